@@ -46,6 +46,7 @@ type file struct {
 	Name      string
 	Size      int64
 	Encrypted bool
+	ChannelId int64
 	Parts     datatypes.JSONSlice[api.Part]
 }
 
@@ -212,7 +213,12 @@ func (cp *channelProcessor) process() error {
 
 	for _, f := range cp.files {
 		size := int64(0)
+		striped := false
 		for _, p := range f.Parts {
+			if p.ChannelId.Or(f.ChannelId) != cp.id {
+				striped = true
+				continue
+			}
 			if p.ID != 0 {
 				allPartIDs[p.ID] = true
 			}
@@ -222,13 +228,13 @@ func (cp *channelProcessor) process() error {
 				break
 			}
 			if f.Encrypted {
-				d, _ := crypt.DecryptedSize(msgMap[p.ID])
+				d, _ := crypt.Format(p.Format.Value).DecryptedSize(msgMap[p.ID])
 				size += d
 			} else {
 				size += msgMap[p.ID]
 			}
 		}
-		if size != f.Size {
+		if !striped && size != f.Size {
 			cp.missingFiles = append(cp.missingFiles, f)
 		}
 	}
@@ -287,7 +293,7 @@ func (cp *channelProcessor) loadFiles() ([]file, error) {
 
 	if err := cp.db.Model(&models.File{}).
 		Where("user_id = ?", cp.userId).
-		Where("channel_id = ?", cp.id).
+		Where(cp.channelFilter()).
 		Where("type = ?", "file").
 		Count(&totalFiles).Error; err != nil {
 		return nil, err
@@ -302,7 +308,7 @@ func (cp *channelProcessor) loadFiles() ([]file, error) {
 		var batch []file
 		query := cp.db.WithContext(cp.ctx).Model(&models.File{}).
 			Where("user_id = ?", cp.userId).
-			Where("channel_id = ?", cp.id).
+			Where(cp.channelFilter()).
 			Where("type = ?", "file").
 			Order("id").
 			Limit(batchSize)
@@ -334,6 +340,13 @@ func (cp *channelProcessor) loadFiles() ([]file, error) {
 	return files, nil
 }
 
+// channelFilter matches files stored in the channel, including files with only
+// some of their parts striped into it.
+func (cp *channelProcessor) channelFilter() *gorm.DB {
+	return cp.db.Where("channel_id = ?", cp.id).
+		Or("parts @> ?::jsonb", fmt.Sprintf(`[{"channelId": %d}]`, cp.id))
+}
+
 func (cp *channelProcessor) loadChannelMessages() (msgs []messages.Elem, total int, err error) {
 
 	err = tgc.RunWithAuth(cp.ctx, cp.client, "", func(ctx context.Context) error {
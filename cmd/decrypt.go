@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tgdrive/teldrive/internal/crypt"
+)
+
+func NewDecryptCmd() *cobra.Command {
+	var key, salt string
+	cmd := &cobra.Command{
+		Use:   "decrypt <part-file>",
+		Short: "Decrypt a downloaded part and write the plaintext to stdout",
+		Long: "Decrypt a part file downloaded from Telegram using the key and salt it was encrypted with. " +
+			"Both the TelDrive and the rclone crypt header formats are accepted.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			cipher, err := crypt.NewCipher(key, salt)
+			if err != nil {
+				return fmt.Errorf("failed to create cipher: %w", err)
+			}
+			out, err := cipher.DecryptData(in)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt %s: %w", args[0], err)
+			}
+			defer out.Close()
+
+			if _, err := io.Copy(cmd.OutOrStdout(), out); err != nil {
+				return fmt.Errorf("failed to decrypt %s: %w", args[0], err)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&key, "key", "", "Encryption key the part was encrypted with")
+	cmd.Flags().StringVar(&salt, "salt", "", "Salt stored with the part")
+	cmd.MarkFlagRequired("key")
+	cmd.MarkFlagRequired("salt")
+	return cmd
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/tgdrive/teldrive/internal/config"
+	"github.com/tgdrive/teldrive/internal/database"
+	"github.com/tgdrive/teldrive/internal/events"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/jobs"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"github.com/tgdrive/teldrive/pkg/services"
+)
+
+func NewImportCmd() *cobra.Command {
+	var cfg config.ServerCmdConfig
+	loader := config.NewConfigLoader()
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import the documents of a Telegram channel as files",
+		Long: "Queue an import job for a channel's document messages. The job is run by the job workers " +
+			"of a running server, the command follows its progress unless detached.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runImportCmd(cmd, &cfg)
+		},
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := loader.Load(cmd, &cfg); err != nil {
+				return err
+			}
+			if err := checkRequiredCheckFlags(&cfg); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	loader.RegisterPlags(cmd.Flags(), "", cfg, true)
+	cmd.Flags().String("user", "", "Telegram User Name")
+	cmd.Flags().Int64("channel", 0, "Channel to import, selected interactively if not set")
+	cmd.Flags().String("path", "/", "Folder receiving the imported files")
+	cmd.Flags().Bool("detach", false, "Queue the import without following its progress")
+	return cmd
+}
+
+func selectChannel(id int64, channels []models.Channel) (*models.Channel, error) {
+	if id != 0 {
+		res := utils.Filter(channels, func(c models.Channel) bool {
+			return c.ChannelId == id
+		})
+		if len(res) == 0 {
+			return nil, fmt.Errorf("invalid channel: %d", id)
+		}
+		return &res[0], nil
+	}
+	templates := &promptui.SelectTemplates{
+		Label:    "{{ . }}",
+		Active:   "{{ .ChannelName | cyan }} ({{ .ChannelId }})",
+		Inactive: "{{ .ChannelName | white }} ({{ .ChannelId }})",
+		Selected: "{{ .ChannelName | red | cyan }}",
+	}
+
+	prompt := promptui.Select{
+		Label:     "Select Channel",
+		Items:     channels,
+		Templates: templates,
+		Size:      50,
+	}
+
+	index, _, err := prompt.Run()
+	if err != nil {
+		return nil, err
+	}
+	return &channels[index], nil
+}
+
+func runImportCmd(cmd *cobra.Command, cfg *config.ServerCmdConfig) {
+
+	ctx := cmd.Context()
+
+	lg := logging.DefaultLogger().Sugar()
+
+	defer logging.DefaultLogger().Sync()
+
+	cfg.DB.LogLevel = "fatal"
+	db, err := database.NewDatabase(ctx, &cfg.DB, lg)
+	if err != nil {
+		lg.Fatalw("failed to create database", "err", err)
+	}
+
+	users := []models.User{}
+	if err := db.Model(&models.User{}).Find(&users).Error; err != nil {
+		lg.Fatalw("failed to get users", "err", err)
+	}
+
+	userName, _ := cmd.Flags().GetString("user")
+	user, err := selectUser(userName, users)
+	if err != nil {
+		lg.Fatalw("failed to select user", "err", err)
+	}
+
+	channels := []models.Channel{}
+	if err := db.Model(&models.Channel{}).Where("user_id = ?", user.UserId).Find(&channels).Error; err != nil {
+		lg.Fatalw("failed to get channels", "err", err)
+	}
+	if len(channels) == 0 {
+		lg.Fatalw("no channels found")
+	}
+
+	channelId, _ := cmd.Flags().GetInt64("channel")
+	channel, err := selectChannel(channelId, channels)
+	if err != nil {
+		lg.Fatalw("failed to select channel", "err", err)
+	}
+
+	path, _ := cmd.Flags().GetString("path")
+	detach, _ := cmd.Flags().GetBool("detach")
+
+	manager := jobs.NewManager(db, cfg, events.NewRecorder(ctx, db, logging.DefaultLogger()))
+	job, err := services.EnqueueChannelImport(manager, user.UserId, channel.ChannelId, path)
+	if err != nil {
+		lg.Fatalw("failed to queue import", "err", err)
+	}
+	lg.Infow("queued channel import", "job", job.ID, "channel", channel.ChannelName)
+	if detach {
+		return
+	}
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	status := ""
+	var done int64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		current, err := manager.Get(user.UserId, job.ID)
+		if err != nil {
+			lg.Fatalw("failed to get import", "err", err)
+		}
+		if current.Status != status || current.Done != done {
+			status, done = current.Status, current.Done
+			lg.Infow("channel import", "status", current.Status, "done", current.Done, "total", current.Total)
+		}
+		switch current.Status {
+		case jobs.StatusCompleted:
+			return
+		case jobs.StatusFailed, jobs.StatusCancelled:
+			lg.Fatalw("channel import stopped", "status", current.Status, "err", current.Error)
+		}
+	}
+}
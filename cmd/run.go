@@ -117,16 +117,18 @@ func runApplication(ctx context.Context, conf *config.ServerCmdConfig) {
 		lg.Fatalw("failed to migrate tg db", "err", err)
 	}
 
-	worker := tgc.NewBotWorker()
+	worker := tgc.NewBotWorker(cacher)
+
+	worker.Subscribe(ctx)
 
 	logger := logging.DefaultLogger()
 
 	eventRecorder := events.NewRecorder(ctx, db, logger)
 
-	srv := setupServer(conf, db, cacher, logger, tgdb, worker, eventRecorder)
+	srv, backfills := setupServer(ctx, conf, db, cacher, logger, tgdb, worker, eventRecorder)
 
 	if conf.CronJobs.Enable {
-		err = cron.StartCronJobs(ctx, db, conf)
+		err = cron.StartCronJobs(ctx, db, conf, backfills)
 		if err != nil {
 			lg.Fatalw("failed to start cron scheduler", "err", err)
 		}
@@ -156,10 +158,12 @@ func runApplication(ctx context.Context, conf *config.ServerCmdConfig) {
 	lg.Info("Server stopped")
 }
 
-func setupServer(cfg *config.ServerCmdConfig, db *gorm.DB, cache cache.Cacher, lg *zap.Logger, tgdb *gorm.DB, worker *tgc.BotWorker, eventRecorder *events.Recorder) *http.Server {
+func setupServer(ctx context.Context, cfg *config.ServerCmdConfig, db *gorm.DB, cache cache.Cacher, lg *zap.Logger, tgdb *gorm.DB, worker *tgc.BotWorker, eventRecorder *events.Recorder) (*http.Server, cron.Backfills) {
 
 	apiSrv := services.NewApiService(db, cfg, cache, tgdb, worker, eventRecorder)
 
+	apiSrv.StartBackgroundJobs(ctx)
+
 	srv, err := api.NewServer(apiSrv, auth.NewSecurityHandler(db, cache, &cfg.JWT))
 
 	if err != nil {
@@ -168,6 +172,11 @@ func setupServer(cfg *config.ServerCmdConfig, db *gorm.DB, cache cache.Cacher, l
 
 	extendedSrv := services.NewExtendedMiddleware(srv, services.NewExtendedService(apiSrv))
 
+	clientIP, err := middleware.ClientIP(cfg.Server.TrustedProxies)
+	if err != nil {
+		lg.Fatal("failed to create server", zap.Error(err))
+	}
+
 	mux := chi.NewRouter()
 
 	mux.Use(chimiddleware.Recoverer)
@@ -177,6 +186,7 @@ func setupServer(cfg *config.ServerCmdConfig, db *gorm.DB, cache cache.Cacher, l
 		AllowedHeaders: []string{"Accept", "Authorization", "Content-Type"},
 		MaxAge:         86400,
 	}))
+	mux.Use(clientIP)
 	mux.Use(chimiddleware.RealIP)
 	mux.Use(middleware.InjectLogger(lg))
 	mux.Use(chizap.ChizapWithConfig(lg, &chizap.Config{
@@ -197,5 +207,6 @@ func setupServer(cfg *config.ServerCmdConfig, db *gorm.DB, cache cache.Cacher, l
 		WriteTimeout:      cfg.Server.WriteTimeout,
 		ReadHeaderTimeout: 10 * time.Second,
 		IdleTimeout:       60 * time.Second,
-	}
+	}, cron.Backfills{Thumbnails: apiSrv.BackfillThumbnails, MediaInfo: apiSrv.BackfillMediaInfo,
+		ContentIndex: apiSrv.BackfillContentIndex}
 }
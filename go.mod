@@ -1,8 +1,9 @@
 module github.com/tgdrive/teldrive
 
-go 1.24
+go 1.24.1
 
 require (
+	github.com/DATA-DOG/go-sqlmock v1.5.2
 	github.com/Masterminds/semver/v3 v3.3.1
 	github.com/WinterYukky/gorm-extra-clause-plugin v0.3.1
 	github.com/coocood/freecache v1.2.4
@@ -19,14 +20,17 @@ require (
 	github.com/gotd/td v0.125.0
 	github.com/iyear/connectproxy v0.1.1
 	github.com/jedib0t/go-pretty/v6 v6.6.7
+	github.com/ledongthuc/pdf v0.0.0-20260907135840-6c8c28e0e8a0
 	github.com/manifoldco/promptui v0.9.0
 	github.com/ogen-go/ogen v1.14.0
 	github.com/redis/go-redis/v9 v9.10.0
+	github.com/rfjakob/eme v1.1.2
 	github.com/spf13/cobra v1.9.1
 	github.com/spf13/pflag v1.0.6
 	github.com/spf13/viper v1.20.1
 	github.com/vmihailenco/msgpack/v5 v5.4.1
 	go.uber.org/zap v1.27.0
+	golang.org/x/image v0.28.0
 	golang.org/x/term v0.32.0
 	golang.org/x/time v0.12.0
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1
@@ -80,7 +84,6 @@ require (
 	modernc.org/mathutil v1.7.1 // indirect
 	modernc.org/memory v1.11.0 // indirect
 	modernc.org/sqlite v1.38.0 // indirect
-
 )
 
 require (
@@ -0,0 +1,19 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *ChannelImportCreate) setDefaults() {
+	{
+		val := string("/")
+		s.Path.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *FileBatch) setDefaults() {
+	{
+		val := bool(false)
+		s.Atomic.SetTo(val)
+	}
+}
@@ -6,44 +6,89 @@ package api
 type OperationName = string
 
 const (
-	AuthLoginOperation          OperationName = "AuthLogin"
-	AuthLogoutOperation         OperationName = "AuthLogout"
-	AuthSessionOperation        OperationName = "AuthSession"
-	AuthWsOperation             OperationName = "AuthWs"
-	EventsGetEventsOperation    OperationName = "EventsGetEvents"
-	FilesCategoryStatsOperation OperationName = "FilesCategoryStats"
-	FilesCopyOperation          OperationName = "FilesCopy"
-	FilesCreateOperation        OperationName = "FilesCreate"
-	FilesCreateShareOperation   OperationName = "FilesCreateShare"
-	FilesDeleteOperation        OperationName = "FilesDelete"
-	FilesDeleteShareOperation   OperationName = "FilesDeleteShare"
-	FilesEditShareOperation     OperationName = "FilesEditShare"
-	FilesGetByIdOperation       OperationName = "FilesGetById"
-	FilesListOperation          OperationName = "FilesList"
-	FilesMkdirOperation         OperationName = "FilesMkdir"
-	FilesMoveOperation          OperationName = "FilesMove"
-	FilesShareByidOperation     OperationName = "FilesShareByid"
-	FilesStreamOperation        OperationName = "FilesStream"
-	FilesUpdateOperation        OperationName = "FilesUpdate"
-	FilesUpdatePartsOperation   OperationName = "FilesUpdateParts"
-	SharesGetByIdOperation      OperationName = "SharesGetById"
-	SharesListFilesOperation    OperationName = "SharesListFiles"
-	SharesStreamOperation       OperationName = "SharesStream"
-	SharesUnlockOperation       OperationName = "SharesUnlock"
-	UploadsDeleteOperation      OperationName = "UploadsDelete"
-	UploadsPartsByIdOperation   OperationName = "UploadsPartsById"
-	UploadsStatsOperation       OperationName = "UploadsStats"
-	UploadsUploadOperation      OperationName = "UploadsUpload"
-	UsersAddBotsOperation       OperationName = "UsersAddBots"
-	UsersCreateChannelOperation OperationName = "UsersCreateChannel"
-	UsersDeleteChannelOperation OperationName = "UsersDeleteChannel"
-	UsersListChannelsOperation  OperationName = "UsersListChannels"
-	UsersListSessionsOperation  OperationName = "UsersListSessions"
-	UsersProfileImageOperation  OperationName = "UsersProfileImage"
-	UsersRemoveBotsOperation    OperationName = "UsersRemoveBots"
-	UsersRemoveSessionOperation OperationName = "UsersRemoveSession"
-	UsersStatsOperation         OperationName = "UsersStats"
-	UsersSyncChannelsOperation  OperationName = "UsersSyncChannels"
-	UsersUpdateChannelOperation OperationName = "UsersUpdateChannel"
-	VersionVersionOperation     OperationName = "VersionVersion"
+	AuthLoginOperation                   OperationName = "AuthLogin"
+	AuthLogoutOperation                  OperationName = "AuthLogout"
+	AuthSessionOperation                 OperationName = "AuthSession"
+	AuthWsOperation                      OperationName = "AuthWs"
+	EventsGetEventsOperation             OperationName = "EventsGetEvents"
+	FilesBatchOperation                  OperationName = "FilesBatch"
+	FilesCategoryStatsOperation          OperationName = "FilesCategoryStats"
+	FilesCopyOperation                   OperationName = "FilesCopy"
+	FilesCreateOperation                 OperationName = "FilesCreate"
+	FilesCreateShareOperation            OperationName = "FilesCreateShare"
+	FilesDeleteOperation                 OperationName = "FilesDelete"
+	FilesDeleteShareOperation            OperationName = "FilesDeleteShare"
+	FilesEditShareOperation              OperationName = "FilesEditShare"
+	FilesGetByIdOperation                OperationName = "FilesGetById"
+	FilesGrantPermissionOperation        OperationName = "FilesGrantPermission"
+	FilesListOperation                   OperationName = "FilesList"
+	FilesListPermissionsOperation        OperationName = "FilesListPermissions"
+	FilesMkdirOperation                  OperationName = "FilesMkdir"
+	FilesMoveOperation                   OperationName = "FilesMove"
+	FilesRevokePermissionOperation       OperationName = "FilesRevokePermission"
+	FilesShareAnalyticsOperation         OperationName = "FilesShareAnalytics"
+	FilesShareByidOperation              OperationName = "FilesShareByid"
+	FilesStarOperation                   OperationName = "FilesStar"
+	FilesStreamOperation                 OperationName = "FilesStream"
+	FilesThumbnailOperation              OperationName = "FilesThumbnail"
+	FilesUnstarOperation                 OperationName = "FilesUnstar"
+	FilesUpdateOperation                 OperationName = "FilesUpdate"
+	FilesUpdatePartsOperation            OperationName = "FilesUpdateParts"
+	FilesUpdateTagsOperation             OperationName = "FilesUpdateTags"
+	JobsCancelOperation                  OperationName = "JobsCancel"
+	JobsGetOperation                     OperationName = "JobsGet"
+	JobsListOperation                    OperationName = "JobsList"
+	JobsRetryOperation                   OperationName = "JobsRetry"
+	MediaAlbumsOperation                 OperationName = "MediaAlbums"
+	MediaArtistsOperation                OperationName = "MediaArtists"
+	MediaTimelineOperation               OperationName = "MediaTimeline"
+	MediaTracksOperation                 OperationName = "MediaTracks"
+	SearchesCreateOperation              OperationName = "SearchesCreate"
+	SearchesDeleteOperation              OperationName = "SearchesDelete"
+	SearchesListOperation                OperationName = "SearchesList"
+	SearchesUpdateOperation              OperationName = "SearchesUpdate"
+	SharesAnalyticsOperation             OperationName = "SharesAnalytics"
+	SharesCreateOperation                OperationName = "SharesCreate"
+	SharesCreateFileOperation            OperationName = "SharesCreateFile"
+	SharesDeleteOperation                OperationName = "SharesDelete"
+	SharesGetByIdOperation               OperationName = "SharesGetById"
+	SharesListFilesOperation             OperationName = "SharesListFiles"
+	SharesStreamOperation                OperationName = "SharesStream"
+	SharesUnlockOperation                OperationName = "SharesUnlock"
+	SharesUploadOperation                OperationName = "SharesUpload"
+	SharesZipOperation                   OperationName = "SharesZip"
+	TagsCreateOperation                  OperationName = "TagsCreate"
+	TagsDeleteOperation                  OperationName = "TagsDelete"
+	TagsListOperation                    OperationName = "TagsList"
+	TagsStatsOperation                   OperationName = "TagsStats"
+	TagsUpdateOperation                  OperationName = "TagsUpdate"
+	UploadsDeleteOperation               OperationName = "UploadsDelete"
+	UploadsPartsByIdOperation            OperationName = "UploadsPartsById"
+	UploadsStatsOperation                OperationName = "UploadsStats"
+	UploadsUploadOperation               OperationName = "UploadsUpload"
+	UsersAddBotsOperation                OperationName = "UsersAddBots"
+	UsersCreateChannelOperation          OperationName = "UsersCreateChannel"
+	UsersCreateChannelImportOperation    OperationName = "UsersCreateChannelImport"
+	UsersCreateChannelMigrationOperation OperationName = "UsersCreateChannelMigration"
+	UsersCreateReencryptionOperation     OperationName = "UsersCreateReencryption"
+	UsersDeleteChannelOperation          OperationName = "UsersDeleteChannel"
+	UsersGetChannelMigrationOperation    OperationName = "UsersGetChannelMigration"
+	UsersGetEncryptionKeyOperation       OperationName = "UsersGetEncryptionKey"
+	UsersGetReencryptionOperation        OperationName = "UsersGetReencryption"
+	UsersGetStoragePolicyOperation       OperationName = "UsersGetStoragePolicy"
+	UsersListChannelMigrationsOperation  OperationName = "UsersListChannelMigrations"
+	UsersListChannelsOperation           OperationName = "UsersListChannels"
+	UsersListReencryptionsOperation      OperationName = "UsersListReencryptions"
+	UsersListSessionsOperation           OperationName = "UsersListSessions"
+	UsersLockEncryptionKeyOperation      OperationName = "UsersLockEncryptionKey"
+	UsersProfileImageOperation           OperationName = "UsersProfileImage"
+	UsersRemoveBotsOperation             OperationName = "UsersRemoveBots"
+	UsersRemoveSessionOperation          OperationName = "UsersRemoveSession"
+	UsersStatsOperation                  OperationName = "UsersStats"
+	UsersSyncChannelsOperation           OperationName = "UsersSyncChannels"
+	UsersUnlockEncryptionKeyOperation    OperationName = "UsersUnlockEncryptionKey"
+	UsersUpdateChannelOperation          OperationName = "UsersUpdateChannel"
+	UsersUpdateEncryptionKeyOperation    OperationName = "UsersUpdateEncryptionKey"
+	UsersUpdateStoragePolicyOperation    OperationName = "UsersUpdateStoragePolicy"
+	VersionVersionOperation              OperationName = "VersionVersion"
 )
@@ -403,6 +403,71 @@ func decodeFilesGetByIdParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
+// FilesGrantPermissionParams is parameters of Files_grantPermission operation.
+type FilesGrantPermissionParams struct {
+	ID string
+}
+
+func unpackFilesGrantPermissionParams(packed middleware.Parameters) (params FilesGrantPermissionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeFilesGrantPermissionParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesGrantPermissionParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // FilesListParams is parameters of Files_list operation.
 type FilesListParams struct {
 	// File name filter.
@@ -411,6 +476,9 @@ type FilesListParams struct {
 	Query OptString
 	// Search type.
 	SearchType OptFileQuerySearchType
+	// Structured filters like size>1GB ext:mkv,mp4 created>2024-01-01 -in:/Trash, on size, created,
+	// updated, mime, ext, type, category and in.
+	Filter OptString
 	// File type.
 	Type OptFileQueryType
 	// File path.
@@ -423,12 +491,34 @@ type FilesListParams struct {
 	DeepSearch OptBool
 	// Show shared files.
 	Shared OptBool
+	// Show files other users shared with you.
+	SharedWithMe OptBool
+	// Show starred files.
+	Starred OptBool
+	// Show recently opened or changed files.
+	Recent OptBool
 	// Parent folder ID.
 	ParentId OptString
+	// Saved search to list, its filters replace the search parameters of the request.
+	SmartFolderId OptString
 	// File category.
 	Category []Category
+	// Tag names to filter by.
+	Tags []string
+	// Match files having any or all of the tags.
+	TagMatch OptFileQueryTagMatch
+	// Property filters, key=value matches a value and key alone matches files having the key.
+	Properties []string
 	// UpdatedAt Filter supports operator eq, gt, lt, gte, lte.
 	UpdatedAt OptString
+	// Capture date filter supports operator eq, gt, lt, gte, lte.
+	TakenAt OptString
+	// Camera maker or model filter.
+	Camera OptString
+	// Duration filter in seconds supports operator eq, gt, lt, gte, lte.
+	Duration OptString
+	// Show files with a capture location.
+	HasLocation OptBool
 	// Sort field.
 	Sort OptFileQuerySort
 	// Sort order.
@@ -437,6 +527,11 @@ type FilesListParams struct {
 	Limit OptInt
 	// Page number.
 	Page OptInt
+	// Opaque token of the page after a previous listing, from meta.nextCursor. Pages by cursor stay
+	// stable while files change and replace page numbers.
+	Cursor OptString
+	// Skip counting the matching files, count and totalPages are left out.
+	SkipCount OptBool
 }
 
 func unpackFilesListParams(packed middleware.Parameters) (params FilesListParams) {
@@ -467,6 +562,15 @@ func unpackFilesListParams(packed middleware.Parameters) (params FilesListParams
 			params.SearchType = v.(OptFileQuerySearchType)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Filter = v.(OptString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "type",
@@ -521,6 +625,33 @@ func unpackFilesListParams(packed middleware.Parameters) (params FilesListParams
 			params.Shared = v.(OptBool)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sharedWithMe",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SharedWithMe = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "starred",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Starred = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "recent",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Recent = v.(OptBool)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "parentId",
@@ -530,6 +661,15 @@ func unpackFilesListParams(packed middleware.Parameters) (params FilesListParams
 			params.ParentId = v.(OptString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "smartFolderId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SmartFolderId = v.(OptString)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "category",
@@ -539,6 +679,33 @@ func unpackFilesListParams(packed middleware.Parameters) (params FilesListParams
 			params.Category = v.([]Category)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "tags",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Tags = v.([]string)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "tagMatch",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.TagMatch = v.(OptFileQueryTagMatch)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "properties",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Properties = v.([]string)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "updatedAt",
@@ -548,6 +715,42 @@ func unpackFilesListParams(packed middleware.Parameters) (params FilesListParams
 			params.UpdatedAt = v.(OptString)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "takenAt",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.TakenAt = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "camera",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Camera = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "duration",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Duration = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "hasLocation",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.HasLocation = v.(OptBool)
+		}
+	}
 	{
 		key := middleware.ParameterKey{
 			Name: "sort",
@@ -584,6 +787,24 @@ func unpackFilesListParams(packed middleware.Parameters) (params FilesListParams
 			params.Page = v.(OptInt)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "skipCount",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SkipCount = v.(OptBool)
+		}
+	}
 	return params
 }
 
@@ -732,6 +953,47 @@ func decodeFilesListParams(args [0]string, argsEscaped bool, r *http.Request) (p
 			Err:  err,
 		}
 	}
+	// Decode query: filter.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFilterVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFilterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Filter.SetTo(paramsDotFilterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: type.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -1038,34 +1300,34 @@ func decodeFilesListParams(args [0]string, argsEscaped bool, r *http.Request) (p
 			Err:  err,
 		}
 	}
-	// Decode query: parentId.
+	// Decode query: sharedWithMe.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "parentId",
+			Name:    "sharedWithMe",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotParentIdVal string
+				var paramsDotSharedWithMeVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotParentIdVal = c
+					paramsDotSharedWithMeVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ParentId.SetTo(paramsDotParentIdVal)
+				params.SharedWithMe.SetTo(paramsDotSharedWithMeVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1074,104 +1336,80 @@ func decodeFilesListParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "parentId",
+			Name: "sharedWithMe",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: category.
+	// Decode query: starred.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "category",
+			Name:    "starred",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotCategoryVal Category
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+				var paramsDotStarredVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						paramsDotCategoryVal = Category(c)
-						return nil
-					}(); err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
 						return err
 					}
-					params.Category = append(params.Category, paramsDotCategoryVal)
+
+					paramsDotStarredVal = c
 					return nil
-				})
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				var failures []validate.FieldError
-				for i, elem := range params.Category {
-					if err := func() error {
-						if err := elem.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						failures = append(failures, validate.FieldError{
-							Name:  fmt.Sprintf("[%d]", i),
-							Error: err,
-						})
-					}
-				}
-				if len(failures) > 0 {
-					return &validate.Error{Fields: failures}
+				}(); err != nil {
+					return err
 				}
+				params.Starred.SetTo(paramsDotStarredVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "category",
+			Name: "starred",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: updatedAt.
+	// Decode query: recent.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "updatedAt",
+			Name:    "recent",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUpdatedAtVal string
+				var paramsDotRecentVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotUpdatedAtVal = c
+					paramsDotRecentVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.UpdatedAt.SetTo(paramsDotUpdatedAtVal)
+				params.Recent.SetTo(paramsDotRecentVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1180,27 +1418,22 @@ func decodeFilesListParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "updatedAt",
+			Name: "recent",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: sort.
-	{
-		val := FileQuerySort("name")
-		params.Sort.SetTo(val)
-	}
-	// Decode query: sort.
+	// Decode query: parentId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort",
+			Name:    "parentId",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortVal FileQuerySort
+				var paramsDotParentIdVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1212,56 +1445,36 @@ func decodeFilesListParams(args [0]string, argsEscaped bool, r *http.Request) (p
 						return err
 					}
 
-					paramsDotSortVal = FileQuerySort(c)
+					paramsDotParentIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Sort.SetTo(paramsDotSortVal)
+				params.ParentId.SetTo(paramsDotParentIdVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Sort.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort",
+			Name: "parentId",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: order.
-	{
-		val := FileQueryOrder("asc")
-		params.Order.SetTo(val)
-	}
-	// Decode query: order.
+	// Decode query: smartFolderId.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order",
+			Name:    "smartFolderId",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderVal FileQueryOrder
+				var paramsDotSmartFolderIdVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1273,26 +1486,76 @@ func decodeFilesListParams(args [0]string, argsEscaped bool, r *http.Request) (p
 						return err
 					}
 
-					paramsDotOrderVal = FileQueryOrder(c)
+					paramsDotSmartFolderIdVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Order.SetTo(paramsDotOrderVal)
+				params.SmartFolderId.SetTo(paramsDotSmartFolderIdVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Order.Get(); ok {
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "smartFolderId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: category.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "category",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotCategoryVal Category
 					if err := func() error {
-						if err := value.Validate(); err != nil {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
 							return err
 						}
+
+						paramsDotCategoryVal = Category(c)
 						return nil
 					}(); err != nil {
 						return err
 					}
+					params.Category = append(params.Category, paramsDotCategoryVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				var failures []validate.FieldError
+				for i, elem := range params.Category {
+					if err := func() error {
+						if err := elem.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						failures = append(failures, validate.FieldError{
+							Name:  fmt.Sprintf("[%d]", i),
+							Error: err,
+						})
+					}
+				}
+				if len(failures) > 0 {
+					return &validate.Error{Fields: failures}
 				}
 				return nil
 			}(); err != nil {
@@ -1302,62 +1565,96 @@ func decodeFilesListParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order",
+			Name: "category",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	// Decode query: tags.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotTagsVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotTagsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Tags = append(params.Tags, paramsDotTagsVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "tags",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: tagMatch.
 	{
-		val := int(500)
-		params.Limit.SetTo(val)
+		val := FileQueryTagMatch("any")
+		params.TagMatch.SetTo(val)
 	}
-	// Decode query: limit.
+	// Decode query: tagMatch.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "tagMatch",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotTagMatchVal FileQueryTagMatch
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotTagMatchVal = FileQueryTagMatch(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.TagMatch.SetTo(paramsDotTagMatchVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.TagMatch.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -1372,88 +1669,863 @@ func decodeFilesListParams(args [0]string, argsEscaped bool, r *http.Request) (p
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "tagMatch",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: page.
-	{
-		val := int(1)
-		params.Page.SetTo(val)
+	// Decode query: properties.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "properties",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				return d.DecodeArray(func(d uri.Decoder) error {
+					var paramsDotPropertiesVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotPropertiesVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					params.Properties = append(params.Properties, paramsDotPropertiesVal)
+					return nil
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "properties",
+			In:   "query",
+			Err:  err,
+		}
 	}
-	// Decode query: page.
+	// Decode query: updatedAt.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
+			Name:    "updatedAt",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
+				var paramsDotUpdatedAtVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPageVal = c
+					paramsDotUpdatedAtVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
+				params.UpdatedAt.SetTo(paramsDotUpdatedAtVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Page.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
+			Name: "updatedAt",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// FilesShareByidParams is parameters of Files_shareByid operation.
-type FilesShareByidParams struct {
-	ID string
-}
+	// Decode query: takenAt.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "takenAt",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTakenAtVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTakenAtVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TakenAt.SetTo(paramsDotTakenAtVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "takenAt",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: camera.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "camera",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCameraVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCameraVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Camera.SetTo(paramsDotCameraVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "camera",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: duration.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "duration",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDurationVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDurationVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Duration.SetTo(paramsDotDurationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "duration",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: hasLocation.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "hasLocation",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHasLocationVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHasLocationVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.HasLocation.SetTo(paramsDotHasLocationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hasLocation",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := FileQuerySort("name")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal FileQuerySort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = FileQuerySort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: order.
+	{
+		val := FileQueryOrder("asc")
+		params.Order.SetTo(val)
+	}
+	// Decode query: order.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderVal FileQueryOrder
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderVal = FileQueryOrder(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Order.SetTo(paramsDotOrderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Order.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(500)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: skipCount.
+	{
+		val := bool(false)
+		params.SkipCount.SetTo(val)
+	}
+	// Decode query: skipCount.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "skipCount",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSkipCountVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSkipCountVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SkipCount.SetTo(paramsDotSkipCountVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "skipCount",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesListPermissionsParams is parameters of Files_listPermissions operation.
+type FilesListPermissionsParams struct {
+	ID string
+}
+
+func unpackFilesListPermissionsParams(packed middleware.Parameters) (params FilesListPermissionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeFilesListPermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesListPermissionsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesRevokePermissionParams is parameters of Files_revokePermission operation.
+type FilesRevokePermissionParams struct {
+	ID     string
+	UserId int64
+}
+
+func unpackFilesRevokePermissionParams(packed middleware.Parameters) (params FilesRevokePermissionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(int64)
+	}
+	return params
+}
+
+func decodeFilesRevokePermissionParams(args [2]string, argsEscaped bool, r *http.Request) (params FilesRevokePermissionParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: userId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesShareAnalyticsParams is parameters of Files_shareAnalytics operation.
+type FilesShareAnalyticsParams struct {
+	ID string
+}
+
+func unpackFilesShareAnalyticsParams(packed middleware.Parameters) (params FilesShareAnalyticsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeFilesShareAnalyticsParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesShareAnalyticsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesShareByidParams is parameters of Files_shareByid operation.
+type FilesShareByidParams struct {
+	ID string
+}
 
 func unpackFilesShareByidParams(packed middleware.Parameters) (params FilesShareByidParams) {
 	{
@@ -1466,7 +2538,1894 @@ func unpackFilesShareByidParams(packed middleware.Parameters) (params FilesShare
 	return params
 }
 
-func decodeFilesShareByidParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesShareByidParams, _ error) {
+func decodeFilesShareByidParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesShareByidParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesStarParams is parameters of Files_star operation.
+type FilesStarParams struct {
+	ID string
+}
+
+func unpackFilesStarParams(packed middleware.Parameters) (params FilesStarParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeFilesStarParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesStarParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesStreamParams is parameters of Files_stream operation.
+type FilesStreamParams struct {
+	ID          string
+	Name        string
+	Download    OptFilesStreamDownload
+	Hash        OptString
+	Range       OptString
+	AccessToken OptString
+}
+
+func unpackFilesStreamParams(packed middleware.Parameters) (params FilesStreamParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "path",
+		}
+		params.Name = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "download",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Download = v.(OptFilesStreamDownload)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "hash",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Hash = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "Range",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.Range = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "access_token",
+			In:   "cookie",
+		}
+		if v, ok := packed[key]; ok {
+			params.AccessToken = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeFilesStreamParams(args [2]string, argsEscaped bool, r *http.Request) (params FilesStreamParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	c := uri.NewCookieDecoder(r)
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: name.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "name",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Name = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: download.
+	{
+		val := FilesStreamDownload("0")
+		params.Download.SetTo(val)
+	}
+	// Decode query: download.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "download",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDownloadVal FilesStreamDownload
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDownloadVal = FilesStreamDownload(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Download.SetTo(paramsDotDownloadVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Download.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "download",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: hash.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "hash",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHashVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHashVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Hash.SetTo(paramsDotHashVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hash",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode header: Range.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Range",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRangeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRangeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Range.SetTo(paramsDotRangeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Range",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode cookie: access_token.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "access_token",
+			Explode: false,
+		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAccessTokenVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAccessTokenVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AccessToken.SetTo(paramsDotAccessTokenVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "access_token",
+			In:   "cookie",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesThumbnailParams is parameters of Files_thumbnail operation.
+type FilesThumbnailParams struct {
+	ID          string
+	Size        OptFilesThumbnailSize
+	Hash        OptString
+	AccessToken OptString
+}
+
+func unpackFilesThumbnailParams(packed middleware.Parameters) (params FilesThumbnailParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "size",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Size = v.(OptFilesThumbnailSize)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "hash",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Hash = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "access_token",
+			In:   "cookie",
+		}
+		if v, ok := packed[key]; ok {
+			params.AccessToken = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeFilesThumbnailParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesThumbnailParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	c := uri.NewCookieDecoder(r)
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: size.
+	{
+		val := FilesThumbnailSize("small")
+		params.Size.SetTo(val)
+	}
+	// Decode query: size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSizeVal FilesThumbnailSize
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSizeVal = FilesThumbnailSize(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Size.SetTo(paramsDotSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Size.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: hash.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "hash",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotHashVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotHashVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Hash.SetTo(paramsDotHashVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "hash",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode cookie: access_token.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "access_token",
+			Explode: false,
+		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAccessTokenVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAccessTokenVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AccessToken.SetTo(paramsDotAccessTokenVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "access_token",
+			In:   "cookie",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesUnstarParams is parameters of Files_unstar operation.
+type FilesUnstarParams struct {
+	ID string
+}
+
+func unpackFilesUnstarParams(packed middleware.Parameters) (params FilesUnstarParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeFilesUnstarParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesUnstarParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesUpdateParams is parameters of Files_update operation.
+type FilesUpdateParams struct {
+	ID string
+}
+
+func unpackFilesUpdateParams(packed middleware.Parameters) (params FilesUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeFilesUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesUpdateParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FilesUpdatePartsParams is parameters of Files_updateParts operation.
+type FilesUpdatePartsParams struct {
+	ID string
+}
+
+func unpackFilesUpdatePartsParams(packed middleware.Parameters) (params FilesUpdatePartsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeFilesUpdatePartsParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesUpdatePartsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// JobsCancelParams is parameters of Jobs_cancel operation.
+type JobsCancelParams struct {
+	ID string
+}
+
+func unpackJobsCancelParams(packed middleware.Parameters) (params JobsCancelParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeJobsCancelParams(args [1]string, argsEscaped bool, r *http.Request) (params JobsCancelParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// JobsGetParams is parameters of Jobs_get operation.
+type JobsGetParams struct {
+	ID string
+}
+
+func unpackJobsGetParams(packed middleware.Parameters) (params JobsGetParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeJobsGetParams(args [1]string, argsEscaped bool, r *http.Request) (params JobsGetParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// JobsListParams is parameters of Jobs_list operation.
+type JobsListParams struct {
+	// Job kind.
+	Kind OptString
+	// Job status.
+	Status OptJobsListStatus
+}
+
+func unpackJobsListParams(packed middleware.Parameters) (params JobsListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptJobsListStatus)
+		}
+	}
+	return params
+}
+
+func decodeJobsListParams(args [0]string, argsEscaped bool, r *http.Request) (params JobsListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal JobsListStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = JobsListStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// JobsRetryParams is parameters of Jobs_retry operation.
+type JobsRetryParams struct {
+	ID string
+}
+
+func unpackJobsRetryParams(packed middleware.Parameters) (params JobsRetryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeJobsRetryParams(args [1]string, argsEscaped bool, r *http.Request) (params JobsRetryParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// MediaAlbumsParams is parameters of Media_albums operation.
+type MediaAlbumsParams struct {
+	// Artist name, empty for tracks without an artist tag.
+	Artist OptString
+}
+
+func unpackMediaAlbumsParams(packed middleware.Parameters) (params MediaAlbumsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "artist",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Artist = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeMediaAlbumsParams(args [0]string, argsEscaped bool, r *http.Request) (params MediaAlbumsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: artist.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "artist",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotArtistVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotArtistVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Artist.SetTo(paramsDotArtistVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "artist",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// MediaTimelineParams is parameters of Media_timeline operation.
+type MediaTimelineParams struct {
+	// Cursor returned with the previous page.
+	Cursor OptString
+	// Items per page.
+	Limit OptInt
+}
+
+func unpackMediaTimelineParams(packed middleware.Parameters) (params MediaTimelineParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeMediaTimelineParams(args [0]string, argsEscaped bool, r *http.Request) (params MediaTimelineParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(200)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// MediaTracksParams is parameters of Media_tracks operation.
+type MediaTracksParams struct {
+	// Artist name, empty for tracks without an artist tag.
+	Artist OptString
+	// Album name, empty for tracks without an album tag.
+	Album OptString
+	// Cursor returned with the previous page.
+	Cursor OptString
+	// Items per page.
+	Limit OptInt
+}
+
+func unpackMediaTracksParams(packed middleware.Parameters) (params MediaTracksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "artist",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Artist = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "album",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Album = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeMediaTracksParams(args [0]string, argsEscaped bool, r *http.Request) (params MediaTracksParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: artist.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "artist",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotArtistVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotArtistVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Artist.SetTo(paramsDotArtistVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "artist",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: album.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "album",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAlbumVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAlbumVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Album.SetTo(paramsDotAlbumVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "album",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(200)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchesDeleteParams is parameters of Searches_delete operation.
+type SearchesDeleteParams struct {
+	ID string
+}
+
+func unpackSearchesDeleteParams(packed middleware.Parameters) (params SearchesDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSearchesDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params SearchesDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchesUpdateParams is parameters of Searches_update operation.
+type SearchesUpdateParams struct {
+	ID string
+}
+
+func unpackSearchesUpdateParams(packed middleware.Parameters) (params SearchesUpdateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSearchesUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params SearchesUpdateParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SharesAnalyticsParams is parameters of Shares_analytics operation.
+type SharesAnalyticsParams struct {
+	ID string
+}
+
+func unpackSharesAnalyticsParams(packed middleware.Parameters) (params SharesAnalyticsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSharesAnalyticsParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesAnalyticsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SharesCreateFileParams is parameters of Shares_createFile operation.
+type SharesCreateFileParams struct {
+	ID string
+}
+
+func unpackSharesCreateFileParams(packed middleware.Parameters) (params SharesCreateFileParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSharesCreateFileParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesCreateFileParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1515,17 +4474,12 @@ func decodeFilesShareByidParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// FilesStreamParams is parameters of Files_stream operation.
-type FilesStreamParams struct {
-	ID          string
-	Name        string
-	Download    OptFilesStreamDownload
-	Hash        OptString
-	Range       OptString
-	AccessToken OptString
+// SharesDeleteParams is parameters of Shares_delete operation.
+type SharesDeleteParams struct {
+	ID string
 }
 
-func unpackFilesStreamParams(packed middleware.Parameters) (params FilesStreamParams) {
+func unpackSharesDeleteParams(packed middleware.Parameters) (params SharesDeleteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1533,56 +4487,196 @@ func unpackFilesStreamParams(packed middleware.Parameters) (params FilesStreamPa
 		}
 		params.ID = packed[key].(string)
 	}
+	return params
+}
+
+func decodeSharesDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SharesGetByIdParams is parameters of Shares_getById operation.
+type SharesGetByIdParams struct {
+	ID string
+}
+
+func unpackSharesGetByIdParams(packed middleware.Parameters) (params SharesGetByIdParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "name",
+			Name: "id",
 			In:   "path",
 		}
-		params.Name = packed[key].(string)
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSharesGetByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesGetByIdParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SharesListFilesParams is parameters of Shares_listFiles operation.
+type SharesListFilesParams struct {
+	ID string
+	// Folder path, in shares of several items it starts with the id of the item.
+	Path OptString
+	// Sort field.
+	Sort OptShareQuerySort
+	// Sort order.
+	Order OptShareQueryOrder
+	// Items per page.
+	Limit OptInt
+	// Page number.
+	Page OptInt
+}
+
+func unpackSharesListFilesParams(packed middleware.Parameters) (params SharesListFilesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "download",
+			Name: "path",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Download = v.(OptFilesStreamDownload)
+			params.Path = v.(OptString)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "hash",
+			Name: "sort",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Hash = v.(OptString)
+			params.Sort = v.(OptShareQuerySort)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "Range",
-			In:   "header",
+			Name: "order",
+			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Range = v.(OptString)
+			params.Order = v.(OptShareQueryOrder)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "access_token",
-			In:   "cookie",
+			Name: "limit",
+			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.AccessToken = v.(OptString)
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
 		}
 	}
 	return params
 }
 
-func decodeFilesStreamParams(args [2]string, argsEscaped bool, r *http.Request) (params FilesStreamParams, _ error) {
+func decodeSharesListFilesParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesListFilesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	h := uri.NewHeaderDecoder(r.Header)
-	c := uri.NewCookieDecoder(r)
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1628,67 +4722,63 @@ func decodeFilesStreamParams(args [2]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Decode path: name.
+	// Decode query: path.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "path",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "name",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPathVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPathVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Name = c
+				params.Path.SetTo(paramsDotPathVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "name",
-			In:   "path",
+			Name: "path",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: download.
+	// Set default value for query: sort.
 	{
-		val := FilesStreamDownload("0")
-		params.Download.SetTo(val)
+		val := ShareQuerySort("name")
+		params.Sort.SetTo(val)
 	}
-	// Decode query: download.
+	// Decode query: sort.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "download",
+			Name:    "sort",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDownloadVal FilesStreamDownload
+				var paramsDotSortVal ShareQuerySort
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1700,18 +4790,18 @@ func decodeFilesStreamParams(args [2]string, argsEscaped bool, r *http.Request)
 						return err
 					}
 
-					paramsDotDownloadVal = FilesStreamDownload(c)
+					paramsDotSortVal = ShareQuerySort(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Download.SetTo(paramsDotDownloadVal)
+				params.Sort.SetTo(paramsDotSortVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Download.Get(); ok {
+				if value, ok := params.Sort.Get(); ok {
 					if err := func() error {
 						if err := value.Validate(); err != nil {
 							return err
@@ -1729,22 +4819,27 @@ func decodeFilesStreamParams(args [2]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "download",
+			Name: "sort",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: hash.
+	// Set default value for query: order.
+	{
+		val := ShareQueryOrder("asc")
+		params.Order.SetTo(val)
+	}
+	// Decode query: order.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "hash",
+			Name:    "order",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotHashVal string
+				var paramsDotOrderVal ShareQueryOrder
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1756,317 +4851,192 @@ func decodeFilesStreamParams(args [2]string, argsEscaped bool, r *http.Request)
 						return err
 					}
 
-					paramsDotHashVal = c
+					paramsDotOrderVal = ShareQueryOrder(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Hash.SetTo(paramsDotHashVal)
+				params.Order.SetTo(paramsDotOrderVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "hash",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode header: Range.
-	if err := func() error {
-		cfg := uri.HeaderParameterDecodingConfig{
-			Name:    "Range",
-			Explode: false,
-		}
-		if err := h.HasParam(cfg); err == nil {
-			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotRangeVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
+			if err := func() error {
+				if value, ok := params.Order.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					paramsDotRangeVal = c
-					return nil
-				}(); err != nil {
-					return err
 				}
-				params.Range.SetTo(paramsDotRangeVal)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "Range",
-			In:   "header",
+			Name: "order",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode cookie: access_token.
+	// Set default value for query: limit.
+	{
+		val := int(500)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
-		cfg := uri.CookieParameterDecodingConfig{
-			Name:    "access_token",
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
-		if err := c.HasParam(cfg); err == nil {
-			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotAccessTokenVal string
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotAccessTokenVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.AccessToken.SetTo(paramsDotAccessTokenVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "access_token",
-			In:   "cookie",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// FilesUpdateParams is parameters of Files_update operation.
-type FilesUpdateParams struct {
-	ID string
-}
-
-func unpackFilesUpdateParams(packed middleware.Parameters) (params FilesUpdateParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeFilesUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesUpdateParams, _ error) {
-	// Decode path: id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.ID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// FilesUpdatePartsParams is parameters of Files_updateParts operation.
-type FilesUpdatePartsParams struct {
-	ID string
-}
-
-func unpackFilesUpdatePartsParams(packed middleware.Parameters) (params FilesUpdatePartsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeFilesUpdatePartsParams(args [1]string, argsEscaped bool, r *http.Request) (params FilesUpdatePartsParams, _ error) {
-	// Decode path: id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
 			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
-
-				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// SharesGetByIdParams is parameters of Shares_getById operation.
-type SharesGetByIdParams struct {
-	ID string
-}
-
-func unpackSharesGetByIdParams(packed middleware.Parameters) (params SharesGetByIdParams) {
+	// Set default value for query: page.
 	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(string)
+		val := int(1)
+		params.Page.SetTo(val)
 	}
-	return params
-}
-
-func decodeSharesGetByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesGetByIdParams, _ error) {
-	// Decode path: id.
+	// Decode query: page.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ID = c
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "page",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SharesListFilesParams is parameters of Shares_listFiles operation.
-type SharesListFilesParams struct {
-	ID string
-	// Folder path.
-	Path OptString
-	// Sort field.
-	Sort OptShareQuerySort
-	// Sort order.
-	Order OptShareQueryOrder
-	// Items per page.
-	Limit OptInt
-	// Page number.
-	Page OptInt
+// SharesStreamParams is parameters of Shares_stream operation.
+type SharesStreamParams struct {
+	ID       string
+	Name     string
+	FileId   string
+	Download OptSharesStreamDownload
 }
 
-func unpackSharesListFilesParams(packed middleware.Parameters) (params SharesListFilesParams) {
+func unpackSharesStreamParams(packed middleware.Parameters) (params SharesStreamParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2076,53 +5046,31 @@ func unpackSharesListFilesParams(packed middleware.Parameters) (params SharesLis
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "path",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Path = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "sort",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Sort = v.(OptShareQuerySort)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "order",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Order = v.(OptShareQueryOrder)
+			Name: "name",
+			In:   "path",
 		}
+		params.Name = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			Name: "fileId",
+			In:   "path",
 		}
+		params.FileId = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "page",
+			Name: "download",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+			params.Download = v.(OptSharesStreamDownload)
 		}
 	}
 	return params
 }
 
-func decodeSharesListFilesParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesListFilesParams, _ error) {
+func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request) (params SharesStreamParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: id.
 	if err := func() error {
@@ -2169,220 +5117,138 @@ func decodeSharesListFilesParams(args [1]string, argsEscaped bool, r *http.Reque
 			Err:  err,
 		}
 	}
-	// Decode query: path.
+	// Decode path: name.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "path",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPathVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotPathVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Path.SetTo(paramsDotPathVal)
-				return nil
-			}); err != nil {
-				return err
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Set default value for query: sort.
-	{
-		val := ShareQuerySort("name")
-		params.Sort.SetTo(val)
-	}
-	// Decode query: sort.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortVal ShareQuerySort
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotSortVal = ShareQuerySort(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Sort.SetTo(paramsDotSortVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Sort.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "name",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.Name = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort",
-			In:   "query",
+			Name: "name",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: order.
-	{
-		val := ShareQueryOrder("asc")
-		params.Order.SetTo(val)
-	}
-	// Decode query: order.
+	// Decode path: fileId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "fileId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderVal ShareQueryOrder
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOrderVal = ShareQueryOrder(c)
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Order.SetTo(paramsDotOrderVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Order.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.FileId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order",
-			In:   "query",
+			Name: "fileId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	// Set default value for query: download.
 	{
-		val := int(500)
-		params.Limit.SetTo(val)
+		val := SharesStreamDownload("0")
+		params.Download.SetTo(val)
 	}
-	// Decode query: limit.
+	// Decode query: download.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "download",
 			Style:   uri.QueryStyleForm,
 			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotDownloadVal SharesStreamDownload
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotDownloadVal = SharesStreamDownload(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Download.SetTo(paramsDotDownloadVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.Download.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -2397,93 +5263,93 @@ func decodeSharesListFilesParams(args [1]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "download",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: page.
+	return params, nil
+}
+
+// SharesUnlockParams is parameters of Shares_unlock operation.
+type SharesUnlockParams struct {
+	ID string
+}
+
+func unpackSharesUnlockParams(packed middleware.Parameters) (params SharesUnlockParams) {
 	{
-		val := int(1)
-		params.Page.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
 	}
-	// Decode query: page.
+	return params
+}
+
+func decodeSharesUnlockParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesUnlockParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotPageVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Page.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SharesStreamParams is parameters of Shares_stream operation.
-type SharesStreamParams struct {
-	ID       string
-	Name     string
-	FileId   string
-	Download OptSharesStreamDownload
+// SharesUploadParams is parameters of Shares_upload operation.
+type SharesUploadParams struct {
+	ID            string
+	UploadId      string
+	ContentLength int64
+	// Name of the part being uploaded.
+	PartName string
+	// Original file name.
+	FileName string
+	// Part number in sequence.
+	PartNo int
 }
 
-func unpackSharesStreamParams(packed middleware.Parameters) (params SharesStreamParams) {
+func unpackSharesUploadParams(packed middleware.Parameters) (params SharesUploadParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2493,32 +5359,45 @@ func unpackSharesStreamParams(packed middleware.Parameters) (params SharesStream
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "name",
+			Name: "uploadId",
 			In:   "path",
 		}
-		params.Name = packed[key].(string)
+		params.UploadId = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "fileId",
-			In:   "path",
+			Name: "Content-Length",
+			In:   "header",
+		}
+		params.ContentLength = packed[key].(int64)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "partName",
+			In:   "query",
+		}
+		params.PartName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "fileName",
+			In:   "query",
 		}
-		params.FileId = packed[key].(string)
+		params.FileName = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "download",
+			Name: "partNo",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Download = v.(OptSharesStreamDownload)
-		}
+		params.PartNo = packed[key].(int)
 	}
 	return params
 }
 
-func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request) (params SharesStreamParams, _ error) {
+func decodeSharesUploadParams(args [2]string, argsEscaped bool, r *http.Request) (params SharesUploadParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2564,11 +5443,11 @@ func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Decode path: name.
+	// Decode path: uploadId.
 	if err := func() error {
-		param := args[2]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2576,7 +5455,7 @@ func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "name",
+				Param:   "uploadId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2593,7 +5472,7 @@ func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Name = c
+				params.UploadId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2604,16 +5483,178 @@ func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "name",
+			Name: "uploadId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: fileId.
+	// Decode header: Content-Length.
 	if err := func() error {
-		param := args[1]
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "Content-Length",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt64(val)
+				if err != nil {
+					return err
+				}
+
+				params.ContentLength = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "Content-Length",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode query: partName.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "partName",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.PartName = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "partName",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: fileName.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "fileName",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.FileName = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "fileName",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: partNo.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "partNo",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.PartNo = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "partNo",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SharesZipParams is parameters of Shares_zip operation.
+type SharesZipParams struct {
+	ID string
+}
+
+func unpackSharesZipParams(packed middleware.Parameters) (params SharesZipParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSharesZipParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesZipParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2621,7 +5662,7 @@ func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "fileId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2638,7 +5679,7 @@ func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.FileId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2649,81 +5690,85 @@ func decodeSharesStreamParams(args [3]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "fileId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: download.
-	{
-		val := SharesStreamDownload("0")
-		params.Download.SetTo(val)
-	}
-	// Decode query: download.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "download",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDownloadVal SharesStreamDownload
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// TagsDeleteParams is parameters of Tags_delete operation.
+type TagsDeleteParams struct {
+	ID string
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+func unpackTagsDeleteParams(packed middleware.Parameters) (params TagsDeleteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
 
-					paramsDotDownloadVal = SharesStreamDownload(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Download.SetTo(paramsDotDownloadVal)
-				return nil
-			}); err != nil {
-				return err
+func decodeTagsDeleteParams(args [1]string, argsEscaped bool, r *http.Request) (params TagsDeleteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
-			if err := func() error {
-				if value, ok := params.Download.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "download",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SharesUnlockParams is parameters of Shares_unlock operation.
-type SharesUnlockParams struct {
+// TagsUpdateParams is parameters of Tags_update operation.
+type TagsUpdateParams struct {
 	ID string
 }
 
-func unpackSharesUnlockParams(packed middleware.Parameters) (params SharesUnlockParams) {
+func unpackTagsUpdateParams(packed middleware.Parameters) (params TagsUpdateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2734,7 +5779,7 @@ func unpackSharesUnlockParams(packed middleware.Parameters) (params SharesUnlock
 	return params
 }
 
-func decodeSharesUnlockParams(args [1]string, argsEscaped bool, r *http.Request) (params SharesUnlockParams, _ error) {
+func decodeTagsUpdateParams(args [1]string, argsEscaped bool, r *http.Request) (params TagsUpdateParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2984,6 +6029,10 @@ type UploadsUploadParams struct {
 	ChannelId OptInt64
 	// Whether the upload content is encrypted.
 	Encrypted OptBool
+	// Folder the file is uploaded to, part names are encrypted below folders with name encryption.
+	ParentId OptString
+	// Header format used when the upload is encrypted.
+	Format OptEncryptionFormat
 }
 
 func unpackUploadsUploadParams(packed middleware.Parameters) (params UploadsUploadParams) {
@@ -3040,6 +6089,24 @@ func unpackUploadsUploadParams(packed middleware.Parameters) (params UploadsUplo
 			params.Encrypted = v.(OptBool)
 		}
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "parentId",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ParentId = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptEncryptionFormat)
+		}
+	}
 	return params
 }
 
@@ -3320,6 +6387,103 @@ func decodeUploadsUploadParams(args [1]string, argsEscaped bool, r *http.Request
 			Err:  err,
 		}
 	}
+	// Decode query: parentId.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "parentId",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotParentIdVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotParentIdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ParentId.SetTo(paramsDotParentIdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "parentId",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal EncryptionFormat
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = EncryptionFormat(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
@@ -3388,6 +6552,136 @@ func decodeUsersDeleteChannelParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
+// UsersGetChannelMigrationParams is parameters of Users_getChannelMigration operation.
+type UsersGetChannelMigrationParams struct {
+	ID string
+}
+
+func unpackUsersGetChannelMigrationParams(packed middleware.Parameters) (params UsersGetChannelMigrationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUsersGetChannelMigrationParams(args [1]string, argsEscaped bool, r *http.Request) (params UsersGetChannelMigrationParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UsersGetReencryptionParams is parameters of Users_getReencryption operation.
+type UsersGetReencryptionParams struct {
+	ID string
+}
+
+func unpackUsersGetReencryptionParams(packed middleware.Parameters) (params UsersGetReencryptionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUsersGetReencryptionParams(args [1]string, argsEscaped bool, r *http.Request) (params UsersGetReencryptionParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // UsersProfileImageParams is parameters of Users_profileImage operation.
 type UsersProfileImageParams struct {
 	Name string
@@ -222,6 +222,27 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
+					case 'b': // Prefix: "batch"
+						origElem := elem
+						if l := len("batch"); len(elem) >= l && elem[0:l] == "batch" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleFilesBatchRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+						elem = origElem
 					case 'c': // Prefix: "categories"
 						origElem := elem
 						if l := len("categories"); len(elem) >= l && elem[0:l] == "categories" {
@@ -318,6 +339,27 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
+						elem = origElem
+					case 't': // Prefix: "tags"
+						origElem := elem
+						if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleFilesUpdateTagsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
 						elem = origElem
 					}
 					// Param: "id"
@@ -381,32 +423,203 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							}
 
 							elem = origElem
-						case 'p': // Prefix: "parts"
+						case 'p': // Prefix: "p"
 							origElem := elem
-							if l := len("parts"); len(elem) >= l && elem[0:l] == "parts" {
+							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "PUT":
-									s.handleFilesUpdatePartsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "PUT")
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "arts"
+
+								if l := len("arts"); len(elem) >= l && elem[0:l] == "arts" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "PUT":
+										s.handleFilesUpdatePartsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "PUT")
+									}
+
+									return
+								}
+
+							case 'e': // Prefix: "ermissions"
+
+								if l := len("ermissions"); len(elem) >= l && elem[0:l] == "ermissions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleFilesListPermissionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleFilesGrantPermissionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "userId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleFilesRevokePermissionRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						case 's': // Prefix: "s"
+							origElem := elem
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'h': // Prefix: "hare"
+
+								if l := len("hare"); len(elem) >= l && elem[0:l] == "hare" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "DELETE":
+										s.handleFilesDeleteShareRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleFilesShareByidRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PATCH":
+										s.handleFilesEditShareRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleFilesCreateShareRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET,PATCH,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/analytics"
+
+									if l := len("/analytics"); len(elem) >= l && elem[0:l] == "/analytics" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleFilesShareAnalyticsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							case 't': // Prefix: "tar"
+
+								if l := len("tar"); len(elem) >= l && elem[0:l] == "tar" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleFilesUnstarRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleFilesStarRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,PUT")
+									}
+
+									return
 								}
 
-								return
 							}
 
 							elem = origElem
-						case 's': // Prefix: "share"
+						case 't': // Prefix: "thumbnail"
 							origElem := elem
-							if l := len("share"); len(elem) >= l && elem[0:l] == "share" {
+							if l := len("thumbnail"); len(elem) >= l && elem[0:l] == "thumbnail" {
 								elem = elem[l:]
 							} else {
 								break
@@ -415,24 +628,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "DELETE":
-									s.handleFilesDeleteShareRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
 								case "GET":
-									s.handleFilesShareByidRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "PATCH":
-									s.handleFilesEditShareRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleFilesCreateShareRequest([1]string{
+									s.handleFilesThumbnailRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "DELETE,GET,PATCH,POST")
+									s.notAllowed(w, r, "GET")
 								}
 
 								return
@@ -468,29 +669,18 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
-			case 's': // Prefix: "shares/"
+			case 'j': // Prefix: "jobs"
 
-				if l := len("shares/"); len(elem) >= l && elem[0:l] == "shares/" {
+				if l := len("jobs"); len(elem) >= l && elem[0:l] == "jobs" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				// Param: "id"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
 				if len(elem) == 0 {
 					switch r.Method {
 					case "GET":
-						s.handleSharesGetByIdRequest([1]string{
-							args[0],
-						}, elemIsEscaped, w, r)
+						s.handleJobsListRequest([0]string{}, elemIsEscaped, w, r)
 					default:
 						s.notAllowed(w, r, "GET")
 					}
@@ -506,118 +696,93 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
 					if len(elem) == 0 {
-						break
+						switch r.Method {
+						case "GET":
+							s.handleJobsGetRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
 					}
 					switch elem[0] {
-					case 'f': // Prefix: "files"
+					case '/': // Prefix: "/"
 
-						if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleSharesListFilesRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'c': // Prefix: "cancel"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "fileId"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleJobsCancelRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
 							}
-							args[1] = elem[:idx]
-							elem = elem[idx:]
 
-							if len(elem) == 0 {
+						case 'r': // Prefix: "retry"
+
+							if l := len("retry"); len(elem) >= l && elem[0:l] == "retry" {
+								elem = elem[l:]
+							} else {
 								break
 							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleJobsRetryRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
 								}
 
-								// Param: "name"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[2] = elem
-								elem = ""
+								return
+							}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleSharesStreamRequest([3]string{
-											args[0],
-											args[1],
-											args[2],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
-
-									return
-								}
-
-							}
-
-						}
-
-					case 'u': // Prefix: "unlock"
-
-						if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleSharesUnlockRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
-							}
-
-							return
 						}
 
 					}
 
 				}
 
-			case 'u': // Prefix: "u"
+			case 'm': // Prefix: "media/"
 
-				if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+				if l := len("media/"); len(elem) >= l && elem[0:l] == "media/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -627,9 +792,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'p': // Prefix: "ploads/"
+				case 'a': // Prefix: "a"
 
-					if l := len("ploads/"); len(elem) >= l && elem[0:l] == "ploads/" {
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 						elem = elem[l:]
 					} else {
 						break
@@ -639,9 +804,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 's': // Prefix: "stats"
-						origElem := elem
-						if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
+					case 'l': // Prefix: "lbums"
+
+						if l := len("lbums"); len(elem) >= l && elem[0:l] == "lbums" {
 							elem = elem[l:]
 						} else {
 							break
@@ -651,7 +816,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							// Leaf node.
 							switch r.Method {
 							case "GET":
-								s.handleUploadsStatsRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleMediaAlbumsRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "GET")
 							}
@@ -659,42 +824,31 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
-						elem = origElem
-					}
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
+					case 'r': // Prefix: "rtists"
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "DELETE":
-							s.handleUploadsDeleteRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "GET":
-							s.handleUploadsPartsByIdRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "POST":
-							s.handleUploadsUploadRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "DELETE,GET,POST")
+						if l := len("rtists"); len(elem) >= l && elem[0:l] == "rtists" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleMediaArtistsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
 						}
 
-						return
 					}
 
-				case 's': // Prefix: "sers/"
+				case 't': // Prefix: "t"
 
-					if l := len("sers/"); len(elem) >= l && elem[0:l] == "sers/" {
+					if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 						elem = elem[l:]
 					} else {
 						break
@@ -704,9 +858,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'b': // Prefix: "bots"
+					case 'i': // Prefix: "imeline"
 
-						if l := len("bots"); len(elem) >= l && elem[0:l] == "bots" {
+						if l := len("imeline"); len(elem) >= l && elem[0:l] == "imeline" {
 							elem = elem[l:]
 						} else {
 							break
@@ -715,142 +869,81 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch r.Method {
-							case "DELETE":
-								s.handleUsersRemoveBotsRequest([0]string{}, elemIsEscaped, w, r)
-							case "POST":
-								s.handleUsersAddBotsRequest([0]string{}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleMediaTimelineRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "DELETE,POST")
+								s.notAllowed(w, r, "GET")
 							}
 
 							return
 						}
 
-					case 'c': // Prefix: "c"
+					case 'r': // Prefix: "racks"
 
-						if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+						if l := len("racks"); len(elem) >= l && elem[0:l] == "racks" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'h': // Prefix: "hannels"
-
-							if l := len("hannels"); len(elem) >= l && elem[0:l] == "hannels" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleUsersListChannelsRequest([0]string{}, elemIsEscaped, w, r)
-								case "PATCH":
-									s.handleUsersUpdateChannelRequest([0]string{}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleUsersCreateChannelRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET,PATCH,POST")
-								}
-
-								return
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleMediaTracksRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
 							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 's': // Prefix: "sync"
-									origElem := elem
-									if l := len("sync"); len(elem) >= l && elem[0:l] == "sync" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "PATCH":
-											s.handleUsersSyncChannelsRequest([0]string{}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "PATCH")
-										}
-
-										return
-									}
-
-									elem = origElem
-								}
-								// Param: "id"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[0] = elem
-								elem = ""
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "DELETE":
-										s.handleUsersDeleteChannelRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "DELETE")
-									}
+							return
+						}
 
-									return
-								}
+					}
 
-							}
+				}
 
-						case 'o': // Prefix: "onfig"
+			case 's': // Prefix: "s"
 
-							if l := len("onfig"); len(elem) >= l && elem[0:l] == "onfig" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleUsersStatsRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "earches"
 
-								return
-							}
+					if l := len("earches"); len(elem) >= l && elem[0:l] == "earches" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleSearchesListRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleSearchesCreateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
 						}
 
-					case 'p': // Prefix: "profile/"
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-						if l := len("profile/"); len(elem) >= l && elem[0:l] == "profile/" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "name"
+						// Param: "id"
 						// Leaf parameter, slashes are prohibited
 						idx := strings.IndexByte(elem, '/')
 						if idx >= 0 {
@@ -862,31 +955,71 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch r.Method {
-							case "GET":
-								s.handleUsersProfileImageRequest([1]string{
+							case "DELETE":
+								s.handleSearchesDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleSearchesUpdateRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, "DELETE,PATCH")
 							}
 
 							return
 						}
 
-					case 's': // Prefix: "sessions"
+					}
+
+				case 'h': // Prefix: "hares"
+
+					if l := len("hares"); len(elem) >= l && elem[0:l] == "hares" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "POST":
+							s.handleSharesCreateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-						if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
 							switch r.Method {
+							case "DELETE":
+								s.handleSharesDeleteRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
 							case "GET":
-								s.handleUsersListSessionsRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleSharesGetByIdRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, "DELETE,GET")
 							}
 
 							return
@@ -900,164 +1033,237 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 
-							// Param: "id"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
+							if len(elem) == 0 {
 								break
 							}
-							args[0] = elem
-							elem = ""
+							switch elem[0] {
+							case 'a': // Prefix: "analytics"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "DELETE":
-									s.handleUsersRemoveSessionRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "DELETE")
+								if l := len("analytics"); len(elem) >= l && elem[0:l] == "analytics" {
+									elem = elem[l:]
+								} else {
+									break
 								}
 
-								return
-							}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleSharesAnalyticsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-						}
+									return
+								}
 
-					}
+							case 'f': // Prefix: "files"
 
-				}
+								if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-			case 'v': // Prefix: "version"
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleSharesListFilesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleSharesCreateFileRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
 
-				if l := len("version"); len(elem) >= l && elem[0:l] == "version" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleVersionVersionRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					return
-				}
+									// Param: "fileId"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
 
-			}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
 
-		}
-	}
-	s.notFound(w, r)
-}
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [3]string
-}
+										// Param: "name"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[2] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleSharesStreamRequest([3]string{
+													args[0],
+													args[1],
+													args[2],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+											return
+										}
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+									}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+								}
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+							case 'u': // Prefix: "u"
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+								if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'n': // Prefix: "nlock"
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+									if l := len("nlock"); len(elem) >= l && elem[0:l] == "nlock" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleSharesUnlockRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+										return
+									}
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+								case 'p': // Prefix: "ploads/"
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "auth/"
+									if l := len("ploads/"); len(elem) >= l && elem[0:l] == "ploads/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+									// Param: "uploadId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleSharesUploadRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							case 'z': // Prefix: "zip"
+
+								if l := len("zip"); len(elem) >= l && elem[0:l] == "zip" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleSharesZipRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 't': // Prefix: "tags"
+
+				if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					break
+					switch r.Method {
+					case "GET":
+						s.handleTagsListRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleTagsCreateRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
 				}
 				switch elem[0] {
-				case 'l': // Prefix: "log"
+				case '/': // Prefix: "/"
 
-					if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1067,9 +1273,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'i': // Prefix: "in"
-
-						if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+					case 's': // Prefix: "stats"
+						origElem := elem
+						if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1077,23 +1283,74 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						if len(elem) == 0 {
 							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = AuthLoginOperation
-								r.summary = "Login"
-								r.operationID = "Auth_login"
-								r.pathPattern = "/auth/login"
-								r.args = args
-								r.count = 0
-								return r, true
+							switch r.Method {
+							case "GET":
+								s.handleTagsStatsRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								return
+								s.notAllowed(w, r, "GET")
 							}
+
+							return
 						}
 
-					case 'o': // Prefix: "out"
+						elem = origElem
+					}
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
 
-						if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleTagsDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleTagsUpdateRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,PATCH")
+						}
+
+						return
+					}
+
+				}
+
+			case 'u': // Prefix: "u"
+
+				if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "ploads/"
+
+					if l := len("ploads/"); len(elem) >= l && elem[0:l] == "ploads/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 's': // Prefix: "stats"
+						origElem := elem
+						if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1101,99 +1358,1350 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						if len(elem) == 0 {
 							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = AuthLogoutOperation
-								r.summary = "Logout"
-								r.operationID = "Auth_logout"
-								r.pathPattern = "/auth/logout"
-								r.args = args
-								r.count = 0
-								return r, true
+							switch r.Method {
+							case "GET":
+								s.handleUploadsStatsRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								return
+								s.notAllowed(w, r, "GET")
 							}
+
+							return
 						}
 
+						elem = origElem
 					}
-
-				case 's': // Prefix: "session"
-
-					if l := len("session"); len(elem) >= l && elem[0:l] == "session" {
-						elem = elem[l:]
-					} else {
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
 						break
 					}
+					args[0] = elem
+					elem = ""
 
 					if len(elem) == 0 {
 						// Leaf node.
-						switch method {
+						switch r.Method {
+						case "DELETE":
+							s.handleUploadsDeleteRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						case "GET":
-							r.name = AuthSessionOperation
-							r.summary = "Get session information"
-							r.operationID = "Auth_session"
-							r.pathPattern = "/auth/session"
-							r.args = args
-							r.count = 0
-							return r, true
+							s.handleUploadsPartsByIdRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleUploadsUploadRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						default:
-							return
+							s.notAllowed(w, r, "DELETE,GET,POST")
 						}
+
+						return
 					}
 
-				case 'w': // Prefix: "ws"
+				case 's': // Prefix: "sers/"
 
-					if l := len("ws"); len(elem) >= l && elem[0:l] == "ws" {
+					if l := len("sers/"); len(elem) >= l && elem[0:l] == "sers/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = AuthWsOperation
-							r.summary = "Websocket QR Login"
-							r.operationID = "Auth_ws"
-							r.pathPattern = "/auth/ws"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
+						break
+					}
+					switch elem[0] {
+					case 'b': // Prefix: "bots"
+
+						if l := len("bots"); len(elem) >= l && elem[0:l] == "bots" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleUsersRemoveBotsRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleUsersAddBotsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,POST")
+							}
+
 							return
 						}
-					}
 
-				}
+					case 'c': // Prefix: "c"
 
-			case 'e': // Prefix: "events"
+						if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-				if l := len("events"); len(elem) >= l && elem[0:l] == "events" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'h': // Prefix: "hannels"
+
+							if l := len("hannels"); len(elem) >= l && elem[0:l] == "hannels" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleUsersListChannelsRequest([0]string{}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUsersUpdateChannelRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleUsersCreateChannelRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,PATCH,POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "imports"
+									origElem := elem
+									if l := len("imports"); len(elem) >= l && elem[0:l] == "imports" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUsersCreateChannelImportRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 'm': // Prefix: "migrations"
+									origElem := elem
+									if l := len("migrations"); len(elem) >= l && elem[0:l] == "migrations" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleUsersListChannelMigrationsRequest([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleUsersCreateChannelMigrationRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleUsersGetChannelMigrationRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+									elem = origElem
+								case 's': // Prefix: "sync"
+									origElem := elem
+									if l := len("sync"); len(elem) >= l && elem[0:l] == "sync" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "PATCH":
+											s.handleUsersSyncChannelsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PATCH")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleUsersDeleteChannelRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+							}
+
+						case 'o': // Prefix: "onfig"
+
+							if l := len("onfig"); len(elem) >= l && elem[0:l] == "onfig" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleUsersStatsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					case 'e': // Prefix: "encryption-key"
+
+						if l := len("encryption-key"); len(elem) >= l && elem[0:l] == "encryption-key" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleUsersGetEncryptionKeyRequest([0]string{}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUsersUpdateEncryptionKeyRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'l': // Prefix: "lock"
+
+								if l := len("lock"); len(elem) >= l && elem[0:l] == "lock" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUsersLockEncryptionKeyRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'u': // Prefix: "unlock"
+
+								if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUsersUnlockEncryptionKeyRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 'p': // Prefix: "profile/"
+
+						if l := len("profile/"); len(elem) >= l && elem[0:l] == "profile/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "name"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleUsersProfileImageRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'r': // Prefix: "reencryptions"
+
+						if l := len("reencryptions"); len(elem) >= l && elem[0:l] == "reencryptions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleUsersListReencryptionsRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleUsersCreateReencryptionRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleUsersGetReencryptionRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "essions"
+
+							if l := len("essions"); len(elem) >= l && elem[0:l] == "essions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleUsersListSessionsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleUsersRemoveSessionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+							}
+
+						case 't': // Prefix: "torage-policy"
+
+							if l := len("torage-policy"); len(elem) >= l && elem[0:l] == "torage-policy" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleUsersGetStoragePolicyRequest([0]string{}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleUsersUpdateStoragePolicyRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,PUT")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'v': // Prefix: "version"
+
+				if l := len("version"); len(elem) >= l && elem[0:l] == "version" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleVersionVersionRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [3]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "auth/"
+
+				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'l': // Prefix: "log"
+
+					if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "in"
+
+						if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = AuthLoginOperation
+								r.summary = "Login"
+								r.operationID = "Auth_login"
+								r.pathPattern = "/auth/login"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'o': // Prefix: "out"
+
+						if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = AuthLogoutOperation
+								r.summary = "Logout"
+								r.operationID = "Auth_logout"
+								r.pathPattern = "/auth/logout"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 's': // Prefix: "session"
+
+					if l := len("session"); len(elem) >= l && elem[0:l] == "session" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = AuthSessionOperation
+							r.summary = "Get session information"
+							r.operationID = "Auth_session"
+							r.pathPattern = "/auth/session"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'w': // Prefix: "ws"
+
+					if l := len("ws"); len(elem) >= l && elem[0:l] == "ws" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = AuthWsOperation
+							r.summary = "Websocket QR Login"
+							r.operationID = "Auth_ws"
+							r.pathPattern = "/auth/ws"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'e': // Prefix: "events"
+
+				if l := len("events"); len(elem) >= l && elem[0:l] == "events" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = EventsGetEventsOperation
+						r.summary = "Get events"
+						r.operationID = "Events_getEvents"
+						r.pathPattern = "/events"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'f': // Prefix: "files"
+
+				if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = FilesListOperation
+						r.summary = "List all files"
+						r.operationID = "Files_list"
+						r.pathPattern = "/files"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = FilesCreateOperation
+						r.summary = "Create a new file"
+						r.operationID = "Files_create"
+						r.pathPattern = "/files"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'b': // Prefix: "batch"
+						origElem := elem
+						if l := len("batch"); len(elem) >= l && elem[0:l] == "batch" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = FilesBatchOperation
+								r.summary = "Run a batch of file operations"
+								r.operationID = "Files_batch"
+								r.pathPattern = "/files/batch"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'c': // Prefix: "categories"
+						origElem := elem
+						if l := len("categories"); len(elem) >= l && elem[0:l] == "categories" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = FilesCategoryStatsOperation
+								r.summary = "Get category stats"
+								r.operationID = "Files_categoryStats"
+								r.pathPattern = "/files/categories"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'd': // Prefix: "delete"
+						origElem := elem
+						if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = FilesDeleteOperation
+								r.summary = "Delete files"
+								r.operationID = "Files_delete"
+								r.pathPattern = "/files/delete"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					case 'm': // Prefix: "m"
+						origElem := elem
+						if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'k': // Prefix: "kdir"
+
+							if l := len("kdir"); len(elem) >= l && elem[0:l] == "kdir" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = FilesMkdirOperation
+									r.summary = "Create Folders"
+									r.operationID = "Files_mkdir"
+									r.pathPattern = "/files/mkdir"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'o': // Prefix: "ove"
+
+							if l := len("ove"); len(elem) >= l && elem[0:l] == "ove" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = FilesMoveOperation
+									r.summary = "Move files"
+									r.operationID = "Files_move"
+									r.pathPattern = "/files/move"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+						elem = origElem
+					case 't': // Prefix: "tags"
+						origElem := elem
+						if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = FilesUpdateTagsOperation
+								r.summary = "Add or remove tags on files"
+								r.operationID = "Files_updateTags"
+								r.pathPattern = "/files/tags"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = FilesGetByIdOperation
+							r.summary = "Get file by ID"
+							r.operationID = "Files_getById"
+							r.pathPattern = "/files/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = FilesUpdateOperation
+							r.summary = "Update file"
+							r.operationID = "Files_update"
+							r.pathPattern = "/files/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "copy"
+							origElem := elem
+							if l := len("copy"); len(elem) >= l && elem[0:l] == "copy" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = FilesCopyOperation
+									r.summary = "Copy file"
+									r.operationID = "Files_copy"
+									r.pathPattern = "/files/{id}/copy"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'p': // Prefix: "p"
+							origElem := elem
+							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "arts"
+
+								if l := len("arts"); len(elem) >= l && elem[0:l] == "arts" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "PUT":
+										r.name = FilesUpdatePartsOperation
+										r.summary = "Update file parts"
+										r.operationID = "Files_updateParts"
+										r.pathPattern = "/files/{id}/parts"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'e': // Prefix: "ermissions"
+
+								if l := len("ermissions"); len(elem) >= l && elem[0:l] == "ermissions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = FilesListPermissionsOperation
+										r.summary = "List users with access"
+										r.operationID = "Files_listPermissions"
+										r.pathPattern = "/files/{id}/permissions"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = FilesGrantPermissionOperation
+										r.summary = "Grant access to a user"
+										r.operationID = "Files_grantPermission"
+										r.pathPattern = "/files/{id}/permissions"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "userId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = FilesRevokePermissionOperation
+											r.summary = "Revoke access of a user"
+											r.operationID = "Files_revokePermission"
+											r.pathPattern = "/files/{id}/permissions/{userId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						case 's': // Prefix: "s"
+							origElem := elem
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'h': // Prefix: "hare"
+
+								if l := len("hare"); len(elem) >= l && elem[0:l] == "hare" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = FilesDeleteShareOperation
+										r.summary = "Delete share"
+										r.operationID = "Files_deleteShare"
+										r.pathPattern = "/files/{id}/share"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "GET":
+										r.name = FilesShareByidOperation
+										r.summary = "Get share by file ID"
+										r.operationID = "Files_shareByid"
+										r.pathPattern = "/files/{id}/share"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PATCH":
+										r.name = FilesEditShareOperation
+										r.summary = "Edit share"
+										r.operationID = "Files_editShare"
+										r.pathPattern = "/files/{id}/share"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = FilesCreateShareOperation
+										r.summary = "Create a share for the file"
+										r.operationID = "Files_createShare"
+										r.pathPattern = "/files/{id}/share"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/analytics"
+
+									if l := len("/analytics"); len(elem) >= l && elem[0:l] == "/analytics" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = FilesShareAnalyticsOperation
+											r.summary = "Get access analytics of the latest share of a file"
+											r.operationID = "Files_shareAnalytics"
+											r.pathPattern = "/files/{id}/share/analytics"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 't': // Prefix: "tar"
+
+								if l := len("tar"); len(elem) >= l && elem[0:l] == "tar" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = FilesUnstarOperation
+										r.summary = "Remove the star from a file"
+										r.operationID = "Files_unstar"
+										r.pathPattern = "/files/{id}/star"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = FilesStarOperation
+										r.summary = "Star a file"
+										r.operationID = "Files_star"
+										r.pathPattern = "/files/{id}/star"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+							elem = origElem
+						case 't': // Prefix: "thumbnail"
+							origElem := elem
+							if l := len("thumbnail"); len(elem) >= l && elem[0:l] == "thumbnail" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = FilesThumbnailOperation
+									r.summary = "Get an image thumbnail"
+									r.operationID = "Files_thumbnail"
+									r.pathPattern = "/files/{id}/thumbnail"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "name"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[1] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = FilesStreamOperation
+								r.summary = "Stream or Download file"
+								r.operationID = "Files_stream"
+								r.pathPattern = "/files/{id}/{name}"
+								r.args = args
+								r.count = 2
+								return r, true
+							default:
+								return
+							}
+						}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = EventsGetEventsOperation
-						r.summary = "Get events"
-						r.operationID = "Events_getEvents"
-						r.pathPattern = "/events"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
 					}
+
 				}
 
-			case 'f': // Prefix: "files"
+			case 'j': // Prefix: "jobs"
 
-				if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+				if l := len("jobs"); len(elem) >= l && elem[0:l] == "jobs" {
 					elem = elem[l:]
 				} else {
 					break
@@ -1202,18 +2710,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						r.name = FilesListOperation
-						r.summary = "List all files"
-						r.operationID = "Files_list"
-						r.pathPattern = "/files"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = FilesCreateOperation
-						r.summary = "Create a new file"
-						r.operationID = "Files_create"
-						r.pathPattern = "/files"
+						r.name = JobsListOperation
+						r.summary = "List jobs"
+						r.operationID = "Jobs_list"
+						r.pathPattern = "/jobs"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -1230,124 +2730,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'c': // Prefix: "categories"
-						origElem := elem
-						if l := len("categories"); len(elem) >= l && elem[0:l] == "categories" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = FilesCategoryStatsOperation
-								r.summary = "Get category stats"
-								r.operationID = "Files_categoryStats"
-								r.pathPattern = "/files/categories"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-
-						elem = origElem
-					case 'd': // Prefix: "delete"
-						origElem := elem
-						if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = FilesDeleteOperation
-								r.summary = "Delete files"
-								r.operationID = "Files_delete"
-								r.pathPattern = "/files/delete"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-
-						elem = origElem
-					case 'm': // Prefix: "m"
-						origElem := elem
-						if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'k': // Prefix: "kdir"
-
-							if l := len("kdir"); len(elem) >= l && elem[0:l] == "kdir" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = FilesMkdirOperation
-									r.summary = "Create Folders"
-									r.operationID = "Files_mkdir"
-									r.pathPattern = "/files/mkdir"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 'o': // Prefix: "ove"
-
-							if l := len("ove"); len(elem) >= l && elem[0:l] == "ove" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = FilesMoveOperation
-									r.summary = "Move files"
-									r.operationID = "Files_move"
-									r.pathPattern = "/files/move"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						}
-
-						elem = origElem
-					}
 					// Param: "id"
 					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
@@ -1360,18 +2742,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							r.name = FilesGetByIdOperation
-							r.summary = "Get file by ID"
-							r.operationID = "Files_getById"
-							r.pathPattern = "/files/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PATCH":
-							r.name = FilesUpdateOperation
-							r.summary = "Update file"
-							r.operationID = "Files_update"
-							r.pathPattern = "/files/{id}"
+							r.name = JobsGetOperation
+							r.summary = "Get job"
+							r.operationID = "Jobs_get"
+							r.pathPattern = "/jobs/{id}"
 							r.args = args
 							r.count = 1
 							return r, true
@@ -1392,9 +2766,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
-						case 'c': // Prefix: "copy"
-							origElem := elem
-							if l := len("copy"); len(elem) >= l && elem[0:l] == "copy" {
+						case 'c': // Prefix: "cancel"
+
+							if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1404,10 +2778,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								// Leaf node.
 								switch method {
 								case "POST":
-									r.name = FilesCopyOperation
-									r.summary = "Copy file"
-									r.operationID = "Files_copy"
-									r.pathPattern = "/files/{id}/copy"
+									r.name = JobsCancelOperation
+									r.summary = "Cancel job"
+									r.operationID = "Jobs_cancel"
+									r.pathPattern = "/jobs/{id}/cancel"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -1416,10 +2790,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
-							elem = origElem
-						case 'p': // Prefix: "parts"
-							origElem := elem
-							if l := len("parts"); len(elem) >= l && elem[0:l] == "parts" {
+						case 'r': // Prefix: "retry"
+
+							if l := len("retry"); len(elem) >= l && elem[0:l] == "retry" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1428,11 +2801,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch method {
-								case "PUT":
-									r.name = FilesUpdatePartsOperation
-									r.summary = "Update file parts"
-									r.operationID = "Files_updateParts"
-									r.pathPattern = "/files/{id}/parts"
+								case "POST":
+									r.name = JobsRetryOperation
+									r.summary = "Retry job"
+									r.operationID = "Jobs_retry"
+									r.pathPattern = "/jobs/{id}/retry"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -1441,76 +2814,140 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
-							elem = origElem
-						case 's': // Prefix: "share"
-							origElem := elem
-							if l := len("share"); len(elem) >= l && elem[0:l] == "share" {
-								elem = elem[l:]
-							} else {
-								break
+						}
+
+					}
+
+				}
+
+			case 'm': // Prefix: "media/"
+
+				if l := len("media/"); len(elem) >= l && elem[0:l] == "media/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "a"
+
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "lbums"
+
+						if l := len("lbums"); len(elem) >= l && elem[0:l] == "lbums" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = MediaAlbumsOperation
+								r.summary = "List music albums"
+								r.operationID = "Media_albums"
+								r.pathPattern = "/media/albums"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
 							}
+						}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "DELETE":
-									r.name = FilesDeleteShareOperation
-									r.summary = "Delete share"
-									r.operationID = "Files_deleteShare"
-									r.pathPattern = "/files/{id}/share"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "GET":
-									r.name = FilesShareByidOperation
-									r.summary = "Get share by file ID"
-									r.operationID = "Files_shareByid"
-									r.pathPattern = "/files/{id}/share"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "PATCH":
-									r.name = FilesEditShareOperation
-									r.summary = "Edit share"
-									r.operationID = "Files_editShare"
-									r.pathPattern = "/files/{id}/share"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "POST":
-									r.name = FilesCreateShareOperation
-									r.summary = "Create a share for the file"
-									r.operationID = "Files_createShare"
-									r.pathPattern = "/files/{id}/share"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
+					case 'r': // Prefix: "rtists"
+
+						if l := len("rtists"); len(elem) >= l && elem[0:l] == "rtists" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = MediaArtistsOperation
+								r.summary = "List music artists"
+								r.operationID = "Media_artists"
+								r.pathPattern = "/media/artists"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
 							}
+						}
 
-							elem = origElem
+					}
+
+				case 't': // Prefix: "t"
+
+					if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "imeline"
+
+						if l := len("imeline"); len(elem) >= l && elem[0:l] == "imeline" {
+							elem = elem[l:]
+						} else {
+							break
 						}
-						// Param: "name"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = MediaTimelineOperation
+								r.summary = "List photos and videos by capture month"
+								r.operationID = "Media_timeline"
+								r.pathPattern = "/media/timeline"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'r': // Prefix: "racks"
+
+						if l := len("racks"); len(elem) >= l && elem[0:l] == "racks" {
+							elem = elem[l:]
+						} else {
 							break
 						}
-						args[1] = elem
-						elem = ""
 
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
 							case "GET":
-								r.name = FilesStreamOperation
-								r.summary = "Stream or Download file"
-								r.operationID = "Files_stream"
-								r.pathPattern = "/files/{id}/{name}"
+								r.name = MediaTracksOperation
+								r.summary = "List music tracks"
+								r.operationID = "Media_tracks"
+								r.pathPattern = "/media/tracks"
 								r.args = args
-								r.count = 2
+								r.count = 0
 								return r, true
 							default:
 								return
@@ -1521,65 +2958,147 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
-			case 's': // Prefix: "shares/"
+			case 's': // Prefix: "s"
 
-				if l := len("shares/"); len(elem) >= l && elem[0:l] == "shares/" {
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				// Param: "id"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
-
 				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = SharesGetByIdOperation
-						r.summary = "Get share by ID"
-						r.operationID = "Shares_getById"
-						r.pathPattern = "/shares/{id}"
-						r.args = args
-						r.count = 1
-						return r, true
-					default:
-						return
-					}
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'e': // Prefix: "earches"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("earches"); len(elem) >= l && elem[0:l] == "earches" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = SearchesListOperation
+							r.summary = "List saved searches"
+							r.operationID = "Searches_list"
+							r.pathPattern = "/searches"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = SearchesCreateOperation
+							r.summary = "Save a search"
+							r.operationID = "Searches_create"
+							r.pathPattern = "/searches"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = SearchesDeleteOperation
+								r.summary = "Delete a saved search"
+								r.operationID = "Searches_delete"
+								r.pathPattern = "/searches/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = SearchesUpdateOperation
+								r.summary = "Update a saved search"
+								r.operationID = "Searches_update"
+								r.pathPattern = "/searches/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'h': // Prefix: "hares"
+
+					if l := len("hares"); len(elem) >= l && elem[0:l] == "hares" {
+						elem = elem[l:]
+					} else {
 						break
 					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = SharesCreateOperation
+							r.summary = "Create share for several files"
+							r.operationID = "Shares_create"
+							r.pathPattern = "/shares"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
 					switch elem[0] {
-					case 'f': // Prefix: "files"
+					case '/': // Prefix: "/"
 
-						if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
 							switch method {
+							case "DELETE":
+								r.name = SharesDeleteOperation
+								r.summary = "Delete share"
+								r.operationID = "Shares_delete"
+								r.pathPattern = "/shares/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
 							case "GET":
-								r.name = SharesListFilesOperation
-								r.summary = "List files in share"
-								r.operationID = "Shares_listFiles"
-								r.pathPattern = "/shares/{id}/files"
+								r.name = SharesGetByIdOperation
+								r.summary = "Get share by ID"
+								r.operationID = "Shares_getById"
+								r.pathPattern = "/shares/{id}"
 								r.args = args
 								r.count = 1
 								return r, true
@@ -1596,46 +3115,212 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 
-							// Param: "fileId"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[1] = elem[:idx]
-							elem = elem[idx:]
-
 							if len(elem) == 0 {
 								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'a': // Prefix: "analytics"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("analytics"); len(elem) >= l && elem[0:l] == "analytics" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "name"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = SharesAnalyticsOperation
+										r.summary = "Get share access analytics"
+										r.operationID = "Shares_analytics"
+										r.pathPattern = "/shares/{id}/analytics"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'f': // Prefix: "files"
+
+								if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = SharesListFilesOperation
+										r.summary = "List files in share"
+										r.operationID = "Shares_listFiles"
+										r.pathPattern = "/shares/{id}/files"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = SharesCreateFileOperation
+										r.summary = "Create file in an upload share"
+										r.operationID = "Shares_createFile"
+										r.pathPattern = "/shares/{id}/files"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "fileId"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "name"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[2] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = SharesStreamOperation
+												r.summary = "Stream or Download shared file"
+												r.operationID = "Shares_stream"
+												r.pathPattern = "/shares/{id}/files/{fileId}/{name}"
+												r.args = args
+												r.count = 3
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 'u': // Prefix: "u"
+
+								if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'n': // Prefix: "nlock"
+
+									if l := len("nlock"); len(elem) >= l && elem[0:l] == "nlock" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = SharesUnlockOperation
+											r.summary = "Unlock share"
+											r.operationID = "Shares_unlock"
+											r.pathPattern = "/shares/{id}/unlock"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'p': // Prefix: "ploads/"
+
+									if l := len("ploads/"); len(elem) >= l && elem[0:l] == "ploads/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "uploadId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = SharesUploadOperation
+											r.summary = "Upload file part to an upload share"
+											r.operationID = "Shares_upload"
+											r.pathPattern = "/shares/{id}/uploads/{uploadId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'z': // Prefix: "zip"
+
+								if l := len("zip"); len(elem) >= l && elem[0:l] == "zip" {
+									elem = elem[l:]
+								} else {
 									break
 								}
-								args[2] = elem
-								elem = ""
 
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
 									case "GET":
-										r.name = SharesStreamOperation
-										r.summary = "Stream or Download shared file"
-										r.operationID = "Shares_stream"
-										r.pathPattern = "/shares/{id}/files/{fileId}/{name}"
+										r.name = SharesZipOperation
+										r.summary = "Download shared files as a zip archive"
+										r.operationID = "Shares_zip"
+										r.pathPattern = "/shares/{id}/zip"
 										r.args = args
-										r.count = 3
+										r.count = 1
 										return r, true
 									default:
 										return
@@ -1646,9 +3331,56 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						}
 
-					case 'u': // Prefix: "unlock"
+					}
+
+				}
+
+			case 't': // Prefix: "tags"
+
+				if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = TagsListOperation
+						r.summary = "List tags"
+						r.operationID = "Tags_list"
+						r.pathPattern = "/tags"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = TagsCreateOperation
+						r.summary = "Create tag"
+						r.operationID = "Tags_create"
+						r.pathPattern = "/tags"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-						if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 's': // Prefix: "stats"
+						origElem := elem
+						if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1657,19 +3389,52 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
-							case "POST":
-								r.name = SharesUnlockOperation
-								r.summary = "Unlock share"
-								r.operationID = "Shares_unlock"
-								r.pathPattern = "/shares/{id}/unlock"
+							case "GET":
+								r.name = TagsStatsOperation
+								r.summary = "Get tag usage statistics"
+								r.operationID = "Tags_stats"
+								r.pathPattern = "/tags/stats"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
 							}
 						}
 
+						elem = origElem
+					}
+					// Param: "id"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = TagsDeleteOperation
+							r.summary = "Delete tag"
+							r.operationID = "Tags_delete"
+							r.pathPattern = "/tags/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = TagsUpdateOperation
+							r.summary = "Update tag"
+							r.operationID = "Tags_update"
+							r.pathPattern = "/tags/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
 					}
 
 				}
@@ -1872,6 +3637,98 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
+								case 'i': // Prefix: "imports"
+									origElem := elem
+									if l := len("imports"); len(elem) >= l && elem[0:l] == "imports" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = UsersCreateChannelImportOperation
+											r.summary = "Import a channel"
+											r.operationID = "Users_createChannelImport"
+											r.pathPattern = "/users/channels/imports"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'm': // Prefix: "migrations"
+									origElem := elem
+									if l := len("migrations"); len(elem) >= l && elem[0:l] == "migrations" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = UsersListChannelMigrationsOperation
+											r.summary = "List channel migrations"
+											r.operationID = "Users_listChannelMigrations"
+											r.pathPattern = "/users/channels/migrations"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "POST":
+											r.name = UsersCreateChannelMigrationOperation
+											r.summary = "Start a channel migration"
+											r.operationID = "Users_createChannelMigration"
+											r.pathPattern = "/users/channels/migrations"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = UsersGetChannelMigrationOperation
+												r.summary = "Get channel migration"
+												r.operationID = "Users_getChannelMigration"
+												r.pathPattern = "/users/channels/migrations/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+									elem = origElem
 								case 's': // Prefix: "sync"
 									origElem := elem
 									if l := len("sync"); len(elem) >= l && elem[0:l] == "sync" {
@@ -1951,6 +3808,101 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						}
 
+					case 'e': // Prefix: "encryption-key"
+
+						if l := len("encryption-key"); len(elem) >= l && elem[0:l] == "encryption-key" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = UsersGetEncryptionKeyOperation
+								r.summary = "Get personal encryption key state"
+								r.operationID = "Users_getEncryptionKey"
+								r.pathPattern = "/users/encryption-key"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "PUT":
+								r.name = UsersUpdateEncryptionKeyOperation
+								r.summary = "Create or rewrap personal encryption key"
+								r.operationID = "Users_updateEncryptionKey"
+								r.pathPattern = "/users/encryption-key"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'l': // Prefix: "lock"
+
+								if l := len("lock"); len(elem) >= l && elem[0:l] == "lock" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UsersLockEncryptionKeyOperation
+										r.summary = "Lock personal encryption key"
+										r.operationID = "Users_lockEncryptionKey"
+										r.pathPattern = "/users/encryption-key/lock"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'u': // Prefix: "unlock"
+
+								if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UsersUnlockEncryptionKeyOperation
+										r.summary = "Unlock personal encryption key"
+										r.operationID = "Users_unlockEncryptionKey"
+										r.pathPattern = "/users/encryption-key/unlock"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
 					case 'p': // Prefix: "profile/"
 
 						if l := len("profile/"); len(elem) >= l && elem[0:l] == "profile/" {
@@ -1984,9 +3936,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
-					case 's': // Prefix: "sessions"
+					case 'r': // Prefix: "reencryptions"
 
-						if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+						if l := len("reencryptions"); len(elem) >= l && elem[0:l] == "reencryptions" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1995,10 +3947,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								r.name = UsersListSessionsOperation
-								r.summary = "List user sessions"
-								r.operationID = "Users_listSessions"
-								r.pathPattern = "/users/sessions"
+								r.name = UsersListReencryptionsOperation
+								r.summary = "List re-encryption jobs"
+								r.operationID = "Users_listReencryptions"
+								r.pathPattern = "/users/reencryptions"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = UsersCreateReencryptionOperation
+								r.summary = "Re-encrypt files with the active key"
+								r.operationID = "Users_createReencryption"
+								r.pathPattern = "/users/reencryptions"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -2027,11 +3987,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch method {
-								case "DELETE":
-									r.name = UsersRemoveSessionOperation
-									r.summary = "Remove user session"
-									r.operationID = "Users_removeSession"
-									r.pathPattern = "/users/sessions/{id}"
+								case "GET":
+									r.name = UsersGetReencryptionOperation
+									r.summary = "Get re-encryption job"
+									r.operationID = "Users_getReencryption"
+									r.pathPattern = "/users/reencryptions/{id}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -2042,6 +4002,110 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						}
 
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "essions"
+
+							if l := len("essions"); len(elem) >= l && elem[0:l] == "essions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = UsersListSessionsOperation
+									r.summary = "List user sessions"
+									r.operationID = "Users_listSessions"
+									r.pathPattern = "/users/sessions"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = UsersRemoveSessionOperation
+										r.summary = "Remove user session"
+										r.operationID = "Users_removeSession"
+										r.pathPattern = "/users/sessions/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 't': // Prefix: "torage-policy"
+
+							if l := len("torage-policy"); len(elem) >= l && elem[0:l] == "torage-policy" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = UsersGetStoragePolicyOperation
+									r.summary = "Get storage policy"
+									r.operationID = "Users_getStoragePolicy"
+									r.pathPattern = "/users/storage-policy"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "PUT":
+									r.name = UsersUpdateStoragePolicyOperation
+									r.summary = "Update storage policy"
+									r.operationID = "Users_updateStoragePolicy"
+									r.pathPattern = "/users/storage-policy"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
 					}
 
 				}
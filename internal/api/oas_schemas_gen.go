@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
 func (s *ErrorStatusCode) Error() string {
@@ -323,6 +324,264 @@ func (s *Channel) SetChannelId(val OptInt64) {
 	s.ChannelId = val
 }
 
+// Import of the documents of a channel as files.
+// Ref: #/components/schemas/ChannelImportCreate
+type ChannelImportCreate struct {
+	// Channel whose documents are imported.
+	ChannelId int64 `json:"channelId"`
+	// Folder receiving the imported files, created when missing.
+	Path OptString `json:"path"`
+}
+
+// GetChannelId returns the value of ChannelId.
+func (s *ChannelImportCreate) GetChannelId() int64 {
+	return s.ChannelId
+}
+
+// GetPath returns the value of Path.
+func (s *ChannelImportCreate) GetPath() OptString {
+	return s.Path
+}
+
+// SetChannelId sets the value of ChannelId.
+func (s *ChannelImportCreate) SetChannelId(val int64) {
+	s.ChannelId = val
+}
+
+// SetPath sets the value of Path.
+func (s *ChannelImportCreate) SetPath(val OptString) {
+	s.Path = val
+}
+
+// Background migration of file parts between channels.
+// Ref: #/components/schemas/ChannelMigration
+type ChannelMigration struct {
+	// Migration ID.
+	ID string `json:"id"`
+	// Channel whose file parts are moved.
+	SourceChannelId OptInt64 `json:"sourceChannelId"`
+	// Folder the migration is limited to.
+	FolderId OptString `json:"folderId"`
+	// Channel receiving the file parts.
+	DestinationChannelId int64 `json:"destinationChannelId"`
+	// Migration status.
+	Status ChannelMigrationStatus `json:"status"`
+	// Number of files to move.
+	TotalFiles int64 `json:"totalFiles"`
+	// Number of files processed so far.
+	MovedFiles int64 `json:"movedFiles"`
+	// Failure reason.
+	Error OptString `json:"error"`
+	// Creation time.
+	CreatedAt time.Time `json:"createdAt"`
+	// Last progress update.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *ChannelMigration) GetID() string {
+	return s.ID
+}
+
+// GetSourceChannelId returns the value of SourceChannelId.
+func (s *ChannelMigration) GetSourceChannelId() OptInt64 {
+	return s.SourceChannelId
+}
+
+// GetFolderId returns the value of FolderId.
+func (s *ChannelMigration) GetFolderId() OptString {
+	return s.FolderId
+}
+
+// GetDestinationChannelId returns the value of DestinationChannelId.
+func (s *ChannelMigration) GetDestinationChannelId() int64 {
+	return s.DestinationChannelId
+}
+
+// GetStatus returns the value of Status.
+func (s *ChannelMigration) GetStatus() ChannelMigrationStatus {
+	return s.Status
+}
+
+// GetTotalFiles returns the value of TotalFiles.
+func (s *ChannelMigration) GetTotalFiles() int64 {
+	return s.TotalFiles
+}
+
+// GetMovedFiles returns the value of MovedFiles.
+func (s *ChannelMigration) GetMovedFiles() int64 {
+	return s.MovedFiles
+}
+
+// GetError returns the value of Error.
+func (s *ChannelMigration) GetError() OptString {
+	return s.Error
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ChannelMigration) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ChannelMigration) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ChannelMigration) SetID(val string) {
+	s.ID = val
+}
+
+// SetSourceChannelId sets the value of SourceChannelId.
+func (s *ChannelMigration) SetSourceChannelId(val OptInt64) {
+	s.SourceChannelId = val
+}
+
+// SetFolderId sets the value of FolderId.
+func (s *ChannelMigration) SetFolderId(val OptString) {
+	s.FolderId = val
+}
+
+// SetDestinationChannelId sets the value of DestinationChannelId.
+func (s *ChannelMigration) SetDestinationChannelId(val int64) {
+	s.DestinationChannelId = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ChannelMigration) SetStatus(val ChannelMigrationStatus) {
+	s.Status = val
+}
+
+// SetTotalFiles sets the value of TotalFiles.
+func (s *ChannelMigration) SetTotalFiles(val int64) {
+	s.TotalFiles = val
+}
+
+// SetMovedFiles sets the value of MovedFiles.
+func (s *ChannelMigration) SetMovedFiles(val int64) {
+	s.MovedFiles = val
+}
+
+// SetError sets the value of Error.
+func (s *ChannelMigration) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ChannelMigration) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ChannelMigration) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// Channel migration request, at least one of sourceChannelId or folderId is required.
+// Ref: #/components/schemas/ChannelMigrationCreate
+type ChannelMigrationCreate struct {
+	// Channel whose file parts are moved.
+	SourceChannelId OptInt64 `json:"sourceChannelId"`
+	// Limit the migration to files below this folder.
+	FolderId OptString `json:"folderId"`
+	// Channel receiving the file parts.
+	DestinationChannelId int64 `json:"destinationChannelId"`
+}
+
+// GetSourceChannelId returns the value of SourceChannelId.
+func (s *ChannelMigrationCreate) GetSourceChannelId() OptInt64 {
+	return s.SourceChannelId
+}
+
+// GetFolderId returns the value of FolderId.
+func (s *ChannelMigrationCreate) GetFolderId() OptString {
+	return s.FolderId
+}
+
+// GetDestinationChannelId returns the value of DestinationChannelId.
+func (s *ChannelMigrationCreate) GetDestinationChannelId() int64 {
+	return s.DestinationChannelId
+}
+
+// SetSourceChannelId sets the value of SourceChannelId.
+func (s *ChannelMigrationCreate) SetSourceChannelId(val OptInt64) {
+	s.SourceChannelId = val
+}
+
+// SetFolderId sets the value of FolderId.
+func (s *ChannelMigrationCreate) SetFolderId(val OptString) {
+	s.FolderId = val
+}
+
+// SetDestinationChannelId sets the value of DestinationChannelId.
+func (s *ChannelMigrationCreate) SetDestinationChannelId(val int64) {
+	s.DestinationChannelId = val
+}
+
+// Migration status.
+type ChannelMigrationStatus string
+
+const (
+	ChannelMigrationStatusPending   ChannelMigrationStatus = "pending"
+	ChannelMigrationStatusRunning   ChannelMigrationStatus = "running"
+	ChannelMigrationStatusCompleted ChannelMigrationStatus = "completed"
+	ChannelMigrationStatusFailed    ChannelMigrationStatus = "failed"
+	ChannelMigrationStatusCancelled ChannelMigrationStatus = "cancelled"
+)
+
+// AllValues returns all ChannelMigrationStatus values.
+func (ChannelMigrationStatus) AllValues() []ChannelMigrationStatus {
+	return []ChannelMigrationStatus{
+		ChannelMigrationStatusPending,
+		ChannelMigrationStatusRunning,
+		ChannelMigrationStatusCompleted,
+		ChannelMigrationStatusFailed,
+		ChannelMigrationStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ChannelMigrationStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ChannelMigrationStatusPending:
+		return []byte(s), nil
+	case ChannelMigrationStatusRunning:
+		return []byte(s), nil
+	case ChannelMigrationStatusCompleted:
+		return []byte(s), nil
+	case ChannelMigrationStatusFailed:
+		return []byte(s), nil
+	case ChannelMigrationStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ChannelMigrationStatus) UnmarshalText(data []byte) error {
+	switch ChannelMigrationStatus(data) {
+	case ChannelMigrationStatusPending:
+		*s = ChannelMigrationStatusPending
+		return nil
+	case ChannelMigrationStatusRunning:
+		*s = ChannelMigrationStatusRunning
+		return nil
+	case ChannelMigrationStatusCompleted:
+		*s = ChannelMigrationStatusCompleted
+		return nil
+	case ChannelMigrationStatusFailed:
+		*s = ChannelMigrationStatusFailed
+		return nil
+	case ChannelMigrationStatusCancelled:
+		*s = ChannelMigrationStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Telegram channel information.
 // Ref: #/components/schemas/ChannelUpdate
 type ChannelUpdate struct {
@@ -352,6 +611,49 @@ func (s *ChannelUpdate) SetChannelId(val OptInt64) {
 	s.ChannelId = val
 }
 
+// Header format of encrypted data, rclone is readable by rclone crypt.
+// Ref: #/components/schemas/EncryptionFormat
+type EncryptionFormat string
+
+const (
+	EncryptionFormatTeldrive EncryptionFormat = "teldrive"
+	EncryptionFormatRclone   EncryptionFormat = "rclone"
+)
+
+// AllValues returns all EncryptionFormat values.
+func (EncryptionFormat) AllValues() []EncryptionFormat {
+	return []EncryptionFormat{
+		EncryptionFormatTeldrive,
+		EncryptionFormatRclone,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EncryptionFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case EncryptionFormatTeldrive:
+		return []byte(s), nil
+	case EncryptionFormatRclone:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EncryptionFormat) UnmarshalText(data []byte) error {
+	switch EncryptionFormat(data) {
+	case EncryptionFormatTeldrive:
+		*s = EncryptionFormatTeldrive
+		return nil
+	case EncryptionFormatRclone:
+		*s = EncryptionFormatRclone
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Standard error response.
 // Ref: #/components/schemas/Error
 type Error struct {
@@ -487,6 +789,18 @@ type File struct {
 	Encrypted OptBool `json:"encrypted"`
 	// Last update time.
 	UpdatedAt OptDateTime `json:"updatedAt"`
+	// Encrypt the names of items stored below this folder.
+	EncryptNames OptBool `json:"encryptNames"`
+	// Tag names.
+	Tags    []string `json:"tags"`
+	Starred OptBool  `json:"starred"`
+	// Custom metadata.
+	Properties   OptFileProperties `json:"properties"`
+	HasThumbnail OptBool           `json:"hasThumbnail"`
+	// Media metadata.
+	Media OptMediaInfo `json:"media"`
+	// Highlighted passages matching a content search.
+	Snippets []string `json:"snippets"`
 }
 
 // GetID returns the value of ID.
@@ -549,6 +863,41 @@ func (s *File) GetUpdatedAt() OptDateTime {
 	return s.UpdatedAt
 }
 
+// GetEncryptNames returns the value of EncryptNames.
+func (s *File) GetEncryptNames() OptBool {
+	return s.EncryptNames
+}
+
+// GetTags returns the value of Tags.
+func (s *File) GetTags() []string {
+	return s.Tags
+}
+
+// GetStarred returns the value of Starred.
+func (s *File) GetStarred() OptBool {
+	return s.Starred
+}
+
+// GetProperties returns the value of Properties.
+func (s *File) GetProperties() OptFileProperties {
+	return s.Properties
+}
+
+// GetHasThumbnail returns the value of HasThumbnail.
+func (s *File) GetHasThumbnail() OptBool {
+	return s.HasThumbnail
+}
+
+// GetMedia returns the value of Media.
+func (s *File) GetMedia() OptMediaInfo {
+	return s.Media
+}
+
+// GetSnippets returns the value of Snippets.
+func (s *File) GetSnippets() []string {
+	return s.Snippets
+}
+
 // SetID sets the value of ID.
 func (s *File) SetID(val OptString) {
 	s.ID = val
@@ -609,137 +958,492 @@ func (s *File) SetUpdatedAt(val OptDateTime) {
 	s.UpdatedAt = val
 }
 
-// File Copy request.
-// Ref: #/components/schemas/FileCopy
-type FileCopy struct {
-	// New file name.
-	NewName OptString `json:"newName"`
-	// Destination path for the copied file.
-	Destination string `json:"destination"`
-	// Last update time.
-	UpdatedAt OptDateTime `json:"updatedAt"`
+// SetEncryptNames sets the value of EncryptNames.
+func (s *File) SetEncryptNames(val OptBool) {
+	s.EncryptNames = val
 }
 
-// GetNewName returns the value of NewName.
-func (s *FileCopy) GetNewName() OptString {
-	return s.NewName
+// SetTags sets the value of Tags.
+func (s *File) SetTags(val []string) {
+	s.Tags = val
 }
 
-// GetDestination returns the value of Destination.
-func (s *FileCopy) GetDestination() string {
-	return s.Destination
+// SetStarred sets the value of Starred.
+func (s *File) SetStarred(val OptBool) {
+	s.Starred = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *FileCopy) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// SetProperties sets the value of Properties.
+func (s *File) SetProperties(val OptFileProperties) {
+	s.Properties = val
 }
 
-// SetNewName sets the value of NewName.
-func (s *FileCopy) SetNewName(val OptString) {
-	s.NewName = val
+// SetHasThumbnail sets the value of HasThumbnail.
+func (s *File) SetHasThumbnail(val OptBool) {
+	s.HasThumbnail = val
 }
 
-// SetDestination sets the value of Destination.
-func (s *FileCopy) SetDestination(val string) {
-	s.Destination = val
+// SetMedia sets the value of Media.
+func (s *File) SetMedia(val OptMediaInfo) {
+	s.Media = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *FileCopy) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// SetSnippets sets the value of Snippets.
+func (s *File) SetSnippets(val []string) {
+	s.Snippets = val
 }
 
-// Delete operation request.
-// Ref: #/components/schemas/FileDelete
-type FileDelete struct {
-	// Array of file or folders ids to be deleted.
-	Ids []string `json:"ids"`
+// Batch of file operations.
+// Ref: #/components/schemas/FileBatch
+type FileBatch struct {
+	// Operations, run in order. Copies send the file parts again and run after the other operations.
+	Operations []FileBatchOperation `json:"operations"`
+	// Roll back every operation when one fails. Copies can't be rolled back and are rejected in atomic
+	// batches.
+	Atomic OptBool `json:"atomic"`
 }
 
-// GetIds returns the value of Ids.
-func (s *FileDelete) GetIds() []string {
-	return s.Ids
+// GetOperations returns the value of Operations.
+func (s *FileBatch) GetOperations() []FileBatchOperation {
+	return s.Operations
 }
 
-// SetIds sets the value of Ids.
-func (s *FileDelete) SetIds(val []string) {
-	s.Ids = val
+// GetAtomic returns the value of Atomic.
+func (s *FileBatch) GetAtomic() OptBool {
+	return s.Atomic
 }
 
-// Paginated file listing response with metadata.
-// Ref: #/components/schemas/FileList
-type FileList struct {
-	// Array of file entries in the current page.
-	Items []File `json:"items"`
-	// Pagination metadata and total count information.
-	Meta Meta `json:"meta"`
+// SetOperations sets the value of Operations.
+func (s *FileBatch) SetOperations(val []FileBatchOperation) {
+	s.Operations = val
 }
 
-// GetItems returns the value of Items.
-func (s *FileList) GetItems() []File {
-	return s.Items
+// SetAtomic sets the value of Atomic.
+func (s *FileBatch) SetAtomic(val OptBool) {
+	s.Atomic = val
 }
 
-// GetMeta returns the value of Meta.
-func (s *FileList) GetMeta() Meta {
-	return s.Meta
+// Outcome of a batch operation.
+// Ref: #/components/schemas/FileBatchItemResult
+type FileBatchItemResult struct {
+	// Position of the operation in the request.
+	Index int `json:"index"`
+	// File or folder ID of the operation.
+	ID string `json:"id"`
+	// Outcome of the operation.
+	Status FileBatchItemResultStatus `json:"status"`
+	// Why the operation failed.
+	Error OptString `json:"error"`
+	// Created file, for copy.
+	File OptFile `json:"file"`
 }
 
-// SetItems sets the value of Items.
-func (s *FileList) SetItems(val []File) {
-	s.Items = val
+// GetIndex returns the value of Index.
+func (s *FileBatchItemResult) GetIndex() int {
+	return s.Index
 }
 
-// SetMeta sets the value of Meta.
-func (s *FileList) SetMeta(val Meta) {
-	s.Meta = val
+// GetID returns the value of ID.
+func (s *FileBatchItemResult) GetID() string {
+	return s.ID
 }
 
-// Request to create directories.
-// Ref: #/components/schemas/FileMkDir
-type FileMkDir struct {
-	// Directory path to be created.
-	Path string `json:"path"`
+// GetStatus returns the value of Status.
+func (s *FileBatchItemResult) GetStatus() FileBatchItemResultStatus {
+	return s.Status
 }
 
-// GetPath returns the value of Path.
-func (s *FileMkDir) GetPath() string {
-	return s.Path
+// GetError returns the value of Error.
+func (s *FileBatchItemResult) GetError() OptString {
+	return s.Error
 }
 
-// SetPath sets the value of Path.
-func (s *FileMkDir) SetPath(val string) {
-	s.Path = val
+// GetFile returns the value of File.
+func (s *FileBatchItemResult) GetFile() OptFile {
+	return s.File
 }
 
-// Move multiple files by ids or path.
-// Ref: #/components/schemas/FileMove
-type FileMove struct {
-	// Array of file or folders ids to be moved.
-	Ids []string `json:"ids"`
-	// Destination parent path or ID where files will be moved to.
-	DestinationParent string `json:"destinationParent"`
-	// Destination file or folder name.
-	DestinationName OptString `json:"destinationName"`
+// SetIndex sets the value of Index.
+func (s *FileBatchItemResult) SetIndex(val int) {
+	s.Index = val
 }
 
-// GetIds returns the value of Ids.
-func (s *FileMove) GetIds() []string {
-	return s.Ids
+// SetID sets the value of ID.
+func (s *FileBatchItemResult) SetID(val string) {
+	s.ID = val
 }
 
-// GetDestinationParent returns the value of DestinationParent.
-func (s *FileMove) GetDestinationParent() string {
-	return s.DestinationParent
+// SetStatus sets the value of Status.
+func (s *FileBatchItemResult) SetStatus(val FileBatchItemResultStatus) {
+	s.Status = val
 }
 
-// GetDestinationName returns the value of DestinationName.
-func (s *FileMove) GetDestinationName() OptString {
-	return s.DestinationName
+// SetError sets the value of Error.
+func (s *FileBatchItemResult) SetError(val OptString) {
+	s.Error = val
 }
 
-// SetIds sets the value of Ids.
+// SetFile sets the value of File.
+func (s *FileBatchItemResult) SetFile(val OptFile) {
+	s.File = val
+}
+
+// Outcome of the operation.
+type FileBatchItemResultStatus string
+
+const (
+	FileBatchItemResultStatusOk         FileBatchItemResultStatus = "ok"
+	FileBatchItemResultStatusFailed     FileBatchItemResultStatus = "failed"
+	FileBatchItemResultStatusRolledBack FileBatchItemResultStatus = "rolled_back"
+)
+
+// AllValues returns all FileBatchItemResultStatus values.
+func (FileBatchItemResultStatus) AllValues() []FileBatchItemResultStatus {
+	return []FileBatchItemResultStatus{
+		FileBatchItemResultStatusOk,
+		FileBatchItemResultStatusFailed,
+		FileBatchItemResultStatusRolledBack,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FileBatchItemResultStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case FileBatchItemResultStatusOk:
+		return []byte(s), nil
+	case FileBatchItemResultStatusFailed:
+		return []byte(s), nil
+	case FileBatchItemResultStatusRolledBack:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FileBatchItemResultStatus) UnmarshalText(data []byte) error {
+	switch FileBatchItemResultStatus(data) {
+	case FileBatchItemResultStatusOk:
+		*s = FileBatchItemResultStatusOk
+		return nil
+	case FileBatchItemResultStatusFailed:
+		*s = FileBatchItemResultStatusFailed
+		return nil
+	case FileBatchItemResultStatusRolledBack:
+		*s = FileBatchItemResultStatusRolledBack
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Operation of a batch on a single item.
+// Ref: #/components/schemas/FileBatchOperation
+type FileBatchOperation struct {
+	// Operation to run on the item.
+	Op FileBatchOperationOp `json:"op"`
+	// File or folder ID.
+	ID string `json:"id"`
+	// Destination folder ID or path, for move and copy.
+	Destination OptString `json:"destination"`
+	// New name, for rename and copy.
+	Name OptString `json:"name"`
+	// Tag names to add, for tag.
+	AddTags []string `json:"addTags"`
+	// Tag names to remove, for tag.
+	RemoveTags []string `json:"removeTags"`
+	// Share settings, for share.
+	Share OptFileShareCreate `json:"share"`
+}
+
+// GetOp returns the value of Op.
+func (s *FileBatchOperation) GetOp() FileBatchOperationOp {
+	return s.Op
+}
+
+// GetID returns the value of ID.
+func (s *FileBatchOperation) GetID() string {
+	return s.ID
+}
+
+// GetDestination returns the value of Destination.
+func (s *FileBatchOperation) GetDestination() OptString {
+	return s.Destination
+}
+
+// GetName returns the value of Name.
+func (s *FileBatchOperation) GetName() OptString {
+	return s.Name
+}
+
+// GetAddTags returns the value of AddTags.
+func (s *FileBatchOperation) GetAddTags() []string {
+	return s.AddTags
+}
+
+// GetRemoveTags returns the value of RemoveTags.
+func (s *FileBatchOperation) GetRemoveTags() []string {
+	return s.RemoveTags
+}
+
+// GetShare returns the value of Share.
+func (s *FileBatchOperation) GetShare() OptFileShareCreate {
+	return s.Share
+}
+
+// SetOp sets the value of Op.
+func (s *FileBatchOperation) SetOp(val FileBatchOperationOp) {
+	s.Op = val
+}
+
+// SetID sets the value of ID.
+func (s *FileBatchOperation) SetID(val string) {
+	s.ID = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *FileBatchOperation) SetDestination(val OptString) {
+	s.Destination = val
+}
+
+// SetName sets the value of Name.
+func (s *FileBatchOperation) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetAddTags sets the value of AddTags.
+func (s *FileBatchOperation) SetAddTags(val []string) {
+	s.AddTags = val
+}
+
+// SetRemoveTags sets the value of RemoveTags.
+func (s *FileBatchOperation) SetRemoveTags(val []string) {
+	s.RemoveTags = val
+}
+
+// SetShare sets the value of Share.
+func (s *FileBatchOperation) SetShare(val OptFileShareCreate) {
+	s.Share = val
+}
+
+// Operation to run on the item.
+type FileBatchOperationOp string
+
+const (
+	FileBatchOperationOpMove   FileBatchOperationOp = "move"
+	FileBatchOperationOpRename FileBatchOperationOp = "rename"
+	FileBatchOperationOpDelete FileBatchOperationOp = "delete"
+	FileBatchOperationOpCopy   FileBatchOperationOp = "copy"
+	FileBatchOperationOpTag    FileBatchOperationOp = "tag"
+	FileBatchOperationOpShare  FileBatchOperationOp = "share"
+)
+
+// AllValues returns all FileBatchOperationOp values.
+func (FileBatchOperationOp) AllValues() []FileBatchOperationOp {
+	return []FileBatchOperationOp{
+		FileBatchOperationOpMove,
+		FileBatchOperationOpRename,
+		FileBatchOperationOpDelete,
+		FileBatchOperationOpCopy,
+		FileBatchOperationOpTag,
+		FileBatchOperationOpShare,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FileBatchOperationOp) MarshalText() ([]byte, error) {
+	switch s {
+	case FileBatchOperationOpMove:
+		return []byte(s), nil
+	case FileBatchOperationOpRename:
+		return []byte(s), nil
+	case FileBatchOperationOpDelete:
+		return []byte(s), nil
+	case FileBatchOperationOpCopy:
+		return []byte(s), nil
+	case FileBatchOperationOpTag:
+		return []byte(s), nil
+	case FileBatchOperationOpShare:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FileBatchOperationOp) UnmarshalText(data []byte) error {
+	switch FileBatchOperationOp(data) {
+	case FileBatchOperationOpMove:
+		*s = FileBatchOperationOpMove
+		return nil
+	case FileBatchOperationOpRename:
+		*s = FileBatchOperationOpRename
+		return nil
+	case FileBatchOperationOpDelete:
+		*s = FileBatchOperationOpDelete
+		return nil
+	case FileBatchOperationOpCopy:
+		*s = FileBatchOperationOpCopy
+		return nil
+	case FileBatchOperationOpTag:
+		*s = FileBatchOperationOpTag
+		return nil
+	case FileBatchOperationOpShare:
+		*s = FileBatchOperationOpShare
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Outcome of a batch of file operations.
+// Ref: #/components/schemas/FileBatchResult
+type FileBatchResult struct {
+	// Outcome of each operation, in request order.
+	Results []FileBatchItemResult `json:"results"`
+}
+
+// GetResults returns the value of Results.
+func (s *FileBatchResult) GetResults() []FileBatchItemResult {
+	return s.Results
+}
+
+// SetResults sets the value of Results.
+func (s *FileBatchResult) SetResults(val []FileBatchItemResult) {
+	s.Results = val
+}
+
+// File Copy request.
+// Ref: #/components/schemas/FileCopy
+type FileCopy struct {
+	// New file name.
+	NewName OptString `json:"newName"`
+	// Destination path for the copied file.
+	Destination string `json:"destination"`
+	// Last update time.
+	UpdatedAt OptDateTime `json:"updatedAt"`
+}
+
+// GetNewName returns the value of NewName.
+func (s *FileCopy) GetNewName() OptString {
+	return s.NewName
+}
+
+// GetDestination returns the value of Destination.
+func (s *FileCopy) GetDestination() string {
+	return s.Destination
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *FileCopy) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetNewName sets the value of NewName.
+func (s *FileCopy) SetNewName(val OptString) {
+	s.NewName = val
+}
+
+// SetDestination sets the value of Destination.
+func (s *FileCopy) SetDestination(val string) {
+	s.Destination = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *FileCopy) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// Delete operation request.
+// Ref: #/components/schemas/FileDelete
+type FileDelete struct {
+	// Array of file or folders ids to be deleted.
+	Ids []string `json:"ids"`
+}
+
+// GetIds returns the value of Ids.
+func (s *FileDelete) GetIds() []string {
+	return s.Ids
+}
+
+// SetIds sets the value of Ids.
+func (s *FileDelete) SetIds(val []string) {
+	s.Ids = val
+}
+
+// Paginated file listing response with metadata.
+// Ref: #/components/schemas/FileList
+type FileList struct {
+	// Array of file entries in the current page.
+	Items []File `json:"items"`
+	// Pagination metadata and total count information.
+	Meta Meta `json:"meta"`
+}
+
+// GetItems returns the value of Items.
+func (s *FileList) GetItems() []File {
+	return s.Items
+}
+
+// GetMeta returns the value of Meta.
+func (s *FileList) GetMeta() Meta {
+	return s.Meta
+}
+
+// SetItems sets the value of Items.
+func (s *FileList) SetItems(val []File) {
+	s.Items = val
+}
+
+// SetMeta sets the value of Meta.
+func (s *FileList) SetMeta(val Meta) {
+	s.Meta = val
+}
+
+// Request to create directories.
+// Ref: #/components/schemas/FileMkDir
+type FileMkDir struct {
+	// Directory path to be created.
+	Path string `json:"path"`
+}
+
+// GetPath returns the value of Path.
+func (s *FileMkDir) GetPath() string {
+	return s.Path
+}
+
+// SetPath sets the value of Path.
+func (s *FileMkDir) SetPath(val string) {
+	s.Path = val
+}
+
+// Move multiple files by ids or path.
+// Ref: #/components/schemas/FileMove
+type FileMove struct {
+	// Array of file or folders ids to be moved.
+	Ids []string `json:"ids"`
+	// Destination parent path or ID where files will be moved to.
+	DestinationParent string `json:"destinationParent"`
+	// Destination file or folder name.
+	DestinationName OptString `json:"destinationName"`
+}
+
+// GetIds returns the value of Ids.
+func (s *FileMove) GetIds() []string {
+	return s.Ids
+}
+
+// GetDestinationParent returns the value of DestinationParent.
+func (s *FileMove) GetDestinationParent() string {
+	return s.DestinationParent
+}
+
+// GetDestinationName returns the value of DestinationName.
+func (s *FileMove) GetDestinationName() OptString {
+	return s.DestinationName
+}
+
+// SetIds sets the value of Ids.
 func (s *FileMove) SetIds(val []string) {
 	s.Ids = val
 }
@@ -855,16 +1559,218 @@ func (s *FilePartsUpdate) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
-type FileQueryOperation string
+// Access of another user to a file or folder.
+// Ref: #/components/schemas/FilePermission
+type FilePermission struct {
+	// User the access is granted to.
+	UserId int64 `json:"userId"`
+	// Telegram username of the user.
+	UserName OptString `json:"userName"`
+	// Display name of the user.
+	Name OptString `json:"name"`
+	// Access granted to the user.
+	Role FilePermissionRole `json:"role"`
+	// Time the access was granted.
+	CreatedAt time.Time `json:"createdAt"`
+}
 
-const (
-	FileQueryOperationList FileQueryOperation = "list"
-	FileQueryOperationFind FileQueryOperation = "find"
-)
+// GetUserId returns the value of UserId.
+func (s *FilePermission) GetUserId() int64 {
+	return s.UserId
+}
 
-// AllValues returns all FileQueryOperation values.
-func (FileQueryOperation) AllValues() []FileQueryOperation {
-	return []FileQueryOperation{
+// GetUserName returns the value of UserName.
+func (s *FilePermission) GetUserName() OptString {
+	return s.UserName
+}
+
+// GetName returns the value of Name.
+func (s *FilePermission) GetName() OptString {
+	return s.Name
+}
+
+// GetRole returns the value of Role.
+func (s *FilePermission) GetRole() FilePermissionRole {
+	return s.Role
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *FilePermission) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetUserId sets the value of UserId.
+func (s *FilePermission) SetUserId(val int64) {
+	s.UserId = val
+}
+
+// SetUserName sets the value of UserName.
+func (s *FilePermission) SetUserName(val OptString) {
+	s.UserName = val
+}
+
+// SetName sets the value of Name.
+func (s *FilePermission) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetRole sets the value of Role.
+func (s *FilePermission) SetRole(val FilePermissionRole) {
+	s.Role = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *FilePermission) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Grant access to another user.
+// Ref: #/components/schemas/FilePermissionCreate
+type FilePermissionCreate struct {
+	// User to grant access to.
+	UserId OptInt64 `json:"userId"`
+	// Telegram username of the user, used when userId is not set.
+	UserName OptString `json:"userName"`
+	// Access granted to the user.
+	Role FilePermissionCreateRole `json:"role"`
+}
+
+// GetUserId returns the value of UserId.
+func (s *FilePermissionCreate) GetUserId() OptInt64 {
+	return s.UserId
+}
+
+// GetUserName returns the value of UserName.
+func (s *FilePermissionCreate) GetUserName() OptString {
+	return s.UserName
+}
+
+// GetRole returns the value of Role.
+func (s *FilePermissionCreate) GetRole() FilePermissionCreateRole {
+	return s.Role
+}
+
+// SetUserId sets the value of UserId.
+func (s *FilePermissionCreate) SetUserId(val OptInt64) {
+	s.UserId = val
+}
+
+// SetUserName sets the value of UserName.
+func (s *FilePermissionCreate) SetUserName(val OptString) {
+	s.UserName = val
+}
+
+// SetRole sets the value of Role.
+func (s *FilePermissionCreate) SetRole(val FilePermissionCreateRole) {
+	s.Role = val
+}
+
+// Access granted to the user.
+type FilePermissionCreateRole string
+
+const (
+	FilePermissionCreateRoleViewer FilePermissionCreateRole = "viewer"
+	FilePermissionCreateRoleEditor FilePermissionCreateRole = "editor"
+)
+
+// AllValues returns all FilePermissionCreateRole values.
+func (FilePermissionCreateRole) AllValues() []FilePermissionCreateRole {
+	return []FilePermissionCreateRole{
+		FilePermissionCreateRoleViewer,
+		FilePermissionCreateRoleEditor,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FilePermissionCreateRole) MarshalText() ([]byte, error) {
+	switch s {
+	case FilePermissionCreateRoleViewer:
+		return []byte(s), nil
+	case FilePermissionCreateRoleEditor:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FilePermissionCreateRole) UnmarshalText(data []byte) error {
+	switch FilePermissionCreateRole(data) {
+	case FilePermissionCreateRoleViewer:
+		*s = FilePermissionCreateRoleViewer
+		return nil
+	case FilePermissionCreateRoleEditor:
+		*s = FilePermissionCreateRoleEditor
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Access granted to the user.
+type FilePermissionRole string
+
+const (
+	FilePermissionRoleViewer FilePermissionRole = "viewer"
+	FilePermissionRoleEditor FilePermissionRole = "editor"
+)
+
+// AllValues returns all FilePermissionRole values.
+func (FilePermissionRole) AllValues() []FilePermissionRole {
+	return []FilePermissionRole{
+		FilePermissionRoleViewer,
+		FilePermissionRoleEditor,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FilePermissionRole) MarshalText() ([]byte, error) {
+	switch s {
+	case FilePermissionRoleViewer:
+		return []byte(s), nil
+	case FilePermissionRoleEditor:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FilePermissionRole) UnmarshalText(data []byte) error {
+	switch FilePermissionRole(data) {
+	case FilePermissionRoleViewer:
+		*s = FilePermissionRoleViewer
+		return nil
+	case FilePermissionRoleEditor:
+		*s = FilePermissionRoleEditor
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Custom metadata.
+type FileProperties map[string]jx.Raw
+
+func (s *FileProperties) init() FileProperties {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type FileQueryOperation string
+
+const (
+	FileQueryOperationList FileQueryOperation = "list"
+	FileQueryOperationFind FileQueryOperation = "find"
+)
+
+// AllValues returns all FileQueryOperation values.
+func (FileQueryOperation) AllValues() []FileQueryOperation {
+	return []FileQueryOperation{
 		FileQueryOperationList,
 		FileQueryOperationFind,
 	}
@@ -940,8 +1846,9 @@ func (s *FileQueryOrder) UnmarshalText(data []byte) error {
 type FileQuerySearchType string
 
 const (
-	FileQuerySearchTypeText  FileQuerySearchType = "text"
-	FileQuerySearchTypeRegex FileQuerySearchType = "regex"
+	FileQuerySearchTypeText    FileQuerySearchType = "text"
+	FileQuerySearchTypeRegex   FileQuerySearchType = "regex"
+	FileQuerySearchTypeContent FileQuerySearchType = "content"
 )
 
 // AllValues returns all FileQuerySearchType values.
@@ -949,6 +1856,7 @@ func (FileQuerySearchType) AllValues() []FileQuerySearchType {
 	return []FileQuerySearchType{
 		FileQuerySearchTypeText,
 		FileQuerySearchTypeRegex,
+		FileQuerySearchTypeContent,
 	}
 }
 
@@ -959,6 +1867,8 @@ func (s FileQuerySearchType) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case FileQuerySearchTypeRegex:
 		return []byte(s), nil
+	case FileQuerySearchTypeContent:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -973,6 +1883,9 @@ func (s *FileQuerySearchType) UnmarshalText(data []byte) error {
 	case FileQuerySearchTypeRegex:
 		*s = FileQuerySearchTypeRegex
 		return nil
+	case FileQuerySearchTypeContent:
+		*s = FileQuerySearchTypeContent
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -985,6 +1898,7 @@ const (
 	FileQuerySortUpdatedAt FileQuerySort = "updatedAt"
 	FileQuerySortSize      FileQuerySort = "size"
 	FileQuerySortID        FileQuerySort = "id"
+	FileQuerySortTakenAt   FileQuerySort = "takenAt"
 )
 
 // AllValues returns all FileQuerySort values.
@@ -994,6 +1908,7 @@ func (FileQuerySort) AllValues() []FileQuerySort {
 		FileQuerySortUpdatedAt,
 		FileQuerySortSize,
 		FileQuerySortID,
+		FileQuerySortTakenAt,
 	}
 }
 
@@ -1008,6 +1923,8 @@ func (s FileQuerySort) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case FileQuerySortID:
 		return []byte(s), nil
+	case FileQuerySortTakenAt:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -1028,6 +1945,9 @@ func (s *FileQuerySort) UnmarshalText(data []byte) error {
 	case FileQuerySortID:
 		*s = FileQuerySortID
 		return nil
+	case FileQuerySortTakenAt:
+		*s = FileQuerySortTakenAt
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -1074,6 +1994,47 @@ func (s *FileQueryStatus) UnmarshalText(data []byte) error {
 	}
 }
 
+type FileQueryTagMatch string
+
+const (
+	FileQueryTagMatchAny FileQueryTagMatch = "any"
+	FileQueryTagMatchAll FileQueryTagMatch = "all"
+)
+
+// AllValues returns all FileQueryTagMatch values.
+func (FileQueryTagMatch) AllValues() []FileQueryTagMatch {
+	return []FileQueryTagMatch{
+		FileQueryTagMatchAny,
+		FileQueryTagMatchAll,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FileQueryTagMatch) MarshalText() ([]byte, error) {
+	switch s {
+	case FileQueryTagMatchAny:
+		return []byte(s), nil
+	case FileQueryTagMatchAll:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FileQueryTagMatch) UnmarshalText(data []byte) error {
+	switch FileQueryTagMatch(data) {
+	case FileQueryTagMatchAny:
+		*s = FileQueryTagMatchAny
+		return nil
+	case FileQueryTagMatchAll:
+		*s = FileQueryTagMatchAll
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 type FileQueryType string
 
 const (
@@ -1130,6 +2091,28 @@ type FileShare struct {
 	Name string `json:"name"`
 	// Expiration date and time of the share link.
 	ExpiresAt OptDateTime `json:"expiresAt"`
+	// Number of downloads after which the share is disabled.
+	MaxDownloads OptInt64 `json:"maxDownloads"`
+	// Number of distinct client IPs after which the share is disabled.
+	MaxIps OptInt64 `json:"maxIps"`
+	// Number of downloads served so far.
+	Downloads OptInt64 `json:"downloads"`
+	// Indicates if the share was disabled after reaching a limit.
+	Disabled OptBool `json:"disabled"`
+	// Limit that disabled the share.
+	DisabledReason OptString `json:"disabledReason"`
+	// Share mode, upload shares accept anonymous uploads into the shared folder.
+	Mode OptFileShareMode `json:"mode"`
+	// File extensions accepted by an upload share.
+	AllowedExtensions []string `json:"allowedExtensions"`
+	// Largest file accepted by an upload share in bytes.
+	MaxFileSize OptInt64 `json:"maxFileSize"`
+	// Number of files after which an upload share is disabled.
+	MaxFiles OptInt64 `json:"maxFiles"`
+	// Number of files received by an upload share.
+	UploadedFiles OptInt64 `json:"uploadedFiles"`
+	// Files and folders included in a multi-file share.
+	FileIds []string `json:"fileIds"`
 }
 
 // GetID returns the value of ID.
@@ -1162,6 +2145,61 @@ func (s *FileShare) GetExpiresAt() OptDateTime {
 	return s.ExpiresAt
 }
 
+// GetMaxDownloads returns the value of MaxDownloads.
+func (s *FileShare) GetMaxDownloads() OptInt64 {
+	return s.MaxDownloads
+}
+
+// GetMaxIps returns the value of MaxIps.
+func (s *FileShare) GetMaxIps() OptInt64 {
+	return s.MaxIps
+}
+
+// GetDownloads returns the value of Downloads.
+func (s *FileShare) GetDownloads() OptInt64 {
+	return s.Downloads
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *FileShare) GetDisabled() OptBool {
+	return s.Disabled
+}
+
+// GetDisabledReason returns the value of DisabledReason.
+func (s *FileShare) GetDisabledReason() OptString {
+	return s.DisabledReason
+}
+
+// GetMode returns the value of Mode.
+func (s *FileShare) GetMode() OptFileShareMode {
+	return s.Mode
+}
+
+// GetAllowedExtensions returns the value of AllowedExtensions.
+func (s *FileShare) GetAllowedExtensions() []string {
+	return s.AllowedExtensions
+}
+
+// GetMaxFileSize returns the value of MaxFileSize.
+func (s *FileShare) GetMaxFileSize() OptInt64 {
+	return s.MaxFileSize
+}
+
+// GetMaxFiles returns the value of MaxFiles.
+func (s *FileShare) GetMaxFiles() OptInt64 {
+	return s.MaxFiles
+}
+
+// GetUploadedFiles returns the value of UploadedFiles.
+func (s *FileShare) GetUploadedFiles() OptInt64 {
+	return s.UploadedFiles
+}
+
+// GetFileIds returns the value of FileIds.
+func (s *FileShare) GetFileIds() []string {
+	return s.FileIds
+}
+
 // SetID sets the value of ID.
 func (s *FileShare) SetID(val string) {
 	s.ID = val
@@ -1192,6 +2230,61 @@ func (s *FileShare) SetExpiresAt(val OptDateTime) {
 	s.ExpiresAt = val
 }
 
+// SetMaxDownloads sets the value of MaxDownloads.
+func (s *FileShare) SetMaxDownloads(val OptInt64) {
+	s.MaxDownloads = val
+}
+
+// SetMaxIps sets the value of MaxIps.
+func (s *FileShare) SetMaxIps(val OptInt64) {
+	s.MaxIps = val
+}
+
+// SetDownloads sets the value of Downloads.
+func (s *FileShare) SetDownloads(val OptInt64) {
+	s.Downloads = val
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *FileShare) SetDisabled(val OptBool) {
+	s.Disabled = val
+}
+
+// SetDisabledReason sets the value of DisabledReason.
+func (s *FileShare) SetDisabledReason(val OptString) {
+	s.DisabledReason = val
+}
+
+// SetMode sets the value of Mode.
+func (s *FileShare) SetMode(val OptFileShareMode) {
+	s.Mode = val
+}
+
+// SetAllowedExtensions sets the value of AllowedExtensions.
+func (s *FileShare) SetAllowedExtensions(val []string) {
+	s.AllowedExtensions = val
+}
+
+// SetMaxFileSize sets the value of MaxFileSize.
+func (s *FileShare) SetMaxFileSize(val OptInt64) {
+	s.MaxFileSize = val
+}
+
+// SetMaxFiles sets the value of MaxFiles.
+func (s *FileShare) SetMaxFiles(val OptInt64) {
+	s.MaxFiles = val
+}
+
+// SetUploadedFiles sets the value of UploadedFiles.
+func (s *FileShare) SetUploadedFiles(val OptInt64) {
+	s.UploadedFiles = val
+}
+
+// SetFileIds sets the value of FileIds.
+func (s *FileShare) SetFileIds(val []string) {
+	s.FileIds = val
+}
+
 // File share creation request.
 // Ref: #/components/schemas/FileShareCreate
 type FileShareCreate struct {
@@ -1199,6 +2292,18 @@ type FileShareCreate struct {
 	Password OptString `json:"password"`
 	// Share expiration date.
 	ExpiresAt OptDateTime `json:"expiresAt"`
+	// Number of downloads after which the share is disabled.
+	MaxDownloads OptInt64 `json:"maxDownloads"`
+	// Number of distinct client IPs after which the share is disabled.
+	MaxIps OptInt64 `json:"maxIps"`
+	// Share mode, upload shares accept anonymous uploads into the shared folder.
+	Mode OptFileShareCreateMode `json:"mode"`
+	// File extensions accepted by an upload share.
+	AllowedExtensions []string `json:"allowedExtensions"`
+	// Largest file accepted by an upload share in bytes.
+	MaxFileSize OptInt64 `json:"maxFileSize"`
+	// Number of files after which an upload share is disabled.
+	MaxFiles OptInt64 `json:"maxFiles"`
 }
 
 // GetPassword returns the value of Password.
@@ -1211,6 +2316,36 @@ func (s *FileShareCreate) GetExpiresAt() OptDateTime {
 	return s.ExpiresAt
 }
 
+// GetMaxDownloads returns the value of MaxDownloads.
+func (s *FileShareCreate) GetMaxDownloads() OptInt64 {
+	return s.MaxDownloads
+}
+
+// GetMaxIps returns the value of MaxIps.
+func (s *FileShareCreate) GetMaxIps() OptInt64 {
+	return s.MaxIps
+}
+
+// GetMode returns the value of Mode.
+func (s *FileShareCreate) GetMode() OptFileShareCreateMode {
+	return s.Mode
+}
+
+// GetAllowedExtensions returns the value of AllowedExtensions.
+func (s *FileShareCreate) GetAllowedExtensions() []string {
+	return s.AllowedExtensions
+}
+
+// GetMaxFileSize returns the value of MaxFileSize.
+func (s *FileShareCreate) GetMaxFileSize() OptInt64 {
+	return s.MaxFileSize
+}
+
+// GetMaxFiles returns the value of MaxFiles.
+func (s *FileShareCreate) GetMaxFiles() OptInt64 {
+	return s.MaxFiles
+}
+
 // SetPassword sets the value of Password.
 func (s *FileShareCreate) SetPassword(val OptString) {
 	s.Password = val
@@ -1221,6 +2356,78 @@ func (s *FileShareCreate) SetExpiresAt(val OptDateTime) {
 	s.ExpiresAt = val
 }
 
+// SetMaxDownloads sets the value of MaxDownloads.
+func (s *FileShareCreate) SetMaxDownloads(val OptInt64) {
+	s.MaxDownloads = val
+}
+
+// SetMaxIps sets the value of MaxIps.
+func (s *FileShareCreate) SetMaxIps(val OptInt64) {
+	s.MaxIps = val
+}
+
+// SetMode sets the value of Mode.
+func (s *FileShareCreate) SetMode(val OptFileShareCreateMode) {
+	s.Mode = val
+}
+
+// SetAllowedExtensions sets the value of AllowedExtensions.
+func (s *FileShareCreate) SetAllowedExtensions(val []string) {
+	s.AllowedExtensions = val
+}
+
+// SetMaxFileSize sets the value of MaxFileSize.
+func (s *FileShareCreate) SetMaxFileSize(val OptInt64) {
+	s.MaxFileSize = val
+}
+
+// SetMaxFiles sets the value of MaxFiles.
+func (s *FileShareCreate) SetMaxFiles(val OptInt64) {
+	s.MaxFiles = val
+}
+
+// Share mode, upload shares accept anonymous uploads into the shared folder.
+type FileShareCreateMode string
+
+const (
+	FileShareCreateModeDownload FileShareCreateMode = "download"
+	FileShareCreateModeUpload   FileShareCreateMode = "upload"
+)
+
+// AllValues returns all FileShareCreateMode values.
+func (FileShareCreateMode) AllValues() []FileShareCreateMode {
+	return []FileShareCreateMode{
+		FileShareCreateModeDownload,
+		FileShareCreateModeUpload,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FileShareCreateMode) MarshalText() ([]byte, error) {
+	switch s {
+	case FileShareCreateModeDownload:
+		return []byte(s), nil
+	case FileShareCreateModeUpload:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FileShareCreateMode) UnmarshalText(data []byte) error {
+	switch FileShareCreateMode(data) {
+	case FileShareCreateModeDownload:
+		*s = FileShareCreateModeDownload
+		return nil
+	case FileShareCreateModeUpload:
+		*s = FileShareCreateModeUpload
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/FileShareInfo
 type FileShareInfo struct {
 	// File name.
@@ -1233,6 +2440,14 @@ type FileShareInfo struct {
 	UserId int64 `json:"userId"`
 	// Share Protection Status.
 	Protected bool `json:"protected"`
+	// Share mode, upload shares accept anonymous uploads into the shared folder.
+	Mode OptFileShareInfoMode `json:"mode"`
+	// File extensions accepted by an upload share.
+	AllowedExtensions []string `json:"allowedExtensions"`
+	// Largest file accepted by an upload share in bytes.
+	MaxFileSize OptInt64 `json:"maxFileSize"`
+	// Number of items in a multi-file share.
+	ItemCount OptInt `json:"itemCount"`
 }
 
 // GetName returns the value of Name.
@@ -1260,6 +2475,26 @@ func (s *FileShareInfo) GetProtected() bool {
 	return s.Protected
 }
 
+// GetMode returns the value of Mode.
+func (s *FileShareInfo) GetMode() OptFileShareInfoMode {
+	return s.Mode
+}
+
+// GetAllowedExtensions returns the value of AllowedExtensions.
+func (s *FileShareInfo) GetAllowedExtensions() []string {
+	return s.AllowedExtensions
+}
+
+// GetMaxFileSize returns the value of MaxFileSize.
+func (s *FileShareInfo) GetMaxFileSize() OptInt64 {
+	return s.MaxFileSize
+}
+
+// GetItemCount returns the value of ItemCount.
+func (s *FileShareInfo) GetItemCount() OptInt {
+	return s.ItemCount
+}
+
 // SetName sets the value of Name.
 func (s *FileShareInfo) SetName(val string) {
 	s.Name = val
@@ -1285,17 +2520,79 @@ func (s *FileShareInfo) SetProtected(val bool) {
 	s.Protected = val
 }
 
-// File type.
-type FileShareInfoType string
+// SetMode sets the value of Mode.
+func (s *FileShareInfo) SetMode(val OptFileShareInfoMode) {
+	s.Mode = val
+}
 
-const (
-	FileShareInfoTypeFolder FileShareInfoType = "folder"
-	FileShareInfoTypeFile   FileShareInfoType = "file"
-)
+// SetAllowedExtensions sets the value of AllowedExtensions.
+func (s *FileShareInfo) SetAllowedExtensions(val []string) {
+	s.AllowedExtensions = val
+}
 
-// AllValues returns all FileShareInfoType values.
-func (FileShareInfoType) AllValues() []FileShareInfoType {
-	return []FileShareInfoType{
+// SetMaxFileSize sets the value of MaxFileSize.
+func (s *FileShareInfo) SetMaxFileSize(val OptInt64) {
+	s.MaxFileSize = val
+}
+
+// SetItemCount sets the value of ItemCount.
+func (s *FileShareInfo) SetItemCount(val OptInt) {
+	s.ItemCount = val
+}
+
+// Share mode, upload shares accept anonymous uploads into the shared folder.
+type FileShareInfoMode string
+
+const (
+	FileShareInfoModeDownload FileShareInfoMode = "download"
+	FileShareInfoModeUpload   FileShareInfoMode = "upload"
+)
+
+// AllValues returns all FileShareInfoMode values.
+func (FileShareInfoMode) AllValues() []FileShareInfoMode {
+	return []FileShareInfoMode{
+		FileShareInfoModeDownload,
+		FileShareInfoModeUpload,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FileShareInfoMode) MarshalText() ([]byte, error) {
+	switch s {
+	case FileShareInfoModeDownload:
+		return []byte(s), nil
+	case FileShareInfoModeUpload:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FileShareInfoMode) UnmarshalText(data []byte) error {
+	switch FileShareInfoMode(data) {
+	case FileShareInfoModeDownload:
+		*s = FileShareInfoModeDownload
+		return nil
+	case FileShareInfoModeUpload:
+		*s = FileShareInfoModeUpload
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// File type.
+type FileShareInfoType string
+
+const (
+	FileShareInfoTypeFolder FileShareInfoType = "folder"
+	FileShareInfoTypeFile   FileShareInfoType = "file"
+)
+
+// AllValues returns all FileShareInfoType values.
+func (FileShareInfoType) AllValues() []FileShareInfoType {
+	return []FileShareInfoType{
 		FileShareInfoTypeFolder,
 		FileShareInfoTypeFile,
 	}
@@ -1327,6 +2624,48 @@ func (s *FileShareInfoType) UnmarshalText(data []byte) error {
 	}
 }
 
+// Share mode, upload shares accept anonymous uploads into the shared folder.
+type FileShareMode string
+
+const (
+	FileShareModeDownload FileShareMode = "download"
+	FileShareModeUpload   FileShareMode = "upload"
+)
+
+// AllValues returns all FileShareMode values.
+func (FileShareMode) AllValues() []FileShareMode {
+	return []FileShareMode{
+		FileShareModeDownload,
+		FileShareModeUpload,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FileShareMode) MarshalText() ([]byte, error) {
+	switch s {
+	case FileShareModeDownload:
+		return []byte(s), nil
+	case FileShareModeUpload:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FileShareMode) UnmarshalText(data []byte) error {
+	switch FileShareMode(data) {
+	case FileShareModeDownload:
+		*s = FileShareModeDownload
+		return nil
+	case FileShareModeUpload:
+		*s = FileShareModeUpload
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // File type.
 type FileShareType string
 
@@ -1369,6 +2708,47 @@ func (s *FileShareType) UnmarshalText(data []byte) error {
 	}
 }
 
+// Bulk tag update.
+// Ref: #/components/schemas/FileTagsUpdate
+type FileTagsUpdate struct {
+	// Files to update.
+	Ids []string `json:"ids"`
+	// Tag names to add, missing tags are created.
+	Add []string `json:"add"`
+	// Tag names to remove.
+	Remove []string `json:"remove"`
+}
+
+// GetIds returns the value of Ids.
+func (s *FileTagsUpdate) GetIds() []string {
+	return s.Ids
+}
+
+// GetAdd returns the value of Add.
+func (s *FileTagsUpdate) GetAdd() []string {
+	return s.Add
+}
+
+// GetRemove returns the value of Remove.
+func (s *FileTagsUpdate) GetRemove() []string {
+	return s.Remove
+}
+
+// SetIds sets the value of Ids.
+func (s *FileTagsUpdate) SetIds(val []string) {
+	s.Ids = val
+}
+
+// SetAdd sets the value of Add.
+func (s *FileTagsUpdate) SetAdd(val []string) {
+	s.Add = val
+}
+
+// SetRemove sets the value of Remove.
+func (s *FileTagsUpdate) SetRemove(val []string) {
+	s.Remove = val
+}
+
 // File type.
 type FileType string
 
@@ -1422,6 +2802,8 @@ type FileUpdate struct {
 	Size OptInt64 `json:"size"`
 	// Last update time.
 	UpdatedAt OptDateTime `json:"updatedAt"`
+	// Properties to merge into the existing ones, a null value removes the key.
+	Properties OptFileUpdateProperties `json:"properties"`
 }
 
 // GetName returns the value of Name.
@@ -1444,6 +2826,11 @@ func (s *FileUpdate) GetUpdatedAt() OptDateTime {
 	return s.UpdatedAt
 }
 
+// GetProperties returns the value of Properties.
+func (s *FileUpdate) GetProperties() OptFileUpdateProperties {
+	return s.Properties
+}
+
 // SetName sets the value of Name.
 func (s *FileUpdate) SetName(val OptString) {
 	s.Name = val
@@ -1464,6 +2851,23 @@ func (s *FileUpdate) SetUpdatedAt(val OptDateTime) {
 	s.UpdatedAt = val
 }
 
+// SetProperties sets the value of Properties.
+func (s *FileUpdate) SetProperties(val OptFileUpdateProperties) {
+	s.Properties = val
+}
+
+// Properties to merge into the existing ones, a null value removes the key.
+type FileUpdateProperties map[string]jx.Raw
+
+func (s *FileUpdateProperties) init() FileUpdateProperties {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
 // FilesCreateShareCreated is response for FilesCreateShare operation.
 type FilesCreateShareCreated struct{}
 
@@ -1482,6 +2886,12 @@ type FilesMkdirNoContent struct{}
 // FilesMoveNoContent is response for FilesMove operation.
 type FilesMoveNoContent struct{}
 
+// FilesRevokePermissionNoContent is response for FilesRevokePermission operation.
+type FilesRevokePermissionNoContent struct{}
+
+// FilesStarNoContent is response for FilesStar operation.
+type FilesStarNoContent struct{}
+
 type FilesStreamDownload string
 
 const (
@@ -1807,450 +3217,679 @@ func (s *FilesStreamPartialContentHeaders) SetResponse(val FilesStreamPartialCon
 
 func (*FilesStreamPartialContentHeaders) filesStreamRes() {}
 
-// FilesUpdatePartsNoContent is response for FilesUpdateParts operation.
-type FilesUpdatePartsNoContent struct{}
+type FilesThumbnailOK struct {
+	Data io.Reader
+}
 
-// Pagination metadata containing count, page information.
-// Ref: #/components/schemas/Meta
-type Meta struct {
-	// Total number of items matching the query.
-	Count int `json:"count"`
-	// Total number of available pages based on limit.
-	TotalPages int `json:"totalPages"`
-	// Current page number in the pagination.
-	CurrentPage int `json:"currentPage"`
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s FilesThumbnailOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetCount returns the value of Count.
-func (s *Meta) GetCount() int {
-	return s.Count
+type FilesThumbnailSize string
+
+const (
+	FilesThumbnailSizeSmall FilesThumbnailSize = "small"
+	FilesThumbnailSizeLarge FilesThumbnailSize = "large"
+)
+
+// AllValues returns all FilesThumbnailSize values.
+func (FilesThumbnailSize) AllValues() []FilesThumbnailSize {
+	return []FilesThumbnailSize{
+		FilesThumbnailSizeSmall,
+		FilesThumbnailSizeLarge,
+	}
 }
 
-// GetTotalPages returns the value of TotalPages.
-func (s *Meta) GetTotalPages() int {
-	return s.TotalPages
+// MarshalText implements encoding.TextMarshaler.
+func (s FilesThumbnailSize) MarshalText() ([]byte, error) {
+	switch s {
+	case FilesThumbnailSizeSmall:
+		return []byte(s), nil
+	case FilesThumbnailSizeLarge:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetCurrentPage returns the value of CurrentPage.
-func (s *Meta) GetCurrentPage() int {
-	return s.CurrentPage
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FilesThumbnailSize) UnmarshalText(data []byte) error {
+	switch FilesThumbnailSize(data) {
+	case FilesThumbnailSizeSmall:
+		*s = FilesThumbnailSizeSmall
+		return nil
+	case FilesThumbnailSizeLarge:
+		*s = FilesThumbnailSizeLarge
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetCount sets the value of Count.
-func (s *Meta) SetCount(val int) {
-	s.Count = val
+// FilesUnstarNoContent is response for FilesUnstar operation.
+type FilesUnstarNoContent struct{}
+
+// FilesUpdatePartsNoContent is response for FilesUpdateParts operation.
+type FilesUpdatePartsNoContent struct{}
+
+// FilesUpdateTagsNoContent is response for FilesUpdateTags operation.
+type FilesUpdateTagsNoContent struct{}
+
+// Background job.
+// Ref: #/components/schemas/Job
+type Job struct {
+	// Job ID.
+	ID string `json:"id"`
+	// Job kind.
+	Kind string `json:"kind"`
+	// Job status.
+	Status JobStatus `json:"status"`
+	// Number of items to process.
+	Total int64 `json:"total"`
+	// Number of items processed so far.
+	Done int64 `json:"done"`
+	// Failure reason.
+	Error OptString `json:"error"`
+	// Number of times the job was started.
+	Attempt int `json:"attempt"`
+	// Creation time.
+	CreatedAt time.Time `json:"createdAt"`
+	// Last progress update.
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// SetTotalPages sets the value of TotalPages.
-func (s *Meta) SetTotalPages(val int) {
-	s.TotalPages = val
+// GetID returns the value of ID.
+func (s *Job) GetID() string {
+	return s.ID
 }
 
-// SetCurrentPage sets the value of CurrentPage.
-func (s *Meta) SetCurrentPage(val int) {
-	s.CurrentPage = val
+// GetKind returns the value of Kind.
+func (s *Job) GetKind() string {
+	return s.Kind
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// GetStatus returns the value of Status.
+func (s *Job) GetStatus() JobStatus {
+	return s.Status
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetTotal returns the value of Total.
+func (s *Job) GetTotal() int64 {
+	return s.Total
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// GetDone returns the value of Done.
+func (s *Job) GetDone() int64 {
+	return s.Done
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetError returns the value of Error.
+func (s *Job) GetError() OptString {
+	return s.Error
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetAttempt returns the value of Attempt.
+func (s *Job) GetAttempt() int {
+	return s.Attempt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Job) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Job) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
-// NewOptCategory returns new OptCategory with value set to v.
-func NewOptCategory(v Category) OptCategory {
-	return OptCategory{
-		Value: v,
-		Set:   true,
-	}
+// SetID sets the value of ID.
+func (s *Job) SetID(val string) {
+	s.ID = val
 }
 
-// OptCategory is optional Category.
-type OptCategory struct {
-	Value Category
-	Set   bool
+// SetKind sets the value of Kind.
+func (s *Job) SetKind(val string) {
+	s.Kind = val
 }
 
-// IsSet returns true if OptCategory was set.
-func (o OptCategory) IsSet() bool { return o.Set }
+// SetStatus sets the value of Status.
+func (s *Job) SetStatus(val JobStatus) {
+	s.Status = val
+}
 
-// Reset unsets value.
-func (o *OptCategory) Reset() {
-	var v Category
-	o.Value = v
-	o.Set = false
+// SetTotal sets the value of Total.
+func (s *Job) SetTotal(val int64) {
+	s.Total = val
 }
 
-// SetTo sets value to v.
-func (o *OptCategory) SetTo(v Category) {
-	o.Set = true
-	o.Value = v
+// SetDone sets the value of Done.
+func (s *Job) SetDone(val int64) {
+	s.Done = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCategory) Get() (v Category, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetError sets the value of Error.
+func (s *Job) SetError(val OptString) {
+	s.Error = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCategory) Or(d Category) Category {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetAttempt sets the value of Attempt.
+func (s *Job) SetAttempt(val int) {
+	s.Attempt = val
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Job) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Job) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// Job status.
+type JobStatus string
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
-}
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCancelled JobStatus = "cancelled"
+)
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// AllValues returns all JobStatus values.
+func (JobStatus) AllValues() []JobStatus {
+	return []JobStatus{
+		JobStatusPending,
+		JobStatusRunning,
+		JobStatusCompleted,
+		JobStatusFailed,
+		JobStatusCancelled,
+	}
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
+// MarshalText implements encoding.TextMarshaler.
+func (s JobStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case JobStatusPending:
+		return []byte(s), nil
+	case JobStatusRunning:
+		return []byte(s), nil
+	case JobStatusCompleted:
+		return []byte(s), nil
+	case JobStatusFailed:
+		return []byte(s), nil
+	case JobStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *JobStatus) UnmarshalText(data []byte) error {
+	switch JobStatus(data) {
+	case JobStatusPending:
+		*s = JobStatusPending
+		return nil
+	case JobStatusRunning:
+		*s = JobStatusRunning
+		return nil
+	case JobStatusCompleted:
+		*s = JobStatusCompleted
+		return nil
+	case JobStatusFailed:
+		*s = JobStatusFailed
+		return nil
+	case JobStatusCancelled:
+		*s = JobStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
-	return d
 }
 
-// NewOptFileQueryOperation returns new OptFileQueryOperation with value set to v.
-func NewOptFileQueryOperation(v FileQueryOperation) OptFileQueryOperation {
-	return OptFileQueryOperation{
-		Value: v,
-		Set:   true,
+type JobsListStatus string
+
+const (
+	JobsListStatusPending   JobsListStatus = "pending"
+	JobsListStatusRunning   JobsListStatus = "running"
+	JobsListStatusCompleted JobsListStatus = "completed"
+	JobsListStatusFailed    JobsListStatus = "failed"
+	JobsListStatusCancelled JobsListStatus = "cancelled"
+)
+
+// AllValues returns all JobsListStatus values.
+func (JobsListStatus) AllValues() []JobsListStatus {
+	return []JobsListStatus{
+		JobsListStatusPending,
+		JobsListStatusRunning,
+		JobsListStatusCompleted,
+		JobsListStatusFailed,
+		JobsListStatusCancelled,
 	}
 }
 
-// OptFileQueryOperation is optional FileQueryOperation.
-type OptFileQueryOperation struct {
-	Value FileQueryOperation
-	Set   bool
+// MarshalText implements encoding.TextMarshaler.
+func (s JobsListStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case JobsListStatusPending:
+		return []byte(s), nil
+	case JobsListStatusRunning:
+		return []byte(s), nil
+	case JobsListStatusCompleted:
+		return []byte(s), nil
+	case JobsListStatusFailed:
+		return []byte(s), nil
+	case JobsListStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// IsSet returns true if OptFileQueryOperation was set.
-func (o OptFileQueryOperation) IsSet() bool { return o.Set }
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *JobsListStatus) UnmarshalText(data []byte) error {
+	switch JobsListStatus(data) {
+	case JobsListStatusPending:
+		*s = JobsListStatusPending
+		return nil
+	case JobsListStatusRunning:
+		*s = JobsListStatusRunning
+		return nil
+	case JobsListStatusCompleted:
+		*s = JobsListStatusCompleted
+		return nil
+	case JobsListStatusFailed:
+		*s = JobsListStatusFailed
+		return nil
+	case JobsListStatusCancelled:
+		*s = JobsListStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-// Reset unsets value.
-func (o *OptFileQueryOperation) Reset() {
-	var v FileQueryOperation
-	o.Value = v
-	o.Set = false
+// Metadata read from the headers of photos, videos and audio files.
+// Ref: #/components/schemas/MediaInfo
+type MediaInfo struct {
+	// Capture time.
+	TakenAt OptDateTime `json:"takenAt"`
+	// Camera maker.
+	CameraMake OptString `json:"cameraMake"`
+	// Camera model.
+	CameraModel OptString `json:"cameraModel"`
+	// Latitude of the capture location.
+	Latitude OptFloat64 `json:"latitude"`
+	// Longitude of the capture location.
+	Longitude OptFloat64 `json:"longitude"`
+	// Duration in seconds.
+	Duration OptFloat64 `json:"duration"`
+	// Width in pixels.
+	Width OptInt `json:"width"`
+	// Height in pixels.
+	Height OptInt `json:"height"`
+	// Video codec.
+	VideoCodec OptString `json:"videoCodec"`
+	// Audio codec.
+	AudioCodec OptString `json:"audioCodec"`
+	// Title tag.
+	Title OptString `json:"title"`
+	// Artist tag.
+	Artist OptString `json:"artist"`
+	// Album tag.
+	Album OptString `json:"album"`
+	// Release year.
+	Year OptInt `json:"year"`
+	// Track number.
+	Track OptInt `json:"track"`
+	// Genre tag.
+	Genre OptString `json:"genre"`
 }
 
-// SetTo sets value to v.
-func (o *OptFileQueryOperation) SetTo(v FileQueryOperation) {
-	o.Set = true
-	o.Value = v
+// GetTakenAt returns the value of TakenAt.
+func (s *MediaInfo) GetTakenAt() OptDateTime {
+	return s.TakenAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFileQueryOperation) Get() (v FileQueryOperation, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetCameraMake returns the value of CameraMake.
+func (s *MediaInfo) GetCameraMake() OptString {
+	return s.CameraMake
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFileQueryOperation) Or(d FileQueryOperation) FileQueryOperation {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetCameraModel returns the value of CameraModel.
+func (s *MediaInfo) GetCameraModel() OptString {
+	return s.CameraModel
 }
 
-// NewOptFileQueryOrder returns new OptFileQueryOrder with value set to v.
-func NewOptFileQueryOrder(v FileQueryOrder) OptFileQueryOrder {
-	return OptFileQueryOrder{
-		Value: v,
-		Set:   true,
-	}
+// GetLatitude returns the value of Latitude.
+func (s *MediaInfo) GetLatitude() OptFloat64 {
+	return s.Latitude
 }
 
-// OptFileQueryOrder is optional FileQueryOrder.
-type OptFileQueryOrder struct {
-	Value FileQueryOrder
-	Set   bool
+// GetLongitude returns the value of Longitude.
+func (s *MediaInfo) GetLongitude() OptFloat64 {
+	return s.Longitude
 }
 
-// IsSet returns true if OptFileQueryOrder was set.
-func (o OptFileQueryOrder) IsSet() bool { return o.Set }
+// GetDuration returns the value of Duration.
+func (s *MediaInfo) GetDuration() OptFloat64 {
+	return s.Duration
+}
 
-// Reset unsets value.
-func (o *OptFileQueryOrder) Reset() {
-	var v FileQueryOrder
-	o.Value = v
-	o.Set = false
+// GetWidth returns the value of Width.
+func (s *MediaInfo) GetWidth() OptInt {
+	return s.Width
 }
 
-// SetTo sets value to v.
-func (o *OptFileQueryOrder) SetTo(v FileQueryOrder) {
-	o.Set = true
-	o.Value = v
+// GetHeight returns the value of Height.
+func (s *MediaInfo) GetHeight() OptInt {
+	return s.Height
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFileQueryOrder) Get() (v FileQueryOrder, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetVideoCodec returns the value of VideoCodec.
+func (s *MediaInfo) GetVideoCodec() OptString {
+	return s.VideoCodec
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFileQueryOrder) Or(d FileQueryOrder) FileQueryOrder {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetAudioCodec returns the value of AudioCodec.
+func (s *MediaInfo) GetAudioCodec() OptString {
+	return s.AudioCodec
 }
 
-// NewOptFileQuerySearchType returns new OptFileQuerySearchType with value set to v.
-func NewOptFileQuerySearchType(v FileQuerySearchType) OptFileQuerySearchType {
-	return OptFileQuerySearchType{
-		Value: v,
-		Set:   true,
-	}
+// GetTitle returns the value of Title.
+func (s *MediaInfo) GetTitle() OptString {
+	return s.Title
 }
 
-// OptFileQuerySearchType is optional FileQuerySearchType.
-type OptFileQuerySearchType struct {
-	Value FileQuerySearchType
-	Set   bool
+// GetArtist returns the value of Artist.
+func (s *MediaInfo) GetArtist() OptString {
+	return s.Artist
 }
 
-// IsSet returns true if OptFileQuerySearchType was set.
-func (o OptFileQuerySearchType) IsSet() bool { return o.Set }
+// GetAlbum returns the value of Album.
+func (s *MediaInfo) GetAlbum() OptString {
+	return s.Album
+}
 
-// Reset unsets value.
-func (o *OptFileQuerySearchType) Reset() {
-	var v FileQuerySearchType
-	o.Value = v
-	o.Set = false
+// GetYear returns the value of Year.
+func (s *MediaInfo) GetYear() OptInt {
+	return s.Year
 }
 
-// SetTo sets value to v.
-func (o *OptFileQuerySearchType) SetTo(v FileQuerySearchType) {
-	o.Set = true
-	o.Value = v
+// GetTrack returns the value of Track.
+func (s *MediaInfo) GetTrack() OptInt {
+	return s.Track
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFileQuerySearchType) Get() (v FileQuerySearchType, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetGenre returns the value of Genre.
+func (s *MediaInfo) GetGenre() OptString {
+	return s.Genre
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFileQuerySearchType) Or(d FileQuerySearchType) FileQuerySearchType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetTakenAt sets the value of TakenAt.
+func (s *MediaInfo) SetTakenAt(val OptDateTime) {
+	s.TakenAt = val
 }
 
-// NewOptFileQuerySort returns new OptFileQuerySort with value set to v.
-func NewOptFileQuerySort(v FileQuerySort) OptFileQuerySort {
-	return OptFileQuerySort{
-		Value: v,
-		Set:   true,
-	}
+// SetCameraMake sets the value of CameraMake.
+func (s *MediaInfo) SetCameraMake(val OptString) {
+	s.CameraMake = val
 }
 
-// OptFileQuerySort is optional FileQuerySort.
-type OptFileQuerySort struct {
-	Value FileQuerySort
-	Set   bool
+// SetCameraModel sets the value of CameraModel.
+func (s *MediaInfo) SetCameraModel(val OptString) {
+	s.CameraModel = val
 }
 
-// IsSet returns true if OptFileQuerySort was set.
-func (o OptFileQuerySort) IsSet() bool { return o.Set }
+// SetLatitude sets the value of Latitude.
+func (s *MediaInfo) SetLatitude(val OptFloat64) {
+	s.Latitude = val
+}
 
-// Reset unsets value.
-func (o *OptFileQuerySort) Reset() {
-	var v FileQuerySort
-	o.Value = v
-	o.Set = false
+// SetLongitude sets the value of Longitude.
+func (s *MediaInfo) SetLongitude(val OptFloat64) {
+	s.Longitude = val
 }
 
-// SetTo sets value to v.
-func (o *OptFileQuerySort) SetTo(v FileQuerySort) {
-	o.Set = true
-	o.Value = v
+// SetDuration sets the value of Duration.
+func (s *MediaInfo) SetDuration(val OptFloat64) {
+	s.Duration = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFileQuerySort) Get() (v FileQuerySort, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetWidth sets the value of Width.
+func (s *MediaInfo) SetWidth(val OptInt) {
+	s.Width = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFileQuerySort) Or(d FileQuerySort) FileQuerySort {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetHeight sets the value of Height.
+func (s *MediaInfo) SetHeight(val OptInt) {
+	s.Height = val
 }
 
-// NewOptFileQueryStatus returns new OptFileQueryStatus with value set to v.
-func NewOptFileQueryStatus(v FileQueryStatus) OptFileQueryStatus {
-	return OptFileQueryStatus{
-		Value: v,
-		Set:   true,
-	}
+// SetVideoCodec sets the value of VideoCodec.
+func (s *MediaInfo) SetVideoCodec(val OptString) {
+	s.VideoCodec = val
 }
 
-// OptFileQueryStatus is optional FileQueryStatus.
-type OptFileQueryStatus struct {
-	Value FileQueryStatus
-	Set   bool
+// SetAudioCodec sets the value of AudioCodec.
+func (s *MediaInfo) SetAudioCodec(val OptString) {
+	s.AudioCodec = val
 }
 
-// IsSet returns true if OptFileQueryStatus was set.
-func (o OptFileQueryStatus) IsSet() bool { return o.Set }
+// SetTitle sets the value of Title.
+func (s *MediaInfo) SetTitle(val OptString) {
+	s.Title = val
+}
 
-// Reset unsets value.
-func (o *OptFileQueryStatus) Reset() {
-	var v FileQueryStatus
-	o.Value = v
-	o.Set = false
+// SetArtist sets the value of Artist.
+func (s *MediaInfo) SetArtist(val OptString) {
+	s.Artist = val
 }
 
-// SetTo sets value to v.
-func (o *OptFileQueryStatus) SetTo(v FileQueryStatus) {
-	o.Set = true
-	o.Value = v
+// SetAlbum sets the value of Album.
+func (s *MediaInfo) SetAlbum(val OptString) {
+	s.Album = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFileQueryStatus) Get() (v FileQueryStatus, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetYear sets the value of Year.
+func (s *MediaInfo) SetYear(val OptInt) {
+	s.Year = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFileQueryStatus) Or(d FileQueryStatus) FileQueryStatus {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetTrack sets the value of Track.
+func (s *MediaInfo) SetTrack(val OptInt) {
+	s.Track = val
 }
 
-// NewOptFileQueryType returns new OptFileQueryType with value set to v.
-func NewOptFileQueryType(v FileQueryType) OptFileQueryType {
-	return OptFileQueryType{
-		Value: v,
-		Set:   true,
-	}
+// SetGenre sets the value of Genre.
+func (s *MediaInfo) SetGenre(val OptString) {
+	s.Genre = val
 }
 
-// OptFileQueryType is optional FileQueryType.
-type OptFileQueryType struct {
-	Value FileQueryType
-	Set   bool
+// Pagination metadata containing count, page information.
+// Ref: #/components/schemas/Meta
+type Meta struct {
+	// Total number of items matching the query.
+	Count OptInt `json:"count"`
+	// Total number of available pages based on limit.
+	TotalPages OptInt `json:"totalPages"`
+	// Current page number in the pagination.
+	CurrentPage int `json:"currentPage"`
+	// Token of the next page, absent on the last page.
+	NextCursor OptString `json:"nextCursor"`
 }
 
-// IsSet returns true if OptFileQueryType was set.
-func (o OptFileQueryType) IsSet() bool { return o.Set }
+// GetCount returns the value of Count.
+func (s *Meta) GetCount() OptInt {
+	return s.Count
+}
 
-// Reset unsets value.
-func (o *OptFileQueryType) Reset() {
-	var v FileQueryType
-	o.Value = v
-	o.Set = false
+// GetTotalPages returns the value of TotalPages.
+func (s *Meta) GetTotalPages() OptInt {
+	return s.TotalPages
 }
 
-// SetTo sets value to v.
-func (o *OptFileQueryType) SetTo(v FileQueryType) {
-	o.Set = true
-	o.Value = v
+// GetCurrentPage returns the value of CurrentPage.
+func (s *Meta) GetCurrentPage() int {
+	return s.CurrentPage
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFileQueryType) Get() (v FileQueryType, ok bool) {
+// GetNextCursor returns the value of NextCursor.
+func (s *Meta) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetCount sets the value of Count.
+func (s *Meta) SetCount(val OptInt) {
+	s.Count = val
+}
+
+// SetTotalPages sets the value of TotalPages.
+func (s *Meta) SetTotalPages(val OptInt) {
+	s.TotalPages = val
+}
+
+// SetCurrentPage sets the value of CurrentPage.
+func (s *Meta) SetCurrentPage(val int) {
+	s.CurrentPage = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *Meta) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// Album of the music library.
+// Ref: #/components/schemas/MusicAlbum
+type MusicAlbum struct {
+	// Album name, empty for tracks without an album tag.
+	Name       string `json:"name"`
+	Artist     string `json:"artist"`
+	Year       OptInt `json:"year"`
+	TrackCount int64  `json:"trackCount"`
+}
+
+// GetName returns the value of Name.
+func (s *MusicAlbum) GetName() string {
+	return s.Name
+}
+
+// GetArtist returns the value of Artist.
+func (s *MusicAlbum) GetArtist() string {
+	return s.Artist
+}
+
+// GetYear returns the value of Year.
+func (s *MusicAlbum) GetYear() OptInt {
+	return s.Year
+}
+
+// GetTrackCount returns the value of TrackCount.
+func (s *MusicAlbum) GetTrackCount() int64 {
+	return s.TrackCount
+}
+
+// SetName sets the value of Name.
+func (s *MusicAlbum) SetName(val string) {
+	s.Name = val
+}
+
+// SetArtist sets the value of Artist.
+func (s *MusicAlbum) SetArtist(val string) {
+	s.Artist = val
+}
+
+// SetYear sets the value of Year.
+func (s *MusicAlbum) SetYear(val OptInt) {
+	s.Year = val
+}
+
+// SetTrackCount sets the value of TrackCount.
+func (s *MusicAlbum) SetTrackCount(val int64) {
+	s.TrackCount = val
+}
+
+// Artist of the music library.
+// Ref: #/components/schemas/MusicArtist
+type MusicArtist struct {
+	// Artist name, empty for tracks without an artist tag.
+	Name       string `json:"name"`
+	AlbumCount int64  `json:"albumCount"`
+	TrackCount int64  `json:"trackCount"`
+}
+
+// GetName returns the value of Name.
+func (s *MusicArtist) GetName() string {
+	return s.Name
+}
+
+// GetAlbumCount returns the value of AlbumCount.
+func (s *MusicArtist) GetAlbumCount() int64 {
+	return s.AlbumCount
+}
+
+// GetTrackCount returns the value of TrackCount.
+func (s *MusicArtist) GetTrackCount() int64 {
+	return s.TrackCount
+}
+
+// SetName sets the value of Name.
+func (s *MusicArtist) SetName(val string) {
+	s.Name = val
+}
+
+// SetAlbumCount sets the value of AlbumCount.
+func (s *MusicArtist) SetAlbumCount(val int64) {
+	s.AlbumCount = val
+}
+
+// SetTrackCount sets the value of TrackCount.
+func (s *MusicArtist) SetTrackCount(val int64) {
+	s.TrackCount = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2258,45 +3897,45 @@ func (o OptFileQueryType) Get() (v FileQueryType, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptFileQueryType) Or(d FileQueryType) FileQueryType {
+func (o OptBool) Or(d bool) bool {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptFilesStreamDownload returns new OptFilesStreamDownload with value set to v.
-func NewOptFilesStreamDownload(v FilesStreamDownload) OptFilesStreamDownload {
-	return OptFilesStreamDownload{
+// NewOptCategory returns new OptCategory with value set to v.
+func NewOptCategory(v Category) OptCategory {
+	return OptCategory{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptFilesStreamDownload is optional FilesStreamDownload.
-type OptFilesStreamDownload struct {
-	Value FilesStreamDownload
+// OptCategory is optional Category.
+type OptCategory struct {
+	Value Category
 	Set   bool
 }
 
-// IsSet returns true if OptFilesStreamDownload was set.
-func (o OptFilesStreamDownload) IsSet() bool { return o.Set }
+// IsSet returns true if OptCategory was set.
+func (o OptCategory) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptFilesStreamDownload) Reset() {
-	var v FilesStreamDownload
+func (o *OptCategory) Reset() {
+	var v Category
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptFilesStreamDownload) SetTo(v FilesStreamDownload) {
+func (o *OptCategory) SetTo(v Category) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptFilesStreamDownload) Get() (v FilesStreamDownload, ok bool) {
+func (o OptCategory) Get() (v Category, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2304,45 +3943,45 @@ func (o OptFilesStreamDownload) Get() (v FilesStreamDownload, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptFilesStreamDownload) Or(d FilesStreamDownload) FilesStreamDownload {
+func (o OptCategory) Or(d Category) Category {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptDateTime) Reset() {
+	var v time.Time
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptDateTime) SetTo(v time.Time) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptDateTime) Get() (v time.Time, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2350,45 +3989,45 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptDateTime) Or(d time.Time) time.Time {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt64 returns new OptInt64 with value set to v.
-func NewOptInt64(v int64) OptInt64 {
-	return OptInt64{
+// NewOptEncryptionFormat returns new OptEncryptionFormat with value set to v.
+func NewOptEncryptionFormat(v EncryptionFormat) OptEncryptionFormat {
+	return OptEncryptionFormat{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt64 is optional int64.
-type OptInt64 struct {
-	Value int64
+// OptEncryptionFormat is optional EncryptionFormat.
+type OptEncryptionFormat struct {
+	Value EncryptionFormat
 	Set   bool
 }
 
-// IsSet returns true if OptInt64 was set.
-func (o OptInt64) IsSet() bool { return o.Set }
+// IsSet returns true if OptEncryptionFormat was set.
+func (o OptEncryptionFormat) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt64) Reset() {
-	var v int64
+func (o *OptEncryptionFormat) Reset() {
+	var v EncryptionFormat
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt64) SetTo(v int64) {
+func (o *OptEncryptionFormat) SetTo(v EncryptionFormat) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt64) Get() (v int64, ok bool) {
+func (o OptEncryptionFormat) Get() (v EncryptionFormat, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2396,45 +4035,45 @@ func (o OptInt64) Get() (v int64, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt64) Or(d int64) int64 {
+func (o OptEncryptionFormat) Or(d EncryptionFormat) EncryptionFormat {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptShareQueryOrder returns new OptShareQueryOrder with value set to v.
-func NewOptShareQueryOrder(v ShareQueryOrder) OptShareQueryOrder {
-	return OptShareQueryOrder{
+// NewOptFile returns new OptFile with value set to v.
+func NewOptFile(v File) OptFile {
+	return OptFile{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptShareQueryOrder is optional ShareQueryOrder.
-type OptShareQueryOrder struct {
-	Value ShareQueryOrder
+// OptFile is optional File.
+type OptFile struct {
+	Value File
 	Set   bool
 }
 
-// IsSet returns true if OptShareQueryOrder was set.
-func (o OptShareQueryOrder) IsSet() bool { return o.Set }
+// IsSet returns true if OptFile was set.
+func (o OptFile) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptShareQueryOrder) Reset() {
-	var v ShareQueryOrder
+func (o *OptFile) Reset() {
+	var v File
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptShareQueryOrder) SetTo(v ShareQueryOrder) {
+func (o *OptFile) SetTo(v File) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptShareQueryOrder) Get() (v ShareQueryOrder, ok bool) {
+func (o OptFile) Get() (v File, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2442,45 +4081,45 @@ func (o OptShareQueryOrder) Get() (v ShareQueryOrder, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptShareQueryOrder) Or(d ShareQueryOrder) ShareQueryOrder {
+func (o OptFile) Or(d File) File {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptShareQuerySort returns new OptShareQuerySort with value set to v.
-func NewOptShareQuerySort(v ShareQuerySort) OptShareQuerySort {
-	return OptShareQuerySort{
+// NewOptFileProperties returns new OptFileProperties with value set to v.
+func NewOptFileProperties(v FileProperties) OptFileProperties {
+	return OptFileProperties{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptShareQuerySort is optional ShareQuerySort.
-type OptShareQuerySort struct {
-	Value ShareQuerySort
+// OptFileProperties is optional FileProperties.
+type OptFileProperties struct {
+	Value FileProperties
 	Set   bool
 }
 
-// IsSet returns true if OptShareQuerySort was set.
-func (o OptShareQuerySort) IsSet() bool { return o.Set }
+// IsSet returns true if OptFileProperties was set.
+func (o OptFileProperties) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptShareQuerySort) Reset() {
-	var v ShareQuerySort
+func (o *OptFileProperties) Reset() {
+	var v FileProperties
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptShareQuerySort) SetTo(v ShareQuerySort) {
+func (o *OptFileProperties) SetTo(v FileProperties) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptShareQuerySort) Get() (v ShareQuerySort, ok bool) {
+func (o OptFileProperties) Get() (v FileProperties, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2488,45 +4127,45 @@ func (o OptShareQuerySort) Get() (v ShareQuerySort, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptShareQuerySort) Or(d ShareQuerySort) ShareQuerySort {
+func (o OptFileProperties) Or(d FileProperties) FileProperties {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptSharesStreamDownload returns new OptSharesStreamDownload with value set to v.
-func NewOptSharesStreamDownload(v SharesStreamDownload) OptSharesStreamDownload {
-	return OptSharesStreamDownload{
+// NewOptFileQueryOperation returns new OptFileQueryOperation with value set to v.
+func NewOptFileQueryOperation(v FileQueryOperation) OptFileQueryOperation {
+	return OptFileQueryOperation{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptSharesStreamDownload is optional SharesStreamDownload.
-type OptSharesStreamDownload struct {
-	Value SharesStreamDownload
+// OptFileQueryOperation is optional FileQueryOperation.
+type OptFileQueryOperation struct {
+	Value FileQueryOperation
 	Set   bool
 }
 
-// IsSet returns true if OptSharesStreamDownload was set.
-func (o OptSharesStreamDownload) IsSet() bool { return o.Set }
+// IsSet returns true if OptFileQueryOperation was set.
+func (o OptFileQueryOperation) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptSharesStreamDownload) Reset() {
-	var v SharesStreamDownload
+func (o *OptFileQueryOperation) Reset() {
+	var v FileQueryOperation
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptSharesStreamDownload) SetTo(v SharesStreamDownload) {
+func (o *OptFileQueryOperation) SetTo(v FileQueryOperation) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptSharesStreamDownload) Get() (v SharesStreamDownload, ok bool) {
+func (o OptFileQueryOperation) Get() (v FileQueryOperation, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2534,45 +4173,45 @@ func (o OptSharesStreamDownload) Get() (v SharesStreamDownload, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptSharesStreamDownload) Or(d SharesStreamDownload) SharesStreamDownload {
+func (o OptFileQueryOperation) Or(d FileQueryOperation) FileQueryOperation {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptFileQueryOrder returns new OptFileQueryOrder with value set to v.
+func NewOptFileQueryOrder(v FileQueryOrder) OptFileQueryOrder {
+	return OptFileQueryOrder{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptFileQueryOrder is optional FileQueryOrder.
+type OptFileQueryOrder struct {
+	Value FileQueryOrder
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptFileQueryOrder was set.
+func (o OptFileQueryOrder) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptFileQueryOrder) Reset() {
+	var v FileQueryOrder
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptFileQueryOrder) SetTo(v FileQueryOrder) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptFileQueryOrder) Get() (v FileQueryOrder, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -2580,41 +4219,2047 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptFileQueryOrder) Or(d FileQueryOrder) FileQueryOrder {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// File part information.
-// Ref: #/components/schemas/Part
-type Part struct {
-	// Part ID.
-	ID int `json:"id"`
-	// Encryption salt.
-	Salt OptString `json:"salt"`
+// NewOptFileQuerySearchType returns new OptFileQuerySearchType with value set to v.
+func NewOptFileQuerySearchType(v FileQuerySearchType) OptFileQuerySearchType {
+	return OptFileQuerySearchType{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *Part) GetID() int {
-	return s.ID
+// OptFileQuerySearchType is optional FileQuerySearchType.
+type OptFileQuerySearchType struct {
+	Value FileQuerySearchType
+	Set   bool
 }
 
-// GetSalt returns the value of Salt.
-func (s *Part) GetSalt() OptString {
+// IsSet returns true if OptFileQuerySearchType was set.
+func (o OptFileQuerySearchType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileQuerySearchType) Reset() {
+	var v FileQuerySearchType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileQuerySearchType) SetTo(v FileQuerySearchType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileQuerySearchType) Get() (v FileQuerySearchType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileQuerySearchType) Or(d FileQuerySearchType) FileQuerySearchType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileQuerySort returns new OptFileQuerySort with value set to v.
+func NewOptFileQuerySort(v FileQuerySort) OptFileQuerySort {
+	return OptFileQuerySort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileQuerySort is optional FileQuerySort.
+type OptFileQuerySort struct {
+	Value FileQuerySort
+	Set   bool
+}
+
+// IsSet returns true if OptFileQuerySort was set.
+func (o OptFileQuerySort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileQuerySort) Reset() {
+	var v FileQuerySort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileQuerySort) SetTo(v FileQuerySort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileQuerySort) Get() (v FileQuerySort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileQuerySort) Or(d FileQuerySort) FileQuerySort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileQueryStatus returns new OptFileQueryStatus with value set to v.
+func NewOptFileQueryStatus(v FileQueryStatus) OptFileQueryStatus {
+	return OptFileQueryStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileQueryStatus is optional FileQueryStatus.
+type OptFileQueryStatus struct {
+	Value FileQueryStatus
+	Set   bool
+}
+
+// IsSet returns true if OptFileQueryStatus was set.
+func (o OptFileQueryStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileQueryStatus) Reset() {
+	var v FileQueryStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileQueryStatus) SetTo(v FileQueryStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileQueryStatus) Get() (v FileQueryStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileQueryStatus) Or(d FileQueryStatus) FileQueryStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileQueryTagMatch returns new OptFileQueryTagMatch with value set to v.
+func NewOptFileQueryTagMatch(v FileQueryTagMatch) OptFileQueryTagMatch {
+	return OptFileQueryTagMatch{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileQueryTagMatch is optional FileQueryTagMatch.
+type OptFileQueryTagMatch struct {
+	Value FileQueryTagMatch
+	Set   bool
+}
+
+// IsSet returns true if OptFileQueryTagMatch was set.
+func (o OptFileQueryTagMatch) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileQueryTagMatch) Reset() {
+	var v FileQueryTagMatch
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileQueryTagMatch) SetTo(v FileQueryTagMatch) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileQueryTagMatch) Get() (v FileQueryTagMatch, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileQueryTagMatch) Or(d FileQueryTagMatch) FileQueryTagMatch {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileQueryType returns new OptFileQueryType with value set to v.
+func NewOptFileQueryType(v FileQueryType) OptFileQueryType {
+	return OptFileQueryType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileQueryType is optional FileQueryType.
+type OptFileQueryType struct {
+	Value FileQueryType
+	Set   bool
+}
+
+// IsSet returns true if OptFileQueryType was set.
+func (o OptFileQueryType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileQueryType) Reset() {
+	var v FileQueryType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileQueryType) SetTo(v FileQueryType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileQueryType) Get() (v FileQueryType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileQueryType) Or(d FileQueryType) FileQueryType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileShareCreate returns new OptFileShareCreate with value set to v.
+func NewOptFileShareCreate(v FileShareCreate) OptFileShareCreate {
+	return OptFileShareCreate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileShareCreate is optional FileShareCreate.
+type OptFileShareCreate struct {
+	Value FileShareCreate
+	Set   bool
+}
+
+// IsSet returns true if OptFileShareCreate was set.
+func (o OptFileShareCreate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileShareCreate) Reset() {
+	var v FileShareCreate
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileShareCreate) SetTo(v FileShareCreate) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileShareCreate) Get() (v FileShareCreate, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileShareCreate) Or(d FileShareCreate) FileShareCreate {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileShareCreateMode returns new OptFileShareCreateMode with value set to v.
+func NewOptFileShareCreateMode(v FileShareCreateMode) OptFileShareCreateMode {
+	return OptFileShareCreateMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileShareCreateMode is optional FileShareCreateMode.
+type OptFileShareCreateMode struct {
+	Value FileShareCreateMode
+	Set   bool
+}
+
+// IsSet returns true if OptFileShareCreateMode was set.
+func (o OptFileShareCreateMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileShareCreateMode) Reset() {
+	var v FileShareCreateMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileShareCreateMode) SetTo(v FileShareCreateMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileShareCreateMode) Get() (v FileShareCreateMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileShareCreateMode) Or(d FileShareCreateMode) FileShareCreateMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileShareInfoMode returns new OptFileShareInfoMode with value set to v.
+func NewOptFileShareInfoMode(v FileShareInfoMode) OptFileShareInfoMode {
+	return OptFileShareInfoMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileShareInfoMode is optional FileShareInfoMode.
+type OptFileShareInfoMode struct {
+	Value FileShareInfoMode
+	Set   bool
+}
+
+// IsSet returns true if OptFileShareInfoMode was set.
+func (o OptFileShareInfoMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileShareInfoMode) Reset() {
+	var v FileShareInfoMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileShareInfoMode) SetTo(v FileShareInfoMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileShareInfoMode) Get() (v FileShareInfoMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileShareInfoMode) Or(d FileShareInfoMode) FileShareInfoMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileShareMode returns new OptFileShareMode with value set to v.
+func NewOptFileShareMode(v FileShareMode) OptFileShareMode {
+	return OptFileShareMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileShareMode is optional FileShareMode.
+type OptFileShareMode struct {
+	Value FileShareMode
+	Set   bool
+}
+
+// IsSet returns true if OptFileShareMode was set.
+func (o OptFileShareMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileShareMode) Reset() {
+	var v FileShareMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileShareMode) SetTo(v FileShareMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileShareMode) Get() (v FileShareMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileShareMode) Or(d FileShareMode) FileShareMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFileUpdateProperties returns new OptFileUpdateProperties with value set to v.
+func NewOptFileUpdateProperties(v FileUpdateProperties) OptFileUpdateProperties {
+	return OptFileUpdateProperties{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFileUpdateProperties is optional FileUpdateProperties.
+type OptFileUpdateProperties struct {
+	Value FileUpdateProperties
+	Set   bool
+}
+
+// IsSet returns true if OptFileUpdateProperties was set.
+func (o OptFileUpdateProperties) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFileUpdateProperties) Reset() {
+	var v FileUpdateProperties
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFileUpdateProperties) SetTo(v FileUpdateProperties) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFileUpdateProperties) Get() (v FileUpdateProperties, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFileUpdateProperties) Or(d FileUpdateProperties) FileUpdateProperties {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFilesStreamDownload returns new OptFilesStreamDownload with value set to v.
+func NewOptFilesStreamDownload(v FilesStreamDownload) OptFilesStreamDownload {
+	return OptFilesStreamDownload{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFilesStreamDownload is optional FilesStreamDownload.
+type OptFilesStreamDownload struct {
+	Value FilesStreamDownload
+	Set   bool
+}
+
+// IsSet returns true if OptFilesStreamDownload was set.
+func (o OptFilesStreamDownload) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFilesStreamDownload) Reset() {
+	var v FilesStreamDownload
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFilesStreamDownload) SetTo(v FilesStreamDownload) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFilesStreamDownload) Get() (v FilesStreamDownload, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFilesStreamDownload) Or(d FilesStreamDownload) FilesStreamDownload {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFilesThumbnailSize returns new OptFilesThumbnailSize with value set to v.
+func NewOptFilesThumbnailSize(v FilesThumbnailSize) OptFilesThumbnailSize {
+	return OptFilesThumbnailSize{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFilesThumbnailSize is optional FilesThumbnailSize.
+type OptFilesThumbnailSize struct {
+	Value FilesThumbnailSize
+	Set   bool
+}
+
+// IsSet returns true if OptFilesThumbnailSize was set.
+func (o OptFilesThumbnailSize) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFilesThumbnailSize) Reset() {
+	var v FilesThumbnailSize
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFilesThumbnailSize) SetTo(v FilesThumbnailSize) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFilesThumbnailSize) Get() (v FilesThumbnailSize, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFilesThumbnailSize) Or(d FilesThumbnailSize) FilesThumbnailSize {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptJobsListStatus returns new OptJobsListStatus with value set to v.
+func NewOptJobsListStatus(v JobsListStatus) OptJobsListStatus {
+	return OptJobsListStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptJobsListStatus is optional JobsListStatus.
+type OptJobsListStatus struct {
+	Value JobsListStatus
+	Set   bool
+}
+
+// IsSet returns true if OptJobsListStatus was set.
+func (o OptJobsListStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptJobsListStatus) Reset() {
+	var v JobsListStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptJobsListStatus) SetTo(v JobsListStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptJobsListStatus) Get() (v JobsListStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptJobsListStatus) Or(d JobsListStatus) JobsListStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMediaInfo returns new OptMediaInfo with value set to v.
+func NewOptMediaInfo(v MediaInfo) OptMediaInfo {
+	return OptMediaInfo{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMediaInfo is optional MediaInfo.
+type OptMediaInfo struct {
+	Value MediaInfo
+	Set   bool
+}
+
+// IsSet returns true if OptMediaInfo was set.
+func (o OptMediaInfo) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMediaInfo) Reset() {
+	var v MediaInfo
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMediaInfo) SetTo(v MediaInfo) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMediaInfo) Get() (v MediaInfo, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMediaInfo) Or(d MediaInfo) MediaInfo {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchQuery returns new OptSearchQuery with value set to v.
+func NewOptSearchQuery(v SearchQuery) OptSearchQuery {
+	return OptSearchQuery{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchQuery is optional SearchQuery.
+type OptSearchQuery struct {
+	Value SearchQuery
+	Set   bool
+}
+
+// IsSet returns true if OptSearchQuery was set.
+func (o OptSearchQuery) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchQuery) Reset() {
+	var v SearchQuery
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchQuery) SetTo(v SearchQuery) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchQuery) Get() (v SearchQuery, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchQuery) Or(d SearchQuery) SearchQuery {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchQueryOrder returns new OptSearchQueryOrder with value set to v.
+func NewOptSearchQueryOrder(v SearchQueryOrder) OptSearchQueryOrder {
+	return OptSearchQueryOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchQueryOrder is optional SearchQueryOrder.
+type OptSearchQueryOrder struct {
+	Value SearchQueryOrder
+	Set   bool
+}
+
+// IsSet returns true if OptSearchQueryOrder was set.
+func (o OptSearchQueryOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchQueryOrder) Reset() {
+	var v SearchQueryOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchQueryOrder) SetTo(v SearchQueryOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchQueryOrder) Get() (v SearchQueryOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchQueryOrder) Or(d SearchQueryOrder) SearchQueryOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchQuerySearchType returns new OptSearchQuerySearchType with value set to v.
+func NewOptSearchQuerySearchType(v SearchQuerySearchType) OptSearchQuerySearchType {
+	return OptSearchQuerySearchType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchQuerySearchType is optional SearchQuerySearchType.
+type OptSearchQuerySearchType struct {
+	Value SearchQuerySearchType
+	Set   bool
+}
+
+// IsSet returns true if OptSearchQuerySearchType was set.
+func (o OptSearchQuerySearchType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchQuerySearchType) Reset() {
+	var v SearchQuerySearchType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchQuerySearchType) SetTo(v SearchQuerySearchType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchQuerySearchType) Get() (v SearchQuerySearchType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchQuerySearchType) Or(d SearchQuerySearchType) SearchQuerySearchType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchQuerySort returns new OptSearchQuerySort with value set to v.
+func NewOptSearchQuerySort(v SearchQuerySort) OptSearchQuerySort {
+	return OptSearchQuerySort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchQuerySort is optional SearchQuerySort.
+type OptSearchQuerySort struct {
+	Value SearchQuerySort
+	Set   bool
+}
+
+// IsSet returns true if OptSearchQuerySort was set.
+func (o OptSearchQuerySort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchQuerySort) Reset() {
+	var v SearchQuerySort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchQuerySort) SetTo(v SearchQuerySort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchQuerySort) Get() (v SearchQuerySort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchQuerySort) Or(d SearchQuerySort) SearchQuerySort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchQueryTagMatch returns new OptSearchQueryTagMatch with value set to v.
+func NewOptSearchQueryTagMatch(v SearchQueryTagMatch) OptSearchQueryTagMatch {
+	return OptSearchQueryTagMatch{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchQueryTagMatch is optional SearchQueryTagMatch.
+type OptSearchQueryTagMatch struct {
+	Value SearchQueryTagMatch
+	Set   bool
+}
+
+// IsSet returns true if OptSearchQueryTagMatch was set.
+func (o OptSearchQueryTagMatch) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchQueryTagMatch) Reset() {
+	var v SearchQueryTagMatch
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchQueryTagMatch) SetTo(v SearchQueryTagMatch) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchQueryTagMatch) Get() (v SearchQueryTagMatch, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchQueryTagMatch) Or(d SearchQueryTagMatch) SearchQueryTagMatch {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchQueryType returns new OptSearchQueryType with value set to v.
+func NewOptSearchQueryType(v SearchQueryType) OptSearchQueryType {
+	return OptSearchQueryType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchQueryType is optional SearchQueryType.
+type OptSearchQueryType struct {
+	Value SearchQueryType
+	Set   bool
+}
+
+// IsSet returns true if OptSearchQueryType was set.
+func (o OptSearchQueryType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchQueryType) Reset() {
+	var v SearchQueryType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchQueryType) SetTo(v SearchQueryType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchQueryType) Get() (v SearchQueryType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchQueryType) Or(d SearchQueryType) SearchQueryType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptShareQueryOrder returns new OptShareQueryOrder with value set to v.
+func NewOptShareQueryOrder(v ShareQueryOrder) OptShareQueryOrder {
+	return OptShareQueryOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptShareQueryOrder is optional ShareQueryOrder.
+type OptShareQueryOrder struct {
+	Value ShareQueryOrder
+	Set   bool
+}
+
+// IsSet returns true if OptShareQueryOrder was set.
+func (o OptShareQueryOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptShareQueryOrder) Reset() {
+	var v ShareQueryOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptShareQueryOrder) SetTo(v ShareQueryOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptShareQueryOrder) Get() (v ShareQueryOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptShareQueryOrder) Or(d ShareQueryOrder) ShareQueryOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptShareQuerySort returns new OptShareQuerySort with value set to v.
+func NewOptShareQuerySort(v ShareQuerySort) OptShareQuerySort {
+	return OptShareQuerySort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptShareQuerySort is optional ShareQuerySort.
+type OptShareQuerySort struct {
+	Value ShareQuerySort
+	Set   bool
+}
+
+// IsSet returns true if OptShareQuerySort was set.
+func (o OptShareQuerySort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptShareQuerySort) Reset() {
+	var v ShareQuerySort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptShareQuerySort) SetTo(v ShareQuerySort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptShareQuerySort) Get() (v ShareQuerySort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptShareQuerySort) Or(d ShareQuerySort) ShareQuerySort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSharesStreamDownload returns new OptSharesStreamDownload with value set to v.
+func NewOptSharesStreamDownload(v SharesStreamDownload) OptSharesStreamDownload {
+	return OptSharesStreamDownload{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSharesStreamDownload is optional SharesStreamDownload.
+type OptSharesStreamDownload struct {
+	Value SharesStreamDownload
+	Set   bool
+}
+
+// IsSet returns true if OptSharesStreamDownload was set.
+func (o OptSharesStreamDownload) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSharesStreamDownload) Reset() {
+	var v SharesStreamDownload
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSharesStreamDownload) SetTo(v SharesStreamDownload) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSharesStreamDownload) Get() (v SharesStreamDownload, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSharesStreamDownload) Or(d SharesStreamDownload) SharesStreamDownload {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// File part information.
+// Ref: #/components/schemas/Part
+type Part struct {
+	// Part ID.
+	ID int `json:"id"`
+	// Encryption salt.
+	Salt OptString `json:"salt"`
+	// Channel holding the part, defaults to the file channel.
+	ChannelId OptInt64 `json:"channelId"`
+	// ID of the encryption key, empty for the primary key.
+	KeyId  OptString           `json:"keyId"`
+	Format OptEncryptionFormat `json:"format"`
+}
+
+// GetID returns the value of ID.
+func (s *Part) GetID() int {
+	return s.ID
+}
+
+// GetSalt returns the value of Salt.
+func (s *Part) GetSalt() OptString {
 	return s.Salt
 }
 
-// SetID sets the value of ID.
-func (s *Part) SetID(val int) {
-	s.ID = val
+// GetChannelId returns the value of ChannelId.
+func (s *Part) GetChannelId() OptInt64 {
+	return s.ChannelId
+}
+
+// GetKeyId returns the value of KeyId.
+func (s *Part) GetKeyId() OptString {
+	return s.KeyId
+}
+
+// GetFormat returns the value of Format.
+func (s *Part) GetFormat() OptEncryptionFormat {
+	return s.Format
+}
+
+// SetID sets the value of ID.
+func (s *Part) SetID(val int) {
+	s.ID = val
+}
+
+// SetSalt sets the value of Salt.
+func (s *Part) SetSalt(val OptString) {
+	s.Salt = val
+}
+
+// SetChannelId sets the value of ChannelId.
+func (s *Part) SetChannelId(val OptInt64) {
+	s.ChannelId = val
+}
+
+// SetKeyId sets the value of KeyId.
+func (s *Part) SetKeyId(val OptString) {
+	s.KeyId = val
+}
+
+// SetFormat sets the value of Format.
+func (s *Part) SetFormat(val OptEncryptionFormat) {
+	s.Format = val
+}
+
+// Background re-encryption of file parts with the active encryption key.
+// Ref: #/components/schemas/ReencryptionJob
+type ReencryptionJob struct {
+	// Job ID.
+	ID string `json:"id"`
+	// ID of the key parts are re-encrypted with.
+	KeyId string `json:"keyId"`
+	// Job status.
+	Status ReencryptionJobStatus `json:"status"`
+	// Number of files to re-encrypt.
+	TotalFiles int64 `json:"totalFiles"`
+	// Number of files processed so far.
+	ProcessedFiles int64 `json:"processedFiles"`
+	// Failure reason.
+	Error OptString `json:"error"`
+	// Creation time.
+	CreatedAt time.Time `json:"createdAt"`
+	// Last progress update.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *ReencryptionJob) GetID() string {
+	return s.ID
+}
+
+// GetKeyId returns the value of KeyId.
+func (s *ReencryptionJob) GetKeyId() string {
+	return s.KeyId
+}
+
+// GetStatus returns the value of Status.
+func (s *ReencryptionJob) GetStatus() ReencryptionJobStatus {
+	return s.Status
+}
+
+// GetTotalFiles returns the value of TotalFiles.
+func (s *ReencryptionJob) GetTotalFiles() int64 {
+	return s.TotalFiles
+}
+
+// GetProcessedFiles returns the value of ProcessedFiles.
+func (s *ReencryptionJob) GetProcessedFiles() int64 {
+	return s.ProcessedFiles
+}
+
+// GetError returns the value of Error.
+func (s *ReencryptionJob) GetError() OptString {
+	return s.Error
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ReencryptionJob) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ReencryptionJob) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *ReencryptionJob) SetID(val string) {
+	s.ID = val
+}
+
+// SetKeyId sets the value of KeyId.
+func (s *ReencryptionJob) SetKeyId(val string) {
+	s.KeyId = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ReencryptionJob) SetStatus(val ReencryptionJobStatus) {
+	s.Status = val
+}
+
+// SetTotalFiles sets the value of TotalFiles.
+func (s *ReencryptionJob) SetTotalFiles(val int64) {
+	s.TotalFiles = val
+}
+
+// SetProcessedFiles sets the value of ProcessedFiles.
+func (s *ReencryptionJob) SetProcessedFiles(val int64) {
+	s.ProcessedFiles = val
+}
+
+// SetError sets the value of Error.
+func (s *ReencryptionJob) SetError(val OptString) {
+	s.Error = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ReencryptionJob) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ReencryptionJob) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// Job status.
+type ReencryptionJobStatus string
+
+const (
+	ReencryptionJobStatusPending   ReencryptionJobStatus = "pending"
+	ReencryptionJobStatusRunning   ReencryptionJobStatus = "running"
+	ReencryptionJobStatusCompleted ReencryptionJobStatus = "completed"
+	ReencryptionJobStatusFailed    ReencryptionJobStatus = "failed"
+	ReencryptionJobStatusCancelled ReencryptionJobStatus = "cancelled"
+)
+
+// AllValues returns all ReencryptionJobStatus values.
+func (ReencryptionJobStatus) AllValues() []ReencryptionJobStatus {
+	return []ReencryptionJobStatus{
+		ReencryptionJobStatusPending,
+		ReencryptionJobStatusRunning,
+		ReencryptionJobStatusCompleted,
+		ReencryptionJobStatusFailed,
+		ReencryptionJobStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReencryptionJobStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReencryptionJobStatusPending:
+		return []byte(s), nil
+	case ReencryptionJobStatusRunning:
+		return []byte(s), nil
+	case ReencryptionJobStatusCompleted:
+		return []byte(s), nil
+	case ReencryptionJobStatusFailed:
+		return []byte(s), nil
+	case ReencryptionJobStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReencryptionJobStatus) UnmarshalText(data []byte) error {
+	switch ReencryptionJobStatus(data) {
+	case ReencryptionJobStatusPending:
+		*s = ReencryptionJobStatusPending
+		return nil
+	case ReencryptionJobStatusRunning:
+		*s = ReencryptionJobStatusRunning
+		return nil
+	case ReencryptionJobStatusCompleted:
+		*s = ReencryptionJobStatusCompleted
+		return nil
+	case ReencryptionJobStatusFailed:
+		*s = ReencryptionJobStatusFailed
+		return nil
+	case ReencryptionJobStatusCancelled:
+		*s = ReencryptionJobStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Named file search, listed as a smart folder.
+// Ref: #/components/schemas/SavedSearch
+type SavedSearch struct {
+	// Saved search ID.
+	ID OptString `json:"id"`
+	// Saved search name.
+	Name      string      `json:"name"`
+	Query     SearchQuery `json:"query"`
+	CreatedAt OptDateTime `json:"createdAt"`
+	UpdatedAt OptDateTime `json:"updatedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *SavedSearch) GetID() OptString {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *SavedSearch) GetName() string {
+	return s.Name
+}
+
+// GetQuery returns the value of Query.
+func (s *SavedSearch) GetQuery() SearchQuery {
+	return s.Query
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SavedSearch) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *SavedSearch) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *SavedSearch) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *SavedSearch) SetName(val string) {
+	s.Name = val
+}
+
+// SetQuery sets the value of Query.
+func (s *SavedSearch) SetQuery(val SearchQuery) {
+	s.Query = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SavedSearch) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *SavedSearch) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// Saved search update request.
+// Ref: #/components/schemas/SavedSearchUpdate
+type SavedSearchUpdate struct {
+	// Saved search name.
+	Name  OptString      `json:"name"`
+	Query OptSearchQuery `json:"query"`
+}
+
+// GetName returns the value of Name.
+func (s *SavedSearchUpdate) GetName() OptString {
+	return s.Name
+}
+
+// GetQuery returns the value of Query.
+func (s *SavedSearchUpdate) GetQuery() OptSearchQuery {
+	return s.Query
+}
+
+// SetName sets the value of Name.
+func (s *SavedSearchUpdate) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetQuery sets the value of Query.
+func (s *SavedSearchUpdate) SetQuery(val OptSearchQuery) {
+	s.Query = val
+}
+
+// Saved filters of a file search, with the meaning of the file list parameters of the same name.
+// Ref: #/components/schemas/SearchQuery
+type SearchQuery struct {
+	// Search query.
+	Query OptString `json:"query"`
+	// Search type.
+	SearchType OptSearchQuerySearchType `json:"searchType"`
+	// Structured filters like size>1GB ext:mkv,mp4 created>2024-01-01 -in:/Trash, on size, created,
+	// updated, mime, ext, type, category and in.
+	Filter OptString `json:"filter"`
+	// File type.
+	Type OptSearchQueryType `json:"type"`
+	// File path.
+	Path OptString `json:"path"`
+	// Enable deep search.
+	DeepSearch OptBool `json:"deepSearch"`
+	// Show starred files.
+	Starred OptBool `json:"starred"`
+	// Show recently opened or changed files.
+	Recent OptBool `json:"recent"`
+	// File category.
+	Category []Category `json:"category"`
+	// Tag names to filter by.
+	Tags []string `json:"tags"`
+	// Match files having any or all of the tags.
+	TagMatch OptSearchQueryTagMatch `json:"tagMatch"`
+	// Property filters, key=value matches a value and key alone matches files having the key.
+	Properties []string `json:"properties"`
+	// UpdatedAt Filter supports operator eq, gt, lt, gte, lte.
+	UpdatedAt OptString `json:"updatedAt"`
+	// Capture date filter supports operator eq, gt, lt, gte, lte.
+	TakenAt OptString `json:"takenAt"`
+	// Camera maker or model filter.
+	Camera OptString `json:"camera"`
+	// Duration filter in seconds supports operator eq, gt, lt, gte, lte.
+	Duration OptString `json:"duration"`
+	// Show files with a capture location.
+	HasLocation OptBool `json:"hasLocation"`
+	// Sort field.
+	Sort OptSearchQuerySort `json:"sort"`
+	// Sort order.
+	Order OptSearchQueryOrder `json:"order"`
+}
+
+// GetQuery returns the value of Query.
+func (s *SearchQuery) GetQuery() OptString {
+	return s.Query
+}
+
+// GetSearchType returns the value of SearchType.
+func (s *SearchQuery) GetSearchType() OptSearchQuerySearchType {
+	return s.SearchType
+}
+
+// GetFilter returns the value of Filter.
+func (s *SearchQuery) GetFilter() OptString {
+	return s.Filter
+}
+
+// GetType returns the value of Type.
+func (s *SearchQuery) GetType() OptSearchQueryType {
+	return s.Type
+}
+
+// GetPath returns the value of Path.
+func (s *SearchQuery) GetPath() OptString {
+	return s.Path
+}
+
+// GetDeepSearch returns the value of DeepSearch.
+func (s *SearchQuery) GetDeepSearch() OptBool {
+	return s.DeepSearch
+}
+
+// GetStarred returns the value of Starred.
+func (s *SearchQuery) GetStarred() OptBool {
+	return s.Starred
+}
+
+// GetRecent returns the value of Recent.
+func (s *SearchQuery) GetRecent() OptBool {
+	return s.Recent
+}
+
+// GetCategory returns the value of Category.
+func (s *SearchQuery) GetCategory() []Category {
+	return s.Category
+}
+
+// GetTags returns the value of Tags.
+func (s *SearchQuery) GetTags() []string {
+	return s.Tags
+}
+
+// GetTagMatch returns the value of TagMatch.
+func (s *SearchQuery) GetTagMatch() OptSearchQueryTagMatch {
+	return s.TagMatch
+}
+
+// GetProperties returns the value of Properties.
+func (s *SearchQuery) GetProperties() []string {
+	return s.Properties
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *SearchQuery) GetUpdatedAt() OptString {
+	return s.UpdatedAt
+}
+
+// GetTakenAt returns the value of TakenAt.
+func (s *SearchQuery) GetTakenAt() OptString {
+	return s.TakenAt
+}
+
+// GetCamera returns the value of Camera.
+func (s *SearchQuery) GetCamera() OptString {
+	return s.Camera
+}
+
+// GetDuration returns the value of Duration.
+func (s *SearchQuery) GetDuration() OptString {
+	return s.Duration
+}
+
+// GetHasLocation returns the value of HasLocation.
+func (s *SearchQuery) GetHasLocation() OptBool {
+	return s.HasLocation
+}
+
+// GetSort returns the value of Sort.
+func (s *SearchQuery) GetSort() OptSearchQuerySort {
+	return s.Sort
+}
+
+// GetOrder returns the value of Order.
+func (s *SearchQuery) GetOrder() OptSearchQueryOrder {
+	return s.Order
+}
+
+// SetQuery sets the value of Query.
+func (s *SearchQuery) SetQuery(val OptString) {
+	s.Query = val
+}
+
+// SetSearchType sets the value of SearchType.
+func (s *SearchQuery) SetSearchType(val OptSearchQuerySearchType) {
+	s.SearchType = val
+}
+
+// SetFilter sets the value of Filter.
+func (s *SearchQuery) SetFilter(val OptString) {
+	s.Filter = val
+}
+
+// SetType sets the value of Type.
+func (s *SearchQuery) SetType(val OptSearchQueryType) {
+	s.Type = val
+}
+
+// SetPath sets the value of Path.
+func (s *SearchQuery) SetPath(val OptString) {
+	s.Path = val
+}
+
+// SetDeepSearch sets the value of DeepSearch.
+func (s *SearchQuery) SetDeepSearch(val OptBool) {
+	s.DeepSearch = val
+}
+
+// SetStarred sets the value of Starred.
+func (s *SearchQuery) SetStarred(val OptBool) {
+	s.Starred = val
+}
+
+// SetRecent sets the value of Recent.
+func (s *SearchQuery) SetRecent(val OptBool) {
+	s.Recent = val
+}
+
+// SetCategory sets the value of Category.
+func (s *SearchQuery) SetCategory(val []Category) {
+	s.Category = val
+}
+
+// SetTags sets the value of Tags.
+func (s *SearchQuery) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetTagMatch sets the value of TagMatch.
+func (s *SearchQuery) SetTagMatch(val OptSearchQueryTagMatch) {
+	s.TagMatch = val
+}
+
+// SetProperties sets the value of Properties.
+func (s *SearchQuery) SetProperties(val []string) {
+	s.Properties = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *SearchQuery) SetUpdatedAt(val OptString) {
+	s.UpdatedAt = val
+}
+
+// SetTakenAt sets the value of TakenAt.
+func (s *SearchQuery) SetTakenAt(val OptString) {
+	s.TakenAt = val
+}
+
+// SetCamera sets the value of Camera.
+func (s *SearchQuery) SetCamera(val OptString) {
+	s.Camera = val
+}
+
+// SetDuration sets the value of Duration.
+func (s *SearchQuery) SetDuration(val OptString) {
+	s.Duration = val
+}
+
+// SetHasLocation sets the value of HasLocation.
+func (s *SearchQuery) SetHasLocation(val OptBool) {
+	s.HasLocation = val
+}
+
+// SetSort sets the value of Sort.
+func (s *SearchQuery) SetSort(val OptSearchQuerySort) {
+	s.Sort = val
+}
+
+// SetOrder sets the value of Order.
+func (s *SearchQuery) SetOrder(val OptSearchQueryOrder) {
+	s.Order = val
+}
+
+// Sort order.
+type SearchQueryOrder string
+
+const (
+	SearchQueryOrderAsc  SearchQueryOrder = "asc"
+	SearchQueryOrderDesc SearchQueryOrder = "desc"
+)
+
+// AllValues returns all SearchQueryOrder values.
+func (SearchQueryOrder) AllValues() []SearchQueryOrder {
+	return []SearchQueryOrder{
+		SearchQueryOrderAsc,
+		SearchQueryOrderDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchQueryOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchQueryOrderAsc:
+		return []byte(s), nil
+	case SearchQueryOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchQueryOrder) UnmarshalText(data []byte) error {
+	switch SearchQueryOrder(data) {
+	case SearchQueryOrderAsc:
+		*s = SearchQueryOrderAsc
+		return nil
+	case SearchQueryOrderDesc:
+		*s = SearchQueryOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Search type.
+type SearchQuerySearchType string
+
+const (
+	SearchQuerySearchTypeText    SearchQuerySearchType = "text"
+	SearchQuerySearchTypeRegex   SearchQuerySearchType = "regex"
+	SearchQuerySearchTypeContent SearchQuerySearchType = "content"
+)
+
+// AllValues returns all SearchQuerySearchType values.
+func (SearchQuerySearchType) AllValues() []SearchQuerySearchType {
+	return []SearchQuerySearchType{
+		SearchQuerySearchTypeText,
+		SearchQuerySearchTypeRegex,
+		SearchQuerySearchTypeContent,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchQuerySearchType) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchQuerySearchTypeText:
+		return []byte(s), nil
+	case SearchQuerySearchTypeRegex:
+		return []byte(s), nil
+	case SearchQuerySearchTypeContent:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchQuerySearchType) UnmarshalText(data []byte) error {
+	switch SearchQuerySearchType(data) {
+	case SearchQuerySearchTypeText:
+		*s = SearchQuerySearchTypeText
+		return nil
+	case SearchQuerySearchTypeRegex:
+		*s = SearchQuerySearchTypeRegex
+		return nil
+	case SearchQuerySearchTypeContent:
+		*s = SearchQuerySearchTypeContent
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Sort field.
+type SearchQuerySort string
+
+const (
+	SearchQuerySortName      SearchQuerySort = "name"
+	SearchQuerySortUpdatedAt SearchQuerySort = "updatedAt"
+	SearchQuerySortSize      SearchQuerySort = "size"
+	SearchQuerySortID        SearchQuerySort = "id"
+	SearchQuerySortTakenAt   SearchQuerySort = "takenAt"
+)
+
+// AllValues returns all SearchQuerySort values.
+func (SearchQuerySort) AllValues() []SearchQuerySort {
+	return []SearchQuerySort{
+		SearchQuerySortName,
+		SearchQuerySortUpdatedAt,
+		SearchQuerySortSize,
+		SearchQuerySortID,
+		SearchQuerySortTakenAt,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchQuerySort) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchQuerySortName:
+		return []byte(s), nil
+	case SearchQuerySortUpdatedAt:
+		return []byte(s), nil
+	case SearchQuerySortSize:
+		return []byte(s), nil
+	case SearchQuerySortID:
+		return []byte(s), nil
+	case SearchQuerySortTakenAt:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchQuerySort) UnmarshalText(data []byte) error {
+	switch SearchQuerySort(data) {
+	case SearchQuerySortName:
+		*s = SearchQuerySortName
+		return nil
+	case SearchQuerySortUpdatedAt:
+		*s = SearchQuerySortUpdatedAt
+		return nil
+	case SearchQuerySortSize:
+		*s = SearchQuerySortSize
+		return nil
+	case SearchQuerySortID:
+		*s = SearchQuerySortID
+		return nil
+	case SearchQuerySortTakenAt:
+		*s = SearchQuerySortTakenAt
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Match files having any or all of the tags.
+type SearchQueryTagMatch string
+
+const (
+	SearchQueryTagMatchAny SearchQueryTagMatch = "any"
+	SearchQueryTagMatchAll SearchQueryTagMatch = "all"
+)
+
+// AllValues returns all SearchQueryTagMatch values.
+func (SearchQueryTagMatch) AllValues() []SearchQueryTagMatch {
+	return []SearchQueryTagMatch{
+		SearchQueryTagMatchAny,
+		SearchQueryTagMatchAll,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchQueryTagMatch) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchQueryTagMatchAny:
+		return []byte(s), nil
+	case SearchQueryTagMatchAll:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchQueryTagMatch) UnmarshalText(data []byte) error {
+	switch SearchQueryTagMatch(data) {
+	case SearchQueryTagMatchAny:
+		*s = SearchQueryTagMatchAny
+		return nil
+	case SearchQueryTagMatchAll:
+		*s = SearchQueryTagMatchAll
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// File type.
+type SearchQueryType string
+
+const (
+	SearchQueryTypeFolder SearchQueryType = "folder"
+	SearchQueryTypeFile   SearchQueryType = "file"
+)
+
+// AllValues returns all SearchQueryType values.
+func (SearchQueryType) AllValues() []SearchQueryType {
+	return []SearchQueryType{
+		SearchQueryTypeFolder,
+		SearchQueryTypeFile,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchQueryType) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchQueryTypeFolder:
+		return []byte(s), nil
+	case SearchQueryTypeFile:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchQueryType) UnmarshalText(data []byte) error {
+	switch SearchQueryType(data) {
+	case SearchQueryTypeFolder:
+		*s = SearchQueryTypeFolder
+		return nil
+	case SearchQueryTypeFile:
+		*s = SearchQueryTypeFile
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetSalt sets the value of Salt.
-func (s *Part) SetSalt(val OptString) {
-	s.Salt = val
-}
+// SearchesDeleteNoContent is response for SearchesDelete operation.
+type SearchesDeleteNoContent struct{}
 
 // User session information containing authentication and profile details.
 // Ref: #/components/schemas/Session
@@ -2810,6 +6455,351 @@ func (s *SessionHeaders) SetResponse(val Session) {
 
 func (*SessionHeaders) authSessionRes() {}
 
+// Single access to a shared file or folder.
+// Ref: #/components/schemas/ShareAccess
+type ShareAccess struct {
+	// Kind of access.
+	Type ShareAccessType `json:"type"`
+	// Client IP address.
+	IP string `json:"ip"`
+	// Client user agent.
+	UserAgent OptString `json:"userAgent"`
+	// Bytes served.
+	Bytes int64 `json:"bytes"`
+	// Access time.
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetType returns the value of Type.
+func (s *ShareAccess) GetType() ShareAccessType {
+	return s.Type
+}
+
+// GetIP returns the value of IP.
+func (s *ShareAccess) GetIP() string {
+	return s.IP
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *ShareAccess) GetUserAgent() OptString {
+	return s.UserAgent
+}
+
+// GetBytes returns the value of Bytes.
+func (s *ShareAccess) GetBytes() int64 {
+	return s.Bytes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ShareAccess) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetType sets the value of Type.
+func (s *ShareAccess) SetType(val ShareAccessType) {
+	s.Type = val
+}
+
+// SetIP sets the value of IP.
+func (s *ShareAccess) SetIP(val string) {
+	s.IP = val
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *ShareAccess) SetUserAgent(val OptString) {
+	s.UserAgent = val
+}
+
+// SetBytes sets the value of Bytes.
+func (s *ShareAccess) SetBytes(val int64) {
+	s.Bytes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ShareAccess) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Kind of access.
+type ShareAccessType string
+
+const (
+	ShareAccessTypeList     ShareAccessType = "list"
+	ShareAccessTypeDownload ShareAccessType = "download"
+	ShareAccessTypeUpload   ShareAccessType = "upload"
+)
+
+// AllValues returns all ShareAccessType values.
+func (ShareAccessType) AllValues() []ShareAccessType {
+	return []ShareAccessType{
+		ShareAccessTypeList,
+		ShareAccessTypeDownload,
+		ShareAccessTypeUpload,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ShareAccessType) MarshalText() ([]byte, error) {
+	switch s {
+	case ShareAccessTypeList:
+		return []byte(s), nil
+	case ShareAccessTypeDownload:
+		return []byte(s), nil
+	case ShareAccessTypeUpload:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ShareAccessType) UnmarshalText(data []byte) error {
+	switch ShareAccessType(data) {
+	case ShareAccessTypeList:
+		*s = ShareAccessTypeList
+		return nil
+	case ShareAccessTypeDownload:
+		*s = ShareAccessTypeDownload
+		return nil
+	case ShareAccessTypeUpload:
+		*s = ShareAccessTypeUpload
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Share access statistics.
+// Ref: #/components/schemas/ShareAnalytics
+type ShareAnalytics struct {
+	// Number of downloads served.
+	Downloads int64 `json:"downloads"`
+	// Number of listings served.
+	Views int64 `json:"views"`
+	// Number of distinct client IPs.
+	UniqueIps int64 `json:"uniqueIps"`
+	// Total bytes served.
+	BytesServed int64 `json:"bytesServed"`
+	// Time of the latest access.
+	LastAccessedAt OptDateTime `json:"lastAccessedAt"`
+	// Indicates if the share was disabled after reaching a limit.
+	Disabled bool `json:"disabled"`
+	// Limit that disabled the share.
+	DisabledReason OptString `json:"disabledReason"`
+	// Time the share was disabled.
+	DisabledAt OptDateTime `json:"disabledAt"`
+	// Most recent accesses.
+	Accesses []ShareAccess `json:"accesses"`
+}
+
+// GetDownloads returns the value of Downloads.
+func (s *ShareAnalytics) GetDownloads() int64 {
+	return s.Downloads
+}
+
+// GetViews returns the value of Views.
+func (s *ShareAnalytics) GetViews() int64 {
+	return s.Views
+}
+
+// GetUniqueIps returns the value of UniqueIps.
+func (s *ShareAnalytics) GetUniqueIps() int64 {
+	return s.UniqueIps
+}
+
+// GetBytesServed returns the value of BytesServed.
+func (s *ShareAnalytics) GetBytesServed() int64 {
+	return s.BytesServed
+}
+
+// GetLastAccessedAt returns the value of LastAccessedAt.
+func (s *ShareAnalytics) GetLastAccessedAt() OptDateTime {
+	return s.LastAccessedAt
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *ShareAnalytics) GetDisabled() bool {
+	return s.Disabled
+}
+
+// GetDisabledReason returns the value of DisabledReason.
+func (s *ShareAnalytics) GetDisabledReason() OptString {
+	return s.DisabledReason
+}
+
+// GetDisabledAt returns the value of DisabledAt.
+func (s *ShareAnalytics) GetDisabledAt() OptDateTime {
+	return s.DisabledAt
+}
+
+// GetAccesses returns the value of Accesses.
+func (s *ShareAnalytics) GetAccesses() []ShareAccess {
+	return s.Accesses
+}
+
+// SetDownloads sets the value of Downloads.
+func (s *ShareAnalytics) SetDownloads(val int64) {
+	s.Downloads = val
+}
+
+// SetViews sets the value of Views.
+func (s *ShareAnalytics) SetViews(val int64) {
+	s.Views = val
+}
+
+// SetUniqueIps sets the value of UniqueIps.
+func (s *ShareAnalytics) SetUniqueIps(val int64) {
+	s.UniqueIps = val
+}
+
+// SetBytesServed sets the value of BytesServed.
+func (s *ShareAnalytics) SetBytesServed(val int64) {
+	s.BytesServed = val
+}
+
+// SetLastAccessedAt sets the value of LastAccessedAt.
+func (s *ShareAnalytics) SetLastAccessedAt(val OptDateTime) {
+	s.LastAccessedAt = val
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *ShareAnalytics) SetDisabled(val bool) {
+	s.Disabled = val
+}
+
+// SetDisabledReason sets the value of DisabledReason.
+func (s *ShareAnalytics) SetDisabledReason(val OptString) {
+	s.DisabledReason = val
+}
+
+// SetDisabledAt sets the value of DisabledAt.
+func (s *ShareAnalytics) SetDisabledAt(val OptDateTime) {
+	s.DisabledAt = val
+}
+
+// SetAccesses sets the value of Accesses.
+func (s *ShareAnalytics) SetAccesses(val []ShareAccess) {
+	s.Accesses = val
+}
+
+// Share of several files and folders.
+// Ref: #/components/schemas/ShareCreate
+type ShareCreate struct {
+	// Files and folders included in the share.
+	FileIds []string `json:"fileIds"`
+	// Name shown for the share.
+	Name OptString `json:"name"`
+	// Share password.
+	Password OptString `json:"password"`
+	// Share expiration date.
+	ExpiresAt OptDateTime `json:"expiresAt"`
+	// Number of downloads after which the share is disabled.
+	MaxDownloads OptInt64 `json:"maxDownloads"`
+	// Number of distinct client IPs after which the share is disabled.
+	MaxIps OptInt64 `json:"maxIps"`
+}
+
+// GetFileIds returns the value of FileIds.
+func (s *ShareCreate) GetFileIds() []string {
+	return s.FileIds
+}
+
+// GetName returns the value of Name.
+func (s *ShareCreate) GetName() OptString {
+	return s.Name
+}
+
+// GetPassword returns the value of Password.
+func (s *ShareCreate) GetPassword() OptString {
+	return s.Password
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *ShareCreate) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetMaxDownloads returns the value of MaxDownloads.
+func (s *ShareCreate) GetMaxDownloads() OptInt64 {
+	return s.MaxDownloads
+}
+
+// GetMaxIps returns the value of MaxIps.
+func (s *ShareCreate) GetMaxIps() OptInt64 {
+	return s.MaxIps
+}
+
+// SetFileIds sets the value of FileIds.
+func (s *ShareCreate) SetFileIds(val []string) {
+	s.FileIds = val
+}
+
+// SetName sets the value of Name.
+func (s *ShareCreate) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPassword sets the value of Password.
+func (s *ShareCreate) SetPassword(val OptString) {
+	s.Password = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *ShareCreate) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetMaxDownloads sets the value of MaxDownloads.
+func (s *ShareCreate) SetMaxDownloads(val OptInt64) {
+	s.MaxDownloads = val
+}
+
+// SetMaxIps sets the value of MaxIps.
+func (s *ShareCreate) SetMaxIps(val OptInt64) {
+	s.MaxIps = val
+}
+
+// File created through an upload share.
+// Ref: #/components/schemas/ShareFileCreate
+type ShareFileCreate struct {
+	// File name.
+	Name string `json:"name"`
+	// MIME type of the file.
+	MimeType OptString `json:"mimeType"`
+	// Upload ID used for the file parts.
+	UploadId string `json:"uploadId"`
+}
+
+// GetName returns the value of Name.
+func (s *ShareFileCreate) GetName() string {
+	return s.Name
+}
+
+// GetMimeType returns the value of MimeType.
+func (s *ShareFileCreate) GetMimeType() OptString {
+	return s.MimeType
+}
+
+// GetUploadId returns the value of UploadId.
+func (s *ShareFileCreate) GetUploadId() string {
+	return s.UploadId
+}
+
+// SetName sets the value of Name.
+func (s *ShareFileCreate) SetName(val string) {
+	s.Name = val
+}
+
+// SetMimeType sets the value of MimeType.
+func (s *ShareFileCreate) SetMimeType(val OptString) {
+	s.MimeType = val
+}
+
+// SetUploadId sets the value of UploadId.
+func (s *ShareFileCreate) SetUploadId(val string) {
+	s.UploadId = val
+}
+
 type ShareQueryOrder string
 
 const (
@@ -2922,6 +6912,9 @@ func (s *ShareUnlock) SetPassword(val string) {
 	s.Password = val
 }
 
+// SharesDeleteNoContent is response for SharesDelete operation.
+type SharesDeleteNoContent struct{}
+
 type SharesStreamDownload string
 
 const (
@@ -3215,53 +7208,139 @@ func (s *SharesStreamPartialContentHeaders) SetContentDisposition(val string) {
 	s.ContentDisposition = val
 }
 
-// SetContentLength sets the value of ContentLength.
-func (s *SharesStreamPartialContentHeaders) SetContentLength(val string) {
-	s.ContentLength = val
+// SetContentLength sets the value of ContentLength.
+func (s *SharesStreamPartialContentHeaders) SetContentLength(val string) {
+	s.ContentLength = val
+}
+
+// SetContentRange sets the value of ContentRange.
+func (s *SharesStreamPartialContentHeaders) SetContentRange(val OptString) {
+	s.ContentRange = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *SharesStreamPartialContentHeaders) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetEtag sets the value of Etag.
+func (s *SharesStreamPartialContentHeaders) SetEtag(val string) {
+	s.Etag = val
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *SharesStreamPartialContentHeaders) SetLastModified(val string) {
+	s.LastModified = val
+}
+
+// SetResponse sets the value of Response.
+func (s *SharesStreamPartialContentHeaders) SetResponse(val SharesStreamPartialContent) {
+	s.Response = val
+}
+
+func (*SharesStreamPartialContentHeaders) sharesStreamRes() {}
+
+// SharesUnlockNoContent is response for SharesUnlock operation.
+type SharesUnlockNoContent struct{}
+
+type SharesUploadReq struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SharesUploadReq) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// SharesUploadReqWithContentType wraps SharesUploadReq with Content-Type.
+type SharesUploadReqWithContentType struct {
+	ContentType string
+	Content     SharesUploadReq
+}
+
+// GetContentType returns the value of ContentType.
+func (s *SharesUploadReqWithContentType) GetContentType() string {
+	return s.ContentType
+}
+
+// GetContent returns the value of Content.
+func (s *SharesUploadReqWithContentType) GetContent() SharesUploadReq {
+	return s.Content
+}
+
+// SetContentType sets the value of ContentType.
+func (s *SharesUploadReqWithContentType) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetContent sets the value of Content.
+func (s *SharesUploadReqWithContentType) SetContent(val SharesUploadReq) {
+	s.Content = val
+}
+
+type SharesZipOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SharesZipOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// SetContentRange sets the value of ContentRange.
-func (s *SharesStreamPartialContentHeaders) SetContentRange(val OptString) {
-	s.ContentRange = val
+// SharesZipOKHeaders wraps SharesZipOK with response headers.
+type SharesZipOKHeaders struct {
+	ContentDisposition string
+	Response           SharesZipOK
 }
 
-// SetContentType sets the value of ContentType.
-func (s *SharesStreamPartialContentHeaders) SetContentType(val string) {
-	s.ContentType = val
+// GetContentDisposition returns the value of ContentDisposition.
+func (s *SharesZipOKHeaders) GetContentDisposition() string {
+	return s.ContentDisposition
 }
 
-// SetEtag sets the value of Etag.
-func (s *SharesStreamPartialContentHeaders) SetEtag(val string) {
-	s.Etag = val
+// GetResponse returns the value of Response.
+func (s *SharesZipOKHeaders) GetResponse() SharesZipOK {
+	return s.Response
 }
 
-// SetLastModified sets the value of LastModified.
-func (s *SharesStreamPartialContentHeaders) SetLastModified(val string) {
-	s.LastModified = val
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *SharesZipOKHeaders) SetContentDisposition(val string) {
+	s.ContentDisposition = val
 }
 
 // SetResponse sets the value of Response.
-func (s *SharesStreamPartialContentHeaders) SetResponse(val SharesStreamPartialContent) {
+func (s *SharesZipOKHeaders) SetResponse(val SharesZipOK) {
 	s.Response = val
 }
 
-func (*SharesStreamPartialContentHeaders) sharesStreamRes() {}
-
-// SharesUnlockNoContent is response for SharesUnlock operation.
-type SharesUnlockNoContent struct{}
-
 // Ref: #/components/schemas/Source
 type Source struct {
 	// File ID.
 	ID string `json:"id"`
 	// File name.
 	Name string `json:"name"`
-	// File type.
+	// Source type.
 	Type SourceType `json:"type"`
 	// Parent ID.
 	ParentId string `json:"parentId"`
 	// Destination Parent ID.
 	DestParentId OptString `json:"destParentId"`
+	// Job status.
+	Status OptString `json:"status"`
+	// Job items processed so far.
+	Done OptInt64 `json:"done"`
+	// Job items to process.
+	Total OptInt64 `json:"total"`
 }
 
 // GetID returns the value of ID.
@@ -3289,6 +7368,21 @@ func (s *Source) GetDestParentId() OptString {
 	return s.DestParentId
 }
 
+// GetStatus returns the value of Status.
+func (s *Source) GetStatus() OptString {
+	return s.Status
+}
+
+// GetDone returns the value of Done.
+func (s *Source) GetDone() OptInt64 {
+	return s.Done
+}
+
+// GetTotal returns the value of Total.
+func (s *Source) GetTotal() OptInt64 {
+	return s.Total
+}
+
 // SetID sets the value of ID.
 func (s *Source) SetID(val string) {
 	s.ID = val
@@ -3314,12 +7408,28 @@ func (s *Source) SetDestParentId(val OptString) {
 	s.DestParentId = val
 }
 
-// File type.
+// SetStatus sets the value of Status.
+func (s *Source) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetDone sets the value of Done.
+func (s *Source) SetDone(val OptInt64) {
+	s.Done = val
+}
+
+// SetTotal sets the value of Total.
+func (s *Source) SetTotal(val OptInt64) {
+	s.Total = val
+}
+
+// Source type.
 type SourceType string
 
 const (
 	SourceTypeFolder SourceType = "folder"
 	SourceTypeFile   SourceType = "file"
+	SourceTypeJob    SourceType = "job"
 )
 
 // AllValues returns all SourceType values.
@@ -3327,6 +7437,7 @@ func (SourceType) AllValues() []SourceType {
 	return []SourceType{
 		SourceTypeFolder,
 		SourceTypeFile,
+		SourceTypeJob,
 	}
 }
 
@@ -3337,6 +7448,8 @@ func (s SourceType) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case SourceTypeFile:
 		return []byte(s), nil
+	case SourceTypeJob:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -3351,11 +7464,355 @@ func (s *SourceType) UnmarshalText(data []byte) error {
 	case SourceTypeFile:
 		*s = SourceTypeFile
 		return nil
+	case SourceTypeJob:
+		*s = SourceTypeJob
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Storage policy for new uploads.
+// Ref: #/components/schemas/StoragePolicy
+type StoragePolicy struct {
+	// How new file parts are spread across channels.
+	Mode StoragePolicyMode `json:"mode"`
+	// Channel set used by the roundRobin and stripe modes.
+	ChannelIds []int64 `json:"channelIds"`
+	// Message count after which a full channel is replaced by a newly created one, 0 disables rollover.
+	RolloverThreshold OptInt64 `json:"rolloverThreshold"`
+}
+
+// GetMode returns the value of Mode.
+func (s *StoragePolicy) GetMode() StoragePolicyMode {
+	return s.Mode
+}
+
+// GetChannelIds returns the value of ChannelIds.
+func (s *StoragePolicy) GetChannelIds() []int64 {
+	return s.ChannelIds
+}
+
+// GetRolloverThreshold returns the value of RolloverThreshold.
+func (s *StoragePolicy) GetRolloverThreshold() OptInt64 {
+	return s.RolloverThreshold
+}
+
+// SetMode sets the value of Mode.
+func (s *StoragePolicy) SetMode(val StoragePolicyMode) {
+	s.Mode = val
+}
+
+// SetChannelIds sets the value of ChannelIds.
+func (s *StoragePolicy) SetChannelIds(val []int64) {
+	s.ChannelIds = val
+}
+
+// SetRolloverThreshold sets the value of RolloverThreshold.
+func (s *StoragePolicy) SetRolloverThreshold(val OptInt64) {
+	s.RolloverThreshold = val
+}
+
+// How new file parts are spread across channels.
+type StoragePolicyMode string
+
+const (
+	StoragePolicyModeSingle     StoragePolicyMode = "single"
+	StoragePolicyModeRoundRobin StoragePolicyMode = "roundRobin"
+	StoragePolicyModeStripe     StoragePolicyMode = "stripe"
+)
+
+// AllValues returns all StoragePolicyMode values.
+func (StoragePolicyMode) AllValues() []StoragePolicyMode {
+	return []StoragePolicyMode{
+		StoragePolicyModeSingle,
+		StoragePolicyModeRoundRobin,
+		StoragePolicyModeStripe,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s StoragePolicyMode) MarshalText() ([]byte, error) {
+	switch s {
+	case StoragePolicyModeSingle:
+		return []byte(s), nil
+	case StoragePolicyModeRoundRobin:
+		return []byte(s), nil
+	case StoragePolicyModeStripe:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *StoragePolicyMode) UnmarshalText(data []byte) error {
+	switch StoragePolicyMode(data) {
+	case StoragePolicyModeSingle:
+		*s = StoragePolicyModeSingle
+		return nil
+	case StoragePolicyModeRoundRobin:
+		*s = StoragePolicyModeRoundRobin
+		return nil
+	case StoragePolicyModeStripe:
+		*s = StoragePolicyModeStripe
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// User defined tag.
+// Ref: #/components/schemas/Tag
+type Tag struct {
+	// Tag ID.
+	ID string `json:"id"`
+	// Tag name.
+	Name string `json:"name"`
+	// Display color.
+	Color OptString `json:"color"`
+	// Number of tagged files.
+	FileCount int64 `json:"fileCount"`
+}
+
+// GetID returns the value of ID.
+func (s *Tag) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Tag) GetName() string {
+	return s.Name
+}
+
+// GetColor returns the value of Color.
+func (s *Tag) GetColor() OptString {
+	return s.Color
+}
+
+// GetFileCount returns the value of FileCount.
+func (s *Tag) GetFileCount() int64 {
+	return s.FileCount
+}
+
+// SetID sets the value of ID.
+func (s *Tag) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Tag) SetName(val string) {
+	s.Name = val
+}
+
+// SetColor sets the value of Color.
+func (s *Tag) SetColor(val OptString) {
+	s.Color = val
+}
+
+// SetFileCount sets the value of FileCount.
+func (s *Tag) SetFileCount(val int64) {
+	s.FileCount = val
+}
+
+// Tag create request.
+// Ref: #/components/schemas/TagCreate
+type TagCreate struct {
+	// Tag name.
+	Name string `json:"name"`
+	// Display color.
+	Color OptString `json:"color"`
+}
+
+// GetName returns the value of Name.
+func (s *TagCreate) GetName() string {
+	return s.Name
+}
+
+// GetColor returns the value of Color.
+func (s *TagCreate) GetColor() OptString {
+	return s.Color
+}
+
+// SetName sets the value of Name.
+func (s *TagCreate) SetName(val string) {
+	s.Name = val
+}
+
+// SetColor sets the value of Color.
+func (s *TagCreate) SetColor(val OptString) {
+	s.Color = val
+}
+
+// Usage of a tag.
+// Ref: #/components/schemas/TagStats
+type TagStats struct {
+	// Tag name.
+	Name string `json:"name"`
+	// Number of tagged files.
+	FileCount int64 `json:"fileCount"`
+	// Number of tagged folders.
+	FolderCount OptInt64 `json:"folderCount"`
+	// Total size of the tagged files in bytes.
+	TotalSize int64 `json:"totalSize"`
+}
+
+// GetName returns the value of Name.
+func (s *TagStats) GetName() string {
+	return s.Name
+}
+
+// GetFileCount returns the value of FileCount.
+func (s *TagStats) GetFileCount() int64 {
+	return s.FileCount
+}
+
+// GetFolderCount returns the value of FolderCount.
+func (s *TagStats) GetFolderCount() OptInt64 {
+	return s.FolderCount
+}
+
+// GetTotalSize returns the value of TotalSize.
+func (s *TagStats) GetTotalSize() int64 {
+	return s.TotalSize
+}
+
+// SetName sets the value of Name.
+func (s *TagStats) SetName(val string) {
+	s.Name = val
+}
+
+// SetFileCount sets the value of FileCount.
+func (s *TagStats) SetFileCount(val int64) {
+	s.FileCount = val
+}
+
+// SetFolderCount sets the value of FolderCount.
+func (s *TagStats) SetFolderCount(val OptInt64) {
+	s.FolderCount = val
+}
+
+// SetTotalSize sets the value of TotalSize.
+func (s *TagStats) SetTotalSize(val int64) {
+	s.TotalSize = val
+}
+
+// Tag update request.
+// Ref: #/components/schemas/TagUpdate
+type TagUpdate struct {
+	// Tag name.
+	Name OptString `json:"name"`
+	// Display color.
+	Color OptString `json:"color"`
+}
+
+// GetName returns the value of Name.
+func (s *TagUpdate) GetName() OptString {
+	return s.Name
+}
+
+// GetColor returns the value of Color.
+func (s *TagUpdate) GetColor() OptString {
+	return s.Color
+}
+
+// SetName sets the value of Name.
+func (s *TagUpdate) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetColor sets the value of Color.
+func (s *TagUpdate) SetColor(val OptString) {
+	s.Color = val
+}
+
+// TagsDeleteNoContent is response for TagsDelete operation.
+type TagsDeleteNoContent struct{}
+
+// Page of the photo timeline.
+// Ref: #/components/schemas/Timeline
+type Timeline struct {
+	Groups []TimelineGroup `json:"groups"`
+	// Cursor of the next page, a month can continue on the next page.
+	NextCursor OptString `json:"nextCursor"`
+}
+
+// GetGroups returns the value of Groups.
+func (s *Timeline) GetGroups() []TimelineGroup {
+	return s.Groups
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *Timeline) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetGroups sets the value of Groups.
+func (s *Timeline) SetGroups(val []TimelineGroup) {
+	s.Groups = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *Timeline) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// Photos and videos captured in a month.
+// Ref: #/components/schemas/TimelineGroup
+type TimelineGroup struct {
+	// Capture month.
+	Month string `json:"month"`
+	Items []File `json:"items"`
+}
+
+// GetMonth returns the value of Month.
+func (s *TimelineGroup) GetMonth() string {
+	return s.Month
+}
+
+// GetItems returns the value of Items.
+func (s *TimelineGroup) GetItems() []File {
+	return s.Items
+}
+
+// SetMonth sets the value of Month.
+func (s *TimelineGroup) SetMonth(val string) {
+	s.Month = val
+}
+
+// SetItems sets the value of Items.
+func (s *TimelineGroup) SetItems(val []File) {
+	s.Items = val
+}
+
+// Page of music tracks.
+// Ref: #/components/schemas/TrackList
+type TrackList struct {
+	Items []File `json:"items"`
+	// Cursor of the next page.
+	NextCursor OptString `json:"nextCursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *TrackList) GetItems() []File {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *TrackList) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *TrackList) SetItems(val []File) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *TrackList) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
 // Details of an uploaded part.
 // Ref: #/components/schemas/UploadPart
 type UploadPart struct {
@@ -3373,6 +7830,9 @@ type UploadPart struct {
 	Encrypted bool `json:"encrypted"`
 	// Salt value used for encryption, required if encrypted is true.
 	Salt OptString `json:"salt"`
+	// ID of the encryption key used for the part.
+	KeyId  OptString           `json:"keyId"`
+	Format OptEncryptionFormat `json:"format"`
 }
 
 // GetName returns the value of Name.
@@ -3410,6 +7870,16 @@ func (s *UploadPart) GetSalt() OptString {
 	return s.Salt
 }
 
+// GetKeyId returns the value of KeyId.
+func (s *UploadPart) GetKeyId() OptString {
+	return s.KeyId
+}
+
+// GetFormat returns the value of Format.
+func (s *UploadPart) GetFormat() OptEncryptionFormat {
+	return s.Format
+}
+
 // SetName sets the value of Name.
 func (s *UploadPart) SetName(val string) {
 	s.Name = val
@@ -3445,6 +7915,16 @@ func (s *UploadPart) SetSalt(val OptString) {
 	s.Salt = val
 }
 
+// SetKeyId sets the value of KeyId.
+func (s *UploadPart) SetKeyId(val OptString) {
+	s.KeyId = val
+}
+
+// SetFormat sets the value of Format.
+func (s *UploadPart) SetFormat(val OptEncryptionFormat) {
+	s.Format = val
+}
+
 // Statistics about the upload.
 // Ref: #/components/schemas/UploadStats
 type UploadStats struct {
@@ -3546,6 +8026,81 @@ func (s *UserConfig) SetBots(val []string) {
 	s.Bots = val
 }
 
+// State of the user's personal encryption key.
+// Ref: #/components/schemas/UserEncryptionKey
+type UserEncryptionKey struct {
+	// Whether the user has a personal encryption key.
+	Configured bool `json:"configured"`
+	// Whether the key is unlocked for the current session.
+	Unlocked bool `json:"unlocked"`
+}
+
+// GetConfigured returns the value of Configured.
+func (s *UserEncryptionKey) GetConfigured() bool {
+	return s.Configured
+}
+
+// GetUnlocked returns the value of Unlocked.
+func (s *UserEncryptionKey) GetUnlocked() bool {
+	return s.Unlocked
+}
+
+// SetConfigured sets the value of Configured.
+func (s *UserEncryptionKey) SetConfigured(val bool) {
+	s.Configured = val
+}
+
+// SetUnlocked sets the value of Unlocked.
+func (s *UserEncryptionKey) SetUnlocked(val bool) {
+	s.Unlocked = val
+}
+
+// Unlock the personal encryption key for the current session.
+// Ref: #/components/schemas/UserEncryptionKeyUnlock
+type UserEncryptionKeyUnlock struct {
+	// Passphrase the key is wrapped with.
+	Passphrase string `json:"passphrase"`
+}
+
+// GetPassphrase returns the value of Passphrase.
+func (s *UserEncryptionKeyUnlock) GetPassphrase() string {
+	return s.Passphrase
+}
+
+// SetPassphrase sets the value of Passphrase.
+func (s *UserEncryptionKeyUnlock) SetPassphrase(val string) {
+	s.Passphrase = val
+}
+
+// Create a personal encryption key or change its passphrase.
+// Ref: #/components/schemas/UserEncryptionKeyUpdate
+type UserEncryptionKeyUpdate struct {
+	// Current passphrase, or the initial one when no key exists.
+	Passphrase string `json:"passphrase"`
+	// Passphrase to rewrap an existing key with.
+	NewPassphrase OptString `json:"newPassphrase"`
+}
+
+// GetPassphrase returns the value of Passphrase.
+func (s *UserEncryptionKeyUpdate) GetPassphrase() string {
+	return s.Passphrase
+}
+
+// GetNewPassphrase returns the value of NewPassphrase.
+func (s *UserEncryptionKeyUpdate) GetNewPassphrase() OptString {
+	return s.NewPassphrase
+}
+
+// SetPassphrase sets the value of Passphrase.
+func (s *UserEncryptionKeyUpdate) SetPassphrase(val string) {
+	s.Passphrase = val
+}
+
+// SetNewPassphrase sets the value of NewPassphrase.
+func (s *UserEncryptionKeyUpdate) SetNewPassphrase(val OptString) {
+	s.NewPassphrase = val
+}
+
 // User session information.
 // Ref: #/components/schemas/UserSession
 type UserSession struct {
@@ -3644,6 +8199,9 @@ type UsersCreateChannelCreated struct{}
 // UsersDeleteChannelNoContent is response for UsersDeleteChannel operation.
 type UsersDeleteChannelNoContent struct{}
 
+// UsersLockEncryptionKeyNoContent is response for UsersLockEncryptionKey operation.
+type UsersLockEncryptionKeyNoContent struct{}
+
 type UsersProfileImageOK struct {
 	Data io.Reader
 }
@@ -3726,5 +8284,14 @@ type UsersRemoveSessionNoContent struct{}
 // UsersSyncChannelsNoContent is response for UsersSyncChannels operation.
 type UsersSyncChannelsNoContent struct{}
 
+// UsersUnlockEncryptionKeyNoContent is response for UsersUnlockEncryptionKey operation.
+type UsersUnlockEncryptionKeyNoContent struct{}
+
 // UsersUpdateChannelNoContent is response for UsersUpdateChannel operation.
 type UsersUpdateChannelNoContent struct{}
+
+// UsersUpdateEncryptionKeyNoContent is response for UsersUpdateEncryptionKey operation.
+type UsersUpdateEncryptionKeyNoContent struct{}
+
+// UsersUpdateStoragePolicyNoContent is response for UsersUpdateStoragePolicy operation.
+type UsersUpdateStoragePolicyNoContent struct{}
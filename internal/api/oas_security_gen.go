@@ -36,37 +36,78 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesApiKeyAuth = map[string][]string{
-	AuthLogoutOperation:         []string{},
-	EventsGetEventsOperation:    []string{},
-	FilesCategoryStatsOperation: []string{},
-	FilesCopyOperation:          []string{},
-	FilesCreateOperation:        []string{},
-	FilesCreateShareOperation:   []string{},
-	FilesDeleteOperation:        []string{},
-	FilesDeleteShareOperation:   []string{},
-	FilesEditShareOperation:     []string{},
-	FilesGetByIdOperation:       []string{},
-	FilesListOperation:          []string{},
-	FilesMkdirOperation:         []string{},
-	FilesMoveOperation:          []string{},
-	FilesShareByidOperation:     []string{},
-	FilesUpdateOperation:        []string{},
-	FilesUpdatePartsOperation:   []string{},
-	UploadsDeleteOperation:      []string{},
-	UploadsPartsByIdOperation:   []string{},
-	UploadsStatsOperation:       []string{},
-	UploadsUploadOperation:      []string{},
-	UsersAddBotsOperation:       []string{},
-	UsersCreateChannelOperation: []string{},
-	UsersDeleteChannelOperation: []string{},
-	UsersListChannelsOperation:  []string{},
-	UsersListSessionsOperation:  []string{},
-	UsersProfileImageOperation:  []string{},
-	UsersRemoveBotsOperation:    []string{},
-	UsersRemoveSessionOperation: []string{},
-	UsersStatsOperation:         []string{},
-	UsersSyncChannelsOperation:  []string{},
-	UsersUpdateChannelOperation: []string{},
+	AuthLogoutOperation:                  []string{},
+	EventsGetEventsOperation:             []string{},
+	FilesBatchOperation:                  []string{},
+	FilesCategoryStatsOperation:          []string{},
+	FilesCopyOperation:                   []string{},
+	FilesCreateOperation:                 []string{},
+	FilesCreateShareOperation:            []string{},
+	FilesDeleteOperation:                 []string{},
+	FilesDeleteShareOperation:            []string{},
+	FilesEditShareOperation:              []string{},
+	FilesGetByIdOperation:                []string{},
+	FilesGrantPermissionOperation:        []string{},
+	FilesListOperation:                   []string{},
+	FilesListPermissionsOperation:        []string{},
+	FilesMkdirOperation:                  []string{},
+	FilesMoveOperation:                   []string{},
+	FilesRevokePermissionOperation:       []string{},
+	FilesShareAnalyticsOperation:         []string{},
+	FilesShareByidOperation:              []string{},
+	FilesStarOperation:                   []string{},
+	FilesUnstarOperation:                 []string{},
+	FilesUpdateOperation:                 []string{},
+	FilesUpdatePartsOperation:            []string{},
+	FilesUpdateTagsOperation:             []string{},
+	JobsCancelOperation:                  []string{},
+	JobsGetOperation:                     []string{},
+	JobsListOperation:                    []string{},
+	JobsRetryOperation:                   []string{},
+	MediaAlbumsOperation:                 []string{},
+	MediaArtistsOperation:                []string{},
+	MediaTimelineOperation:               []string{},
+	MediaTracksOperation:                 []string{},
+	SearchesCreateOperation:              []string{},
+	SearchesDeleteOperation:              []string{},
+	SearchesListOperation:                []string{},
+	SearchesUpdateOperation:              []string{},
+	SharesAnalyticsOperation:             []string{},
+	SharesCreateOperation:                []string{},
+	SharesDeleteOperation:                []string{},
+	TagsCreateOperation:                  []string{},
+	TagsDeleteOperation:                  []string{},
+	TagsListOperation:                    []string{},
+	TagsStatsOperation:                   []string{},
+	TagsUpdateOperation:                  []string{},
+	UploadsDeleteOperation:               []string{},
+	UploadsPartsByIdOperation:            []string{},
+	UploadsStatsOperation:                []string{},
+	UploadsUploadOperation:               []string{},
+	UsersAddBotsOperation:                []string{},
+	UsersCreateChannelOperation:          []string{},
+	UsersCreateChannelImportOperation:    []string{},
+	UsersCreateChannelMigrationOperation: []string{},
+	UsersCreateReencryptionOperation:     []string{},
+	UsersDeleteChannelOperation:          []string{},
+	UsersGetChannelMigrationOperation:    []string{},
+	UsersGetEncryptionKeyOperation:       []string{},
+	UsersGetReencryptionOperation:        []string{},
+	UsersGetStoragePolicyOperation:       []string{},
+	UsersListChannelMigrationsOperation:  []string{},
+	UsersListChannelsOperation:           []string{},
+	UsersListReencryptionsOperation:      []string{},
+	UsersListSessionsOperation:           []string{},
+	UsersLockEncryptionKeyOperation:      []string{},
+	UsersProfileImageOperation:           []string{},
+	UsersRemoveBotsOperation:             []string{},
+	UsersRemoveSessionOperation:          []string{},
+	UsersStatsOperation:                  []string{},
+	UsersSyncChannelsOperation:           []string{},
+	UsersUnlockEncryptionKeyOperation:    []string{},
+	UsersUpdateChannelOperation:          []string{},
+	UsersUpdateEncryptionKeyOperation:    []string{},
+	UsersUpdateStoragePolicyOperation:    []string{},
 }
 
 func (s *Server) securityApiKeyAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
@@ -93,37 +134,78 @@ func (s *Server) securityApiKeyAuth(ctx context.Context, operationName Operation
 }
 
 var operationRolesBearerAuth = map[string][]string{
-	AuthLogoutOperation:         []string{},
-	EventsGetEventsOperation:    []string{},
-	FilesCategoryStatsOperation: []string{},
-	FilesCopyOperation:          []string{},
-	FilesCreateOperation:        []string{},
-	FilesCreateShareOperation:   []string{},
-	FilesDeleteOperation:        []string{},
-	FilesDeleteShareOperation:   []string{},
-	FilesEditShareOperation:     []string{},
-	FilesGetByIdOperation:       []string{},
-	FilesListOperation:          []string{},
-	FilesMkdirOperation:         []string{},
-	FilesMoveOperation:          []string{},
-	FilesShareByidOperation:     []string{},
-	FilesUpdateOperation:        []string{},
-	FilesUpdatePartsOperation:   []string{},
-	UploadsDeleteOperation:      []string{},
-	UploadsPartsByIdOperation:   []string{},
-	UploadsStatsOperation:       []string{},
-	UploadsUploadOperation:      []string{},
-	UsersAddBotsOperation:       []string{},
-	UsersCreateChannelOperation: []string{},
-	UsersDeleteChannelOperation: []string{},
-	UsersListChannelsOperation:  []string{},
-	UsersListSessionsOperation:  []string{},
-	UsersProfileImageOperation:  []string{},
-	UsersRemoveBotsOperation:    []string{},
-	UsersRemoveSessionOperation: []string{},
-	UsersStatsOperation:         []string{},
-	UsersSyncChannelsOperation:  []string{},
-	UsersUpdateChannelOperation: []string{},
+	AuthLogoutOperation:                  []string{},
+	EventsGetEventsOperation:             []string{},
+	FilesBatchOperation:                  []string{},
+	FilesCategoryStatsOperation:          []string{},
+	FilesCopyOperation:                   []string{},
+	FilesCreateOperation:                 []string{},
+	FilesCreateShareOperation:            []string{},
+	FilesDeleteOperation:                 []string{},
+	FilesDeleteShareOperation:            []string{},
+	FilesEditShareOperation:              []string{},
+	FilesGetByIdOperation:                []string{},
+	FilesGrantPermissionOperation:        []string{},
+	FilesListOperation:                   []string{},
+	FilesListPermissionsOperation:        []string{},
+	FilesMkdirOperation:                  []string{},
+	FilesMoveOperation:                   []string{},
+	FilesRevokePermissionOperation:       []string{},
+	FilesShareAnalyticsOperation:         []string{},
+	FilesShareByidOperation:              []string{},
+	FilesStarOperation:                   []string{},
+	FilesUnstarOperation:                 []string{},
+	FilesUpdateOperation:                 []string{},
+	FilesUpdatePartsOperation:            []string{},
+	FilesUpdateTagsOperation:             []string{},
+	JobsCancelOperation:                  []string{},
+	JobsGetOperation:                     []string{},
+	JobsListOperation:                    []string{},
+	JobsRetryOperation:                   []string{},
+	MediaAlbumsOperation:                 []string{},
+	MediaArtistsOperation:                []string{},
+	MediaTimelineOperation:               []string{},
+	MediaTracksOperation:                 []string{},
+	SearchesCreateOperation:              []string{},
+	SearchesDeleteOperation:              []string{},
+	SearchesListOperation:                []string{},
+	SearchesUpdateOperation:              []string{},
+	SharesAnalyticsOperation:             []string{},
+	SharesCreateOperation:                []string{},
+	SharesDeleteOperation:                []string{},
+	TagsCreateOperation:                  []string{},
+	TagsDeleteOperation:                  []string{},
+	TagsListOperation:                    []string{},
+	TagsStatsOperation:                   []string{},
+	TagsUpdateOperation:                  []string{},
+	UploadsDeleteOperation:               []string{},
+	UploadsPartsByIdOperation:            []string{},
+	UploadsStatsOperation:                []string{},
+	UploadsUploadOperation:               []string{},
+	UsersAddBotsOperation:                []string{},
+	UsersCreateChannelOperation:          []string{},
+	UsersCreateChannelImportOperation:    []string{},
+	UsersCreateChannelMigrationOperation: []string{},
+	UsersCreateReencryptionOperation:     []string{},
+	UsersDeleteChannelOperation:          []string{},
+	UsersGetChannelMigrationOperation:    []string{},
+	UsersGetEncryptionKeyOperation:       []string{},
+	UsersGetReencryptionOperation:        []string{},
+	UsersGetStoragePolicyOperation:       []string{},
+	UsersListChannelMigrationsOperation:  []string{},
+	UsersListChannelsOperation:           []string{},
+	UsersListReencryptionsOperation:      []string{},
+	UsersListSessionsOperation:           []string{},
+	UsersLockEncryptionKeyOperation:      []string{},
+	UsersProfileImageOperation:           []string{},
+	UsersRemoveBotsOperation:             []string{},
+	UsersRemoveSessionOperation:          []string{},
+	UsersStatsOperation:                  []string{},
+	UsersSyncChannelsOperation:           []string{},
+	UsersUnlockEncryptionKeyOperation:    []string{},
+	UsersUpdateChannelOperation:          []string{},
+	UsersUpdateEncryptionKeyOperation:    []string{},
+	UsersUpdateStoragePolicyOperation:    []string{},
 }
 
 func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
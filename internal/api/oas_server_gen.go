@@ -38,6 +38,12 @@ type Handler interface {
 	//
 	// GET /events
 	EventsGetEvents(ctx context.Context) ([]Event, error)
+	// FilesBatch implements Files_batch operation.
+	//
+	// Run a batch of file operations.
+	//
+	// POST /files/batch
+	FilesBatch(ctx context.Context, req *FileBatch) (*FileBatchResult, error)
 	// FilesCategoryStats implements Files_categoryStats operation.
 	//
 	// Get category stats.
@@ -86,12 +92,24 @@ type Handler interface {
 	//
 	// GET /files/{id}
 	FilesGetById(ctx context.Context, params FilesGetByIdParams) (*File, error)
+	// FilesGrantPermission implements Files_grantPermission operation.
+	//
+	// Grant access to a user.
+	//
+	// POST /files/{id}/permissions
+	FilesGrantPermission(ctx context.Context, req *FilePermissionCreate, params FilesGrantPermissionParams) (*FilePermission, error)
 	// FilesList implements Files_list operation.
 	//
 	// List all files.
 	//
 	// GET /files
 	FilesList(ctx context.Context, params FilesListParams) (*FileList, error)
+	// FilesListPermissions implements Files_listPermissions operation.
+	//
+	// List users with access.
+	//
+	// GET /files/{id}/permissions
+	FilesListPermissions(ctx context.Context, params FilesListPermissionsParams) ([]FilePermission, error)
 	// FilesMkdir implements Files_mkdir operation.
 	//
 	// Create Folders.
@@ -104,18 +122,48 @@ type Handler interface {
 	//
 	// POST /files/move
 	FilesMove(ctx context.Context, req *FileMove) error
+	// FilesRevokePermission implements Files_revokePermission operation.
+	//
+	// Revoke access of a user.
+	//
+	// DELETE /files/{id}/permissions/{userId}
+	FilesRevokePermission(ctx context.Context, params FilesRevokePermissionParams) error
+	// FilesShareAnalytics implements Files_shareAnalytics operation.
+	//
+	// Get access analytics of the latest share of a file.
+	//
+	// GET /files/{id}/share/analytics
+	FilesShareAnalytics(ctx context.Context, params FilesShareAnalyticsParams) (*ShareAnalytics, error)
 	// FilesShareByid implements Files_shareByid operation.
 	//
 	// Get share by file ID.
 	//
 	// GET /files/{id}/share
 	FilesShareByid(ctx context.Context, params FilesShareByidParams) (*FileShare, error)
+	// FilesStar implements Files_star operation.
+	//
+	// Star a file.
+	//
+	// PUT /files/{id}/star
+	FilesStar(ctx context.Context, params FilesStarParams) error
 	// FilesStream implements Files_stream operation.
 	//
 	// Stream or Download file.
 	//
 	// GET /files/{id}/{name}
 	FilesStream(ctx context.Context, params FilesStreamParams) (FilesStreamRes, error)
+	// FilesThumbnail implements Files_thumbnail operation.
+	//
+	// Get an image thumbnail.
+	//
+	// GET /files/{id}/thumbnail
+	FilesThumbnail(ctx context.Context, params FilesThumbnailParams) (FilesThumbnailOK, error)
+	// FilesUnstar implements Files_unstar operation.
+	//
+	// Remove the star from a file.
+	//
+	// DELETE /files/{id}/star
+	FilesUnstar(ctx context.Context, params FilesUnstarParams) error
 	// FilesUpdate implements Files_update operation.
 	//
 	// Update file.
@@ -128,6 +176,108 @@ type Handler interface {
 	//
 	// PUT /files/{id}/parts
 	FilesUpdateParts(ctx context.Context, req *FilePartsUpdate, params FilesUpdatePartsParams) error
+	// FilesUpdateTags implements Files_updateTags operation.
+	//
+	// Add or remove tags on files.
+	//
+	// POST /files/tags
+	FilesUpdateTags(ctx context.Context, req *FileTagsUpdate) error
+	// JobsCancel implements Jobs_cancel operation.
+	//
+	// Cancel job.
+	//
+	// POST /jobs/{id}/cancel
+	JobsCancel(ctx context.Context, params JobsCancelParams) (*Job, error)
+	// JobsGet implements Jobs_get operation.
+	//
+	// Get job.
+	//
+	// GET /jobs/{id}
+	JobsGet(ctx context.Context, params JobsGetParams) (*Job, error)
+	// JobsList implements Jobs_list operation.
+	//
+	// List jobs.
+	//
+	// GET /jobs
+	JobsList(ctx context.Context, params JobsListParams) ([]Job, error)
+	// JobsRetry implements Jobs_retry operation.
+	//
+	// Retry job.
+	//
+	// POST /jobs/{id}/retry
+	JobsRetry(ctx context.Context, params JobsRetryParams) (*Job, error)
+	// MediaAlbums implements Media_albums operation.
+	//
+	// List music albums.
+	//
+	// GET /media/albums
+	MediaAlbums(ctx context.Context, params MediaAlbumsParams) ([]MusicAlbum, error)
+	// MediaArtists implements Media_artists operation.
+	//
+	// List music artists.
+	//
+	// GET /media/artists
+	MediaArtists(ctx context.Context) ([]MusicArtist, error)
+	// MediaTimeline implements Media_timeline operation.
+	//
+	// List photos and videos by capture month.
+	//
+	// GET /media/timeline
+	MediaTimeline(ctx context.Context, params MediaTimelineParams) (*Timeline, error)
+	// MediaTracks implements Media_tracks operation.
+	//
+	// List music tracks.
+	//
+	// GET /media/tracks
+	MediaTracks(ctx context.Context, params MediaTracksParams) (*TrackList, error)
+	// SearchesCreate implements Searches_create operation.
+	//
+	// Save a search.
+	//
+	// POST /searches
+	SearchesCreate(ctx context.Context, req *SavedSearch) (*SavedSearch, error)
+	// SearchesDelete implements Searches_delete operation.
+	//
+	// Delete a saved search.
+	//
+	// DELETE /searches/{id}
+	SearchesDelete(ctx context.Context, params SearchesDeleteParams) error
+	// SearchesList implements Searches_list operation.
+	//
+	// List saved searches.
+	//
+	// GET /searches
+	SearchesList(ctx context.Context) ([]SavedSearch, error)
+	// SearchesUpdate implements Searches_update operation.
+	//
+	// Update a saved search.
+	//
+	// PATCH /searches/{id}
+	SearchesUpdate(ctx context.Context, req *SavedSearchUpdate, params SearchesUpdateParams) (*SavedSearch, error)
+	// SharesAnalytics implements Shares_analytics operation.
+	//
+	// Get share access analytics.
+	//
+	// GET /shares/{id}/analytics
+	SharesAnalytics(ctx context.Context, params SharesAnalyticsParams) (*ShareAnalytics, error)
+	// SharesCreate implements Shares_create operation.
+	//
+	// Create share for several files.
+	//
+	// POST /shares
+	SharesCreate(ctx context.Context, req *ShareCreate) (*FileShare, error)
+	// SharesCreateFile implements Shares_createFile operation.
+	//
+	// Create file in an upload share.
+	//
+	// POST /shares/{id}/files
+	SharesCreateFile(ctx context.Context, req *ShareFileCreate, params SharesCreateFileParams) (*File, error)
+	// SharesDelete implements Shares_delete operation.
+	//
+	// Delete share.
+	//
+	// DELETE /shares/{id}
+	SharesDelete(ctx context.Context, params SharesDeleteParams) error
 	// SharesGetById implements Shares_getById operation.
 	//
 	// Get share by ID.
@@ -152,6 +302,48 @@ type Handler interface {
 	//
 	// POST /shares/{id}/unlock
 	SharesUnlock(ctx context.Context, req *ShareUnlock, params SharesUnlockParams) error
+	// SharesUpload implements Shares_upload operation.
+	//
+	// Upload file part to an upload share.
+	//
+	// POST /shares/{id}/uploads/{uploadId}
+	SharesUpload(ctx context.Context, req *SharesUploadReqWithContentType, params SharesUploadParams) (*UploadPart, error)
+	// SharesZip implements Shares_zip operation.
+	//
+	// Download shared files as a zip archive.
+	//
+	// GET /shares/{id}/zip
+	SharesZip(ctx context.Context, params SharesZipParams) (*SharesZipOKHeaders, error)
+	// TagsCreate implements Tags_create operation.
+	//
+	// Create tag.
+	//
+	// POST /tags
+	TagsCreate(ctx context.Context, req *TagCreate) (*Tag, error)
+	// TagsDelete implements Tags_delete operation.
+	//
+	// Delete tag.
+	//
+	// DELETE /tags/{id}
+	TagsDelete(ctx context.Context, params TagsDeleteParams) error
+	// TagsList implements Tags_list operation.
+	//
+	// List tags.
+	//
+	// GET /tags
+	TagsList(ctx context.Context) ([]Tag, error)
+	// TagsStats implements Tags_stats operation.
+	//
+	// Get tag usage statistics.
+	//
+	// GET /tags/stats
+	TagsStats(ctx context.Context) ([]TagStats, error)
+	// TagsUpdate implements Tags_update operation.
+	//
+	// Update tag.
+	//
+	// PATCH /tags/{id}
+	TagsUpdate(ctx context.Context, req *TagUpdate, params TagsUpdateParams) (*Tag, error)
 	// UploadsDelete implements Uploads_delete operation.
 	//
 	// Delete uploaded file.
@@ -188,24 +380,84 @@ type Handler interface {
 	//
 	// POST /users/channels
 	UsersCreateChannel(ctx context.Context, req *Channel) error
+	// UsersCreateChannelImport implements Users_createChannelImport operation.
+	//
+	// Import a channel.
+	//
+	// POST /users/channels/imports
+	UsersCreateChannelImport(ctx context.Context, req *ChannelImportCreate) (*Job, error)
+	// UsersCreateChannelMigration implements Users_createChannelMigration operation.
+	//
+	// Start a channel migration.
+	//
+	// POST /users/channels/migrations
+	UsersCreateChannelMigration(ctx context.Context, req *ChannelMigrationCreate) (*ChannelMigration, error)
+	// UsersCreateReencryption implements Users_createReencryption operation.
+	//
+	// Re-encrypt files with the active key.
+	//
+	// POST /users/reencryptions
+	UsersCreateReencryption(ctx context.Context) (*ReencryptionJob, error)
 	// UsersDeleteChannel implements Users_deleteChannel operation.
 	//
 	// Delete user channel.
 	//
 	// DELETE /users/channels/{id}
 	UsersDeleteChannel(ctx context.Context, params UsersDeleteChannelParams) error
+	// UsersGetChannelMigration implements Users_getChannelMigration operation.
+	//
+	// Get channel migration.
+	//
+	// GET /users/channels/migrations/{id}
+	UsersGetChannelMigration(ctx context.Context, params UsersGetChannelMigrationParams) (*ChannelMigration, error)
+	// UsersGetEncryptionKey implements Users_getEncryptionKey operation.
+	//
+	// Get personal encryption key state.
+	//
+	// GET /users/encryption-key
+	UsersGetEncryptionKey(ctx context.Context) (*UserEncryptionKey, error)
+	// UsersGetReencryption implements Users_getReencryption operation.
+	//
+	// Get re-encryption job.
+	//
+	// GET /users/reencryptions/{id}
+	UsersGetReencryption(ctx context.Context, params UsersGetReencryptionParams) (*ReencryptionJob, error)
+	// UsersGetStoragePolicy implements Users_getStoragePolicy operation.
+	//
+	// Get storage policy.
+	//
+	// GET /users/storage-policy
+	UsersGetStoragePolicy(ctx context.Context) (*StoragePolicy, error)
+	// UsersListChannelMigrations implements Users_listChannelMigrations operation.
+	//
+	// List channel migrations.
+	//
+	// GET /users/channels/migrations
+	UsersListChannelMigrations(ctx context.Context) ([]ChannelMigration, error)
 	// UsersListChannels implements Users_listChannels operation.
 	//
 	// List user channels.
 	//
 	// GET /users/channels
 	UsersListChannels(ctx context.Context) ([]Channel, error)
+	// UsersListReencryptions implements Users_listReencryptions operation.
+	//
+	// List re-encryption jobs.
+	//
+	// GET /users/reencryptions
+	UsersListReencryptions(ctx context.Context) ([]ReencryptionJob, error)
 	// UsersListSessions implements Users_listSessions operation.
 	//
 	// List user sessions.
 	//
 	// GET /users/sessions
 	UsersListSessions(ctx context.Context) ([]UserSession, error)
+	// UsersLockEncryptionKey implements Users_lockEncryptionKey operation.
+	//
+	// Lock personal encryption key.
+	//
+	// POST /users/encryption-key/lock
+	UsersLockEncryptionKey(ctx context.Context) error
 	// UsersProfileImage implements Users_profileImage operation.
 	//
 	// Get user profile photo.
@@ -236,12 +488,30 @@ type Handler interface {
 	//
 	// PATCH /users/channels/sync
 	UsersSyncChannels(ctx context.Context) error
+	// UsersUnlockEncryptionKey implements Users_unlockEncryptionKey operation.
+	//
+	// Unlock personal encryption key.
+	//
+	// POST /users/encryption-key/unlock
+	UsersUnlockEncryptionKey(ctx context.Context, req *UserEncryptionKeyUnlock) error
 	// UsersUpdateChannel implements Users_updateChannel operation.
 	//
 	// Update user channel.
 	//
 	// PATCH /users/channels
 	UsersUpdateChannel(ctx context.Context, req *ChannelUpdate) error
+	// UsersUpdateEncryptionKey implements Users_updateEncryptionKey operation.
+	//
+	// Create or rewrap personal encryption key.
+	//
+	// PUT /users/encryption-key
+	UsersUpdateEncryptionKey(ctx context.Context, req *UserEncryptionKeyUpdate) error
+	// UsersUpdateStoragePolicy implements Users_updateStoragePolicy operation.
+	//
+	// Update storage policy.
+	//
+	// PUT /users/storage-policy
+	UsersUpdateStoragePolicy(ctx context.Context, req *StoragePolicy) error
 	// VersionVersion implements Version_version operation.
 	//
 	// Get API version.
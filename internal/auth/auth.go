@@ -53,6 +53,12 @@ func GetJWTUser(c context.Context) *types.JWTClaims {
 	return authUser
 }
 
+// WithUser returns a context that acts on behalf of the given user, for work that is not
+// started by an authenticated request.
+func WithUser(c context.Context, claims *types.JWTClaims) context.Context {
+	return context.WithValue(c, authKey, claims)
+}
+
 func VerifyUser(db *gorm.DB, cache cache.Cacher, secret, authCookie string) (*types.JWTClaims, error) {
 	claims, err := Decode(secret, authCookie)
 
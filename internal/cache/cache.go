@@ -19,12 +19,23 @@ type Cacher interface {
 	Get(key string, value any) error
 	Set(key string, value any, expiration time.Duration) error
 	Delete(keys ...string) error
+	// Incr increments the counter at key and returns its new value. The counter expires
+	// after expiration without increments.
+	Incr(key string, expiration time.Duration) (int64, error)
+	Publish(channel string, message string) error
+	Subscribe(ctx context.Context, channel string, fn func(message string))
 }
 
 type MemoryCache struct {
-	cache  *freecache.Cache
-	prefix string
-	mu     sync.RWMutex
+	cache       *freecache.Cache
+	prefix      string
+	mu          sync.RWMutex
+	subMu       sync.RWMutex
+	subscribers map[string][]*subscriber
+}
+
+type subscriber struct {
+	fn func(message string)
 }
 
 func NewCache(ctx context.Context, conf *config.CacheConfig) Cacher {
@@ -50,8 +61,9 @@ func NewCache(ctx context.Context, conf *config.CacheConfig) Cacher {
 
 func NewMemoryCache(size int) *MemoryCache {
 	return &MemoryCache{
-		cache:  freecache.NewCache(size),
-		prefix: "teldrive:",
+		cache:       freecache.NewCache(size),
+		prefix:      "teldrive:",
+		subscribers: make(map[string][]*subscriber),
 	}
 }
 
@@ -86,6 +98,56 @@ func (m *MemoryCache) Delete(keys ...string) error {
 	return nil
 }
 
+func (m *MemoryCache) Incr(key string, expiration time.Duration) (int64, error) {
+	// The write lock keeps concurrent increments from reading the same value.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key = m.prefix + key
+	var n int64
+	if data, err := m.cache.Get([]byte(key)); err == nil {
+		if err := msgpack.Unmarshal(data, &n); err != nil {
+			return 0, err
+		}
+	} else if !errors.Is(err, freecache.ErrNotFound) {
+		return 0, err
+	}
+	n++
+	data, err := msgpack.Marshal(n)
+	if err != nil {
+		return 0, err
+	}
+	return n, m.cache.Set([]byte(key), data, int(expiration.Seconds()))
+}
+
+func (m *MemoryCache) Publish(channel string, message string) error {
+	m.subMu.RLock()
+	subs := m.subscribers[channel]
+	m.subMu.RUnlock()
+	for _, sub := range subs {
+		sub.fn(message)
+	}
+	return nil
+}
+
+func (m *MemoryCache) Subscribe(ctx context.Context, channel string, fn func(message string)) {
+	sub := &subscriber{fn: fn}
+	m.subMu.Lock()
+	m.subscribers[channel] = append(m.subscribers[channel], sub)
+	m.subMu.Unlock()
+	go func() {
+		<-ctx.Done()
+		m.subMu.Lock()
+		defer m.subMu.Unlock()
+		subs := m.subscribers[channel]
+		for i := range subs {
+			if subs[i] == sub {
+				m.subscribers[channel] = append(subs[:i], subs[i+1:]...)
+				break
+			}
+		}
+	}()
+}
+
 type RedisCache struct {
 	client *redis.Client
 	ctx    context.Context
@@ -132,6 +194,44 @@ func (r *RedisCache) Delete(keys ...string) error {
 	return r.client.Del(r.ctx, keys...).Err()
 }
 
+func (r *RedisCache) Incr(key string, expiration time.Duration) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	key = r.prefix + key
+	var incr *redis.IntCmd
+	if _, err := r.client.TxPipelined(r.ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(r.ctx, key)
+		pipe.PExpire(r.ctx, key, expiration)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (r *RedisCache) Publish(channel string, message string) error {
+	return r.client.Publish(r.ctx, r.prefix+channel, message).Err()
+}
+
+func (r *RedisCache) Subscribe(ctx context.Context, channel string, fn func(message string)) {
+	pubsub := r.client.Subscribe(ctx, r.prefix+channel)
+	go func() {
+		defer pubsub.Close()
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				fn(msg.Payload)
+			}
+		}
+	}()
+}
+
 func Fetch[T any](cache Cacher, key string, expiration time.Duration, fn func() (T, error)) (T, error) {
 	var zero, value T
 	err := cache.Get(key, &value)
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -26,6 +27,28 @@ func TestCache(t *testing.T) {
 	assert.Equal(t, result, value)
 }
 
+func TestPublishSubscribe(t *testing.T) {
+	cache := NewMemoryCache(1 * 1024 * 1024)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var received []string
+	cache.Subscribe(ctx, "bots", func(message string) {
+		received = append(received, message)
+	})
+
+	assert.NoError(t, cache.Publish("bots", "123"))
+	assert.NoError(t, cache.Publish("other", "456"))
+	assert.Equal(t, []string{"123"}, received)
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		cache.subMu.RLock()
+		defer cache.subMu.RUnlock()
+		return len(cache.subscribers["bots"]) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
 func TestKey(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -23,6 +23,8 @@ type ServerCmdConfig struct {
 	TG       TGConfig      `config:"tg"`
 	CronJobs CronJobConfig `config:"cronjobs"`
 	Cache    CacheConfig   `config:"cache"`
+	Search   SearchConfig  `config:"search"`
+	Jobs     JobsConfig    `config:"jobs"`
 }
 
 type ServerConfig struct {
@@ -31,6 +33,7 @@ type ServerConfig struct {
 	EnablePprof      bool          `config:"enable-pprof" description:"Enable pprof debugging endpoints"`
 	ReadTimeout      time.Duration `config:"read-timeout" description:"Maximum duration for reading entire request" default:"1h"`
 	WriteTimeout     time.Duration `config:"write-timeout" description:"Maximum duration for writing response" default:"1h"`
+	TrustedProxies   []string      `config:"trusted-proxies" description:"Addresses or CIDR ranges of reverse proxies whose forwarding headers give the client address"`
 }
 
 type CacheConfig struct {
@@ -39,6 +42,19 @@ type CacheConfig struct {
 	RedisPass string `config:"redis-pass" description:"Redis server password"`
 }
 
+type SearchConfig struct {
+	IndexContent bool  `config:"index-content" description:"Index the text of documents for content search" default:"true"`
+	MaxFileSize  int64 `config:"max-file-size" description:"Largest document in bytes whose text is indexed" default:"20971520"`
+	MaxTextSize  int   `config:"max-text-size" description:"Maximum text in bytes indexed per document" default:"1048576"`
+}
+
+type JobsConfig struct {
+	Workers      int           `config:"workers" description:"Number of background jobs this instance runs at once" default:"2"`
+	PollInterval time.Duration `config:"poll-interval" description:"Interval for checking for new background jobs" default:"30s"`
+	StaleAfter   time.Duration `config:"stale-after" description:"Time without progress after which a running job is taken over" default:"5m"`
+	MaxAttempts  int           `config:"max-attempts" description:"Number of times a job is started before it fails, counted again when it's retried" default:"5"`
+}
+
 type LoggingConfig struct {
 	Level string `config:"level" description:"Logging level (debug, info, warn, error)" default:"info"`
 	File  string `config:"file" description:"Log file path, if empty logs to stdout"`
@@ -69,6 +85,9 @@ type CronJobConfig struct {
 	CleanFilesInterval   time.Duration `config:"clean-files-interval" description:"Interval for cleaning expired files" default:"1h"`
 	CleanUploadsInterval time.Duration `config:"clean-uploads-interval" description:"Interval for cleaning incomplete uploads" default:"12h"`
 	FolderSizeInterval   time.Duration `config:"folder-size-interval" description:"Interval for updating folder sizes" default:"2h"`
+	ThumbnailsInterval   time.Duration `config:"thumbnails-interval" description:"Interval for generating missing image thumbnails" default:"30m"`
+	MediaInfoInterval    time.Duration `config:"media-info-interval" description:"Interval for reading missing media metadata" default:"30m"`
+	ContentIndexInterval time.Duration `config:"content-index-interval" description:"Interval for indexing the text of documents" default:"30m"`
 }
 
 type TGStream struct {
@@ -78,10 +97,13 @@ type TGStream struct {
 }
 
 type TGUpload struct {
-	EncryptionKey string        `config:"encryption-key" description:"Encryption key for uploads" required:"true"`
-	Threads       int           `config:"threads" description:"Number of upload threads" default:"8"`
-	MaxRetries    int           `config:"max-retries" description:"Maximum upload retry attempts" default:"10"`
-	Retention     time.Duration `config:"retention" description:"Upload retention period" default:"7d"`
+	EncryptionKey   string        `config:"encryption-key" description:"Encryption key for uploads" required:"true"`
+	EncryptionKeys  []string      `config:"encryption-keys" description:"Additional encryption keys as id=key pairs"`
+	EncryptionKeyId string        `config:"encryption-key-id" description:"ID of the key used for new encrypted uploads"`
+	UserKeyTTL      time.Duration `config:"user-key-ttl" description:"How long an unlocked personal encryption key stays available" default:"1h"`
+	Threads         int           `config:"threads" description:"Number of upload threads" default:"8"`
+	MaxRetries      int           `config:"max-retries" description:"Maximum upload retry attempts" default:"10"`
+	Retention       time.Duration `config:"retention" description:"Upload retention period" default:"7d"`
 }
 type TGConfig struct {
 	RateLimit         bool          `config:"rate-limit" description:"Enable rate limiting for API calls" default:"true"`
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EncryptionKeyFor returns the key registered under id. The empty id refers to
+// encryption-key, which is what parts uploaded before key ids existed were sealed with.
+func (u *TGUpload) EncryptionKeyFor(id string) (string, error) {
+	if id == "" {
+		if u.EncryptionKey == "" {
+			return "", fmt.Errorf("encryption key is not set")
+		}
+		return u.EncryptionKey, nil
+	}
+	for _, entry := range u.EncryptionKeys {
+		keyId, key, ok := strings.Cut(entry, "=")
+		if ok && keyId == id && key != "" {
+			return key, nil
+		}
+	}
+	return "", fmt.Errorf("encryption key %q not found", id)
+}
+
+// ActiveEncryptionKey returns the id and key used to encrypt new uploads.
+func (u *TGUpload) ActiveEncryptionKey() (string, string, error) {
+	key, err := u.EncryptionKeyFor(u.EncryptionKeyId)
+	if err != nil {
+		return "", "", err
+	}
+	return u.EncryptionKeyId, key, nil
+}
+
+// EncryptionKeyIds returns the ids of all configured keys, the empty id first when
+// encryption-key is set.
+func (u *TGUpload) EncryptionKeyIds() []string {
+	var ids []string
+	if u.EncryptionKey != "" {
+		ids = append(ids, "")
+	}
+	for _, entry := range u.EncryptionKeys {
+		if keyId, key, ok := strings.Cut(entry, "="); ok && keyId != "" && key != "" {
+			ids = append(ids, keyId)
+		}
+	}
+	return ids
+}
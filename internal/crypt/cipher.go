@@ -19,8 +19,11 @@ const (
 	nameCipherBlockSize = aes.BlockSize
 	fileMagic           = "TELDRIVE\x00\x00"
 	fileMagicSize       = len(fileMagic)
+	rcloneMagic         = "RCLONE\x00\x00"
+	rcloneMagicSize     = len(rcloneMagic)
 	fileNonceSize       = 24
 	fileHeaderSize      = fileMagicSize + fileNonceSize
+	rcloneHeaderSize    = rcloneMagicSize + fileNonceSize
 	blockHeaderSize     = secretbox.Overhead
 	blockDataSize       = 64 * 1024
 	blockSize           = blockHeaderSize + blockDataSize
@@ -35,9 +38,59 @@ var (
 )
 
 var (
-	fileMagicBytes = []byte(fileMagic)
+	fileMagicBytes   = []byte(fileMagic)
+	rcloneMagicBytes = []byte(rcloneMagic)
 )
 
+// Format selects the header written in front of encrypted data. Both formats share
+// the block layout and key derivation, so FormatRclone output can be read by rclone
+// crypt v1 configured with the same key as password and the salt as password2.
+type Format string
+
+const (
+	FormatTelDrive Format = "teldrive"
+	FormatRclone   Format = "rclone"
+)
+
+func (f Format) magic() []byte {
+	if f == FormatRclone {
+		return rcloneMagicBytes
+	}
+	return fileMagicBytes
+}
+
+func (f Format) headerSize() int {
+	return len(f.magic()) + fileNonceSize
+}
+
+// EncryptedSize returns the size of size bytes of plaintext once encrypted.
+func (f Format) EncryptedSize(size int64) int64 {
+	blocks, residue := size/blockDataSize, size%blockDataSize
+	encryptedSize := int64(f.headerSize()) + blocks*(blockHeaderSize+blockDataSize)
+	if residue != 0 {
+		encryptedSize += blockHeaderSize + residue
+	}
+	return encryptedSize
+}
+
+// DecryptedSize returns the plaintext size of size bytes of encrypted data.
+func (f Format) DecryptedSize(size int64) (int64, error) {
+	size -= int64(f.headerSize())
+	if size < 0 {
+		return 0, ErrorEncryptedFileTooShort
+	}
+	blocks, residue := size/blockSize, size%blockSize
+	decryptedSize := blocks * blockDataSize
+	if residue != 0 {
+		residue -= blockHeaderSize
+		if residue <= 0 {
+			return 0, ErrorEncryptedFileBadHeader
+		}
+	}
+	decryptedSize += residue
+	return decryptedSize, nil
+}
+
 type ReadSeekCloser interface {
 	io.Reader
 	io.Seeker
@@ -168,13 +221,14 @@ type encrypter struct {
 	err      error
 }
 
-func (c *Cipher) newEncrypter(in io.Reader, nonce *nonce) (*encrypter, error) {
+func (c *Cipher) newEncrypter(in io.Reader, nonce *nonce, format Format) (*encrypter, error) {
+	magic := format.magic()
 	fh := &encrypter{
 		in:      in,
 		c:       c,
 		buf:     c.getBlock(),
 		readBuf: c.getBlock(),
-		bufSize: fileHeaderSize,
+		bufSize: format.headerSize(),
 	}
 
 	if nonce != nil {
@@ -186,9 +240,9 @@ func (c *Cipher) newEncrypter(in io.Reader, nonce *nonce) (*encrypter, error) {
 		}
 	}
 
-	copy((*fh.buf)[:], fileMagicBytes)
+	copy((*fh.buf)[:], magic)
 
-	copy((*fh.buf)[fileMagicSize:], fh.nonce[:])
+	copy((*fh.buf)[len(magic):], fh.nonce[:])
 	return fh, nil
 }
 
@@ -234,7 +288,12 @@ func (fh *encrypter) Close() error {
 }
 
 func (c *Cipher) EncryptData(in io.Reader) (io.ReadCloser, error) {
-	return c.newEncrypter(in, nil)
+	return c.newEncrypter(in, nil, FormatTelDrive)
+}
+
+// EncryptDataFormat is EncryptData with a selectable header format.
+func (c *Cipher) EncryptDataFormat(in io.Reader, format Format) (io.ReadCloser, error) {
+	return c.newEncrypter(in, nil, format)
 }
 
 type decrypter struct {
@@ -250,6 +309,7 @@ type decrypter struct {
 	err          error
 	limit        int64
 	open         OpenRangeSeek
+	headerSize   int
 }
 
 func (c *Cipher) newDecrypter(rc io.ReadCloser) (*decrypter, error) {
@@ -261,20 +321,34 @@ func (c *Cipher) newDecrypter(rc io.ReadCloser) (*decrypter, error) {
 		limit:   -1,
 	}
 
-	readBuf := (*fh.readBuf)[:fileHeaderSize]
+	// The rclone header is the shorter one, so read that much first and only read
+	// the rest of a TelDrive header when the magic does not match rclone's.
+	readBuf := (*fh.readBuf)[:rcloneHeaderSize]
 	n, err := readFill(fh.rc, readBuf)
-	if n < fileHeaderSize && err == io.EOF {
+	if n < rcloneHeaderSize && err == io.EOF {
 
 		return nil, fh.finishAndClose(ErrorEncryptedFileTooShort)
 	} else if err != io.EOF && err != nil {
 		return nil, fh.finishAndClose(err)
 	}
 
-	if !bytes.Equal(readBuf[:fileMagicSize], fileMagicBytes) {
-		return nil, fh.finishAndClose(ErrorEncryptedBadMagic)
+	magicSize := rcloneMagicSize
+	if !bytes.Equal(readBuf[:rcloneMagicSize], rcloneMagicBytes) {
+		readBuf = (*fh.readBuf)[:fileHeaderSize]
+		n, err = readFill(fh.rc, readBuf[rcloneHeaderSize:])
+		if n < fileHeaderSize-rcloneHeaderSize && err == io.EOF {
+			return nil, fh.finishAndClose(ErrorEncryptedFileTooShort)
+		} else if err != io.EOF && err != nil {
+			return nil, fh.finishAndClose(err)
+		}
+		if !bytes.Equal(readBuf[:fileMagicSize], fileMagicBytes) {
+			return nil, fh.finishAndClose(ErrorEncryptedBadMagic)
+		}
+		magicSize = fileMagicSize
 	}
+	fh.headerSize = magicSize + fileNonceSize
 
-	err = fh.nonce.fromBuf(readBuf[fileMagicSize:])
+	err = fh.nonce.fromBuf(readBuf[magicSize:])
 	if err != nil {
 		return nil, err
 	}
@@ -427,6 +501,7 @@ func (fh *decrypter) RangeSeek(ctx context.Context, offset int64, whence int, li
 	}
 
 	underlyingOffset, underlyingLimit, discard, blocks := calculateUnderlying(offset, limit)
+	underlyingOffset -= int64(fileHeaderSize - fh.headerSize)
 
 	fh.nonce = fh.initialNonce
 	fh.nonce.add(uint64(blocks))
@@ -522,27 +597,9 @@ func (c *Cipher) DecryptDataSeek(ctx context.Context, open OpenRangeSeek, offset
 }
 
 func EncryptedSize(size int64) int64 {
-	blocks, residue := size/blockDataSize, size%blockDataSize
-	encryptedSize := int64(fileHeaderSize) + blocks*(blockHeaderSize+blockDataSize)
-	if residue != 0 {
-		encryptedSize += blockHeaderSize + residue
-	}
-	return encryptedSize
+	return FormatTelDrive.EncryptedSize(size)
 }
 
 func DecryptedSize(size int64) (int64, error) {
-	size -= int64(fileHeaderSize)
-	if size < 0 {
-		return 0, ErrorEncryptedFileTooShort
-	}
-	blocks, residue := size/blockSize, size%blockSize
-	decryptedSize := blocks * blockDataSize
-	if residue != 0 {
-		residue -= blockHeaderSize
-		if residue <= 0 {
-			return 0, ErrorEncryptedFileBadHeader
-		}
-	}
-	decryptedSize += residue
-	return decryptedSize, nil
+	return FormatTelDrive.DecryptedSize(size)
 }
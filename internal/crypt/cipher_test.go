@@ -0,0 +1,139 @@
+package crypt
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rcloneVector is "The quick brown fox jumps over the lazy dog" as rclone crypt writes
+// it for the password "teldrive" and password2 "rclone": the magic, the nonce 00..17 and
+// one secretbox sealed block.
+const rcloneVector = "52434c4f4e450000000102030405060708090a0b0c0d0e0f1011121314151617" +
+	"b8d244798a2e0a635a2ccc7f9edad2b439675aceae47ecb3ab7b2dbe8c62b93a525a9f14b953df0d8f7439" +
+	"e6abe5d510f380012fb8c8145789b71e"
+
+func testData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i*7 + i/blockDataSize)
+	}
+	return data
+}
+
+func encrypt(t *testing.T, c *Cipher, data []byte, format Format) []byte {
+	t.Helper()
+	rc, err := c.EncryptDataFormat(bytes.NewReader(data), format)
+	require.NoError(t, err)
+	encrypted, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return encrypted
+}
+
+func openBytes(data []byte) OpenRangeSeek {
+	return func(ctx context.Context, offset, limit int64) (io.ReadCloser, error) {
+		end := int64(len(data))
+		if limit >= 0 && offset+limit < end {
+			end = offset + limit
+		}
+		return io.NopCloser(bytes.NewReader(data[offset:end])), nil
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	c, err := NewCipher("key", "salt")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "Empty", size: 0},
+		{name: "One byte", size: 1},
+		{name: "Short of a block", size: blockDataSize - 1},
+		{name: "One block", size: blockDataSize},
+		{name: "Several blocks", size: 2*blockDataSize + 100},
+	}
+
+	for _, format := range []Format{FormatTelDrive, FormatRclone} {
+		for _, tt := range tests {
+			t.Run(string(format)+"/"+tt.name, func(t *testing.T) {
+				data := testData(tt.size)
+				encrypted := encrypt(t, c, data, format)
+				assert.Equal(t, format.EncryptedSize(int64(tt.size)), int64(len(encrypted)))
+				assert.Equal(t, format.magic(), encrypted[:len(format.magic())])
+				size, err := format.DecryptedSize(int64(len(encrypted)))
+				require.NoError(t, err)
+				assert.Equal(t, int64(tt.size), size)
+
+				rc, err := c.DecryptData(io.NopCloser(bytes.NewReader(encrypted)))
+				require.NoError(t, err)
+				decrypted, err := io.ReadAll(rc)
+				require.NoError(t, err)
+				assert.Equal(t, data, decrypted)
+			})
+		}
+	}
+}
+
+func TestDecryptRcloneVector(t *testing.T) {
+	c, err := NewCipher("teldrive", "rclone")
+	require.NoError(t, err)
+	encrypted, err := hex.DecodeString(rcloneVector)
+	require.NoError(t, err)
+
+	rc, err := c.DecryptData(io.NopCloser(bytes.NewReader(encrypted)))
+	require.NoError(t, err)
+	decrypted, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "The quick brown fox jumps over the lazy dog", string(decrypted))
+}
+
+func TestDecryptBadMagic(t *testing.T) {
+	c, err := NewCipher("key", "salt")
+	require.NoError(t, err)
+	_, err = c.DecryptData(io.NopCloser(bytes.NewReader(make([]byte, fileHeaderSize+blockHeaderSize+1))))
+	assert.ErrorIs(t, err, ErrorEncryptedBadMagic)
+}
+
+func TestDecryptRange(t *testing.T) {
+	c, err := NewCipher("key", "salt")
+	require.NoError(t, err)
+	data := testData(3*blockDataSize + 123)
+
+	tests := []struct {
+		name   string
+		offset int64
+		limit  int64
+	}{
+		{name: "Start", offset: 0, limit: 10},
+		{name: "Whole file", offset: 0, limit: -1},
+		{name: "Inside the first block", offset: 100, limit: 50},
+		{name: "Across a block boundary", offset: blockDataSize - 10, limit: 40},
+		{name: "Mid block to the end", offset: 2*blockDataSize + 7, limit: -1},
+		{name: "Several blocks", offset: blockDataSize / 2, limit: 2 * blockDataSize},
+	}
+
+	for _, format := range []Format{FormatTelDrive, FormatRclone} {
+		encrypted := encrypt(t, c, data, format)
+		for _, tt := range tests {
+			t.Run(string(format)+"/"+tt.name, func(t *testing.T) {
+				rc, err := c.DecryptDataSeek(context.Background(), openBytes(encrypted), tt.offset, tt.limit)
+				require.NoError(t, err)
+				defer rc.Close()
+				got, err := io.ReadAll(rc)
+				require.NoError(t, err)
+				end := int64(len(data))
+				if tt.limit >= 0 {
+					end = tt.offset + tt.limit
+				}
+				assert.Equal(t, data[tt.offset:end], got)
+			})
+		}
+	}
+}
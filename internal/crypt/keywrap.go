@@ -0,0 +1,80 @@
+package crypt
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	wrapSaltSize = 16
+	dataKeySize  = 32
+)
+
+var ErrorWrongPassphrase = errors.New("wrong passphrase")
+
+// NewDataKey returns a random key suitable as the password for NewCipher.
+func NewDataKey() (string, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+func wrappingKey(passphrase string, salt []byte) (*[32]byte, error) {
+	derived, err := scrypt.Key([]byte(passphrase), salt, 32768, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	var key [32]byte
+	copy(key[:], derived)
+	return &key, nil
+}
+
+// WrapKey seals key with a key derived from passphrase and returns the sealed key
+// and the salt used for the derivation, both base64 encoded.
+func WrapKey(passphrase, key string) (string, string, error) {
+	salt := make([]byte, wrapSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", "", err
+	}
+	wrapKey, err := wrappingKey(passphrase, salt)
+	if err != nil {
+		return "", "", err
+	}
+	var n nonce
+	if _, err := rand.Read(n[:]); err != nil {
+		return "", "", err
+	}
+	sealed := secretbox.Seal(n[:], []byte(key), n.pointer(), wrapKey)
+	return base64.StdEncoding.EncodeToString(sealed), base64.StdEncoding.EncodeToString(salt), nil
+}
+
+// UnwrapKey opens a key sealed by WrapKey.
+func UnwrapKey(passphrase, wrapped, salt string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return "", err
+	}
+	saltBytes, err := base64.StdEncoding.DecodeString(salt)
+	if err != nil {
+		return "", err
+	}
+	var n nonce
+	if err := n.fromBuf(sealed); err != nil {
+		return "", err
+	}
+	wrapKey, err := wrappingKey(passphrase, saltBytes)
+	if err != nil {
+		return "", err
+	}
+	key, ok := secretbox.Open(nil, sealed[fileNonceSize:], n.pointer(), wrapKey)
+	if !ok {
+		return "", ErrorWrongPassphrase
+	}
+	return string(key), nil
+}
@@ -0,0 +1,103 @@
+package crypt
+
+import (
+	"bytes"
+	"encoding/base32"
+	"errors"
+	"strings"
+
+	"github.com/rfjakob/eme"
+)
+
+var (
+	ErrorBadName          = errors.New("bad encrypted name")
+	ErrorNameBadPadding   = errors.New("bad padding in encrypted name")
+	ErrorNameTooShort     = errors.New("encrypted name too short")
+	ErrorNameNotBlockSize = errors.New("encrypted name not a multiple of the block size")
+)
+
+var nameEncoding = base32.HexEncoding.WithPadding(base32.NoPadding)
+
+// EncryptName encrypts a single file or folder name as rclone crypt does in standard
+// mode: PKCS#7 padding, EME with the name key and tweak, then lowercase base32hex.
+// Equal names encrypt to equal output, which keeps exact-match lookups possible.
+func (c *Cipher) EncryptName(name string) string {
+	if name == "" {
+		return ""
+	}
+	padded := pad([]byte(name))
+	sealed := eme.Transform(c.block, c.nameTweak[:], padded, eme.DirectionEncrypt)
+	return strings.ToLower(nameEncoding.EncodeToString(sealed))
+}
+
+// DecryptName reverses EncryptName.
+func (c *Cipher) DecryptName(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	raw, err := nameEncoding.DecodeString(strings.ToUpper(name))
+	if err != nil {
+		return "", ErrorBadName
+	}
+	if len(raw) == 0 {
+		return "", ErrorNameTooShort
+	}
+	if len(raw)%nameCipherBlockSize != 0 {
+		return "", ErrorNameNotBlockSize
+	}
+	padded := eme.Transform(c.block, c.nameTweak[:], raw, eme.DirectionDecrypt)
+	plain, err := unpad(padded)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// EncryptPath encrypts each segment of a slash separated path with the cipher at its
+// index in ciphers. Segments without a cipher, nil or past the end, are kept. The
+// result starts with a slash.
+func EncryptPath(path string, ciphers []*Cipher) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if i < len(ciphers) && ciphers[i] != nil {
+			segments[i] = ciphers[i].EncryptName(segment)
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// DecryptPath reverses EncryptPath.
+func DecryptPath(path string, ciphers []*Cipher) (string, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if i < len(ciphers) && ciphers[i] != nil {
+			plain, err := ciphers[i].DecryptName(segment)
+			if err != nil {
+				return "", err
+			}
+			segments[i] = plain
+		}
+	}
+	return "/" + strings.Join(segments, "/"), nil
+}
+
+func pad(buf []byte) []byte {
+	n := nameCipherBlockSize - len(buf)%nameCipherBlockSize
+	return append(buf, bytes.Repeat([]byte{byte(n)}, n)...)
+}
+
+func unpad(buf []byte) ([]byte, error) {
+	if len(buf) == 0 || len(buf)%nameCipherBlockSize != 0 {
+		return nil, ErrorNameBadPadding
+	}
+	n := int(buf[len(buf)-1])
+	if n == 0 || n > nameCipherBlockSize {
+		return nil, ErrorNameBadPadding
+	}
+	for _, b := range buf[len(buf)-n:] {
+		if int(b) != n {
+			return nil, ErrorNameBadPadding
+		}
+	}
+	return buf[:len(buf)-n], nil
+}
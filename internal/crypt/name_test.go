@@ -0,0 +1,70 @@
+package crypt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath(t *testing.T) {
+	c, err := NewCipher("key", "salt")
+	require.NoError(t, err)
+	other, err := NewCipher("other key", "salt")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		path    string
+		ciphers []*Cipher
+		want    []string
+	}{
+		{
+			name: "Root",
+			path: "/",
+			want: []string{""},
+		},
+		{
+			name:    "Plain folders",
+			path:    "/Documents/Work",
+			ciphers: []*Cipher{nil, nil},
+			want:    []string{"Documents", "Work"},
+		},
+		{
+			name:    "Below an encrypted folder",
+			path:    "/Private/Photos/2024",
+			ciphers: []*Cipher{nil, c, c},
+			want:    []string{"Private", c.EncryptName("Photos"), c.EncryptName("2024")},
+		},
+		{
+			name:    "Below folders sealed with different keys",
+			path:    "/Private/Photos/2024",
+			ciphers: []*Cipher{nil, c, other},
+			want:    []string{"Private", c.EncryptName("Photos"), other.EncryptName("2024")},
+		},
+		{
+			name:    "Segments past the ciphers",
+			path:    "/Private/Photos/missing",
+			ciphers: []*Cipher{nil, c},
+			want:    []string{"Private", c.EncryptName("Photos"), "missing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := EncryptPath(tt.path, tt.ciphers)
+			assert.Equal(t, "/"+strings.Join(tt.want, "/"), stored)
+			plain, err := DecryptPath(stored, tt.ciphers)
+			require.NoError(t, err)
+			assert.Equal(t, tt.path, plain)
+		})
+	}
+}
+
+func TestDecryptPathBadName(t *testing.T) {
+	c, err := NewCipher("key", "salt")
+	require.NoError(t, err)
+	_, err = DecryptPath("/Private/not-encrypted", []*Cipher{nil, c})
+	assert.ErrorIs(t, err, ErrorBadName)
+}
@@ -28,3 +28,13 @@ func IsKeyConflictErr(err error) bool {
 	}
 	return false
 }
+
+// IsQuerySyntaxErr reports whether a search value given to the database, like a
+// PGroonga query or a regular expression, could not be parsed.
+func IsQuerySyntaxErr(err error) bool {
+	var e *pgconn.PgError
+	if errors.As(err, &e) {
+		return e.Code == "42601" || e.Code == "2201B"
+	}
+	return false
+}
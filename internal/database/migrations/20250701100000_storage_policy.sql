@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.storage_policies (
+    user_id bigint PRIMARY KEY,
+    mode text NOT NULL DEFAULT 'single',
+    channel_ids jsonb,
+    rollover_threshold bigint NOT NULL DEFAULT 0,
+    updated_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+ALTER TABLE teldrive.channels ADD COLUMN IF NOT EXISTS message_count bigint NOT NULL DEFAULT 0;
+
+UPDATE teldrive.channels c SET message_count = counts.total
+FROM (
+    SELECT f.channel_id, SUM(jsonb_array_length(f.parts)) AS total
+    FROM teldrive.files f
+    WHERE f.type = 'file' AND f.parts IS NOT NULL
+    GROUP BY f.channel_id
+) AS counts
+WHERE c.channel_id = counts.channel_id;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.channel_migrations (
+    id uuid PRIMARY KEY DEFAULT uuid7(),
+    user_id bigint NOT NULL,
+    source_channel_id bigint,
+    folder_id uuid,
+    destination_channel_id bigint NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    total_files bigint NOT NULL DEFAULT 0,
+    moved_files bigint NOT NULL DEFAULT 0,
+    last_file_id uuid,
+    error text,
+    heartbeat_at timestamp,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS channel_migrations_user_id_idx ON teldrive.channel_migrations (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS channel_migrations_status_idx ON teldrive.channel_migrations (status);
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.uploads ADD COLUMN IF NOT EXISTS key_id text;
+
+CREATE TABLE IF NOT EXISTS teldrive.reencryption_jobs (
+    id uuid PRIMARY KEY DEFAULT uuid7(),
+    user_id bigint NOT NULL,
+    key_id text NOT NULL DEFAULT '',
+    status text NOT NULL DEFAULT 'pending',
+    total_files bigint NOT NULL DEFAULT 0,
+    processed_files bigint NOT NULL DEFAULT 0,
+    last_file_id uuid,
+    error text,
+    heartbeat_at timestamp,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS reencryption_jobs_user_id_idx ON teldrive.reencryption_jobs (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS reencryption_jobs_status_idx ON teldrive.reencryption_jobs (status);
+-- +goose StatementEnd
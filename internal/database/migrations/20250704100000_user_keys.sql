@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.user_keys (
+    user_id bigint PRIMARY KEY,
+    wrapped_key text NOT NULL,
+    salt text NOT NULL,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+-- +goose StatementEnd
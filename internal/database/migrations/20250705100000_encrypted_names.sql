@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.files ADD COLUMN IF NOT EXISTS name_encrypted boolean NOT NULL DEFAULT false;
+ALTER TABLE teldrive.files ADD COLUMN IF NOT EXISTS encrypt_names boolean NOT NULL DEFAULT false;
+-- +goose StatementEnd
@@ -0,0 +1,4 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.uploads ADD COLUMN IF NOT EXISTS format text;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS max_downloads bigint;
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS max_ips bigint;
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS downloads bigint NOT NULL DEFAULT 0;
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS disabled_at timestamp;
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS disabled_reason text;
+
+CREATE TABLE IF NOT EXISTS teldrive.share_accesses (
+    id uuid PRIMARY KEY DEFAULT uuid7(),
+    share_id uuid NOT NULL REFERENCES teldrive.file_shares (id) ON DELETE CASCADE,
+    type text NOT NULL,
+    ip text NOT NULL,
+    user_agent text,
+    bytes bigint NOT NULL DEFAULT 0,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS share_accesses_share_id_idx ON teldrive.share_accesses (share_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS share_accesses_share_ip_idx ON teldrive.share_accesses (share_id, ip);
+-- +goose StatementEnd
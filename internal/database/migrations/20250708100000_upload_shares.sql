@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS mode text NOT NULL DEFAULT 'download';
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS allowed_extensions jsonb;
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS max_file_size bigint;
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS max_files bigint;
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS uploaded_files bigint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.file_permissions (
+    id uuid PRIMARY KEY DEFAULT uuid7(),
+    file_id uuid NOT NULL REFERENCES teldrive.files (id) ON DELETE CASCADE,
+    owner_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    role text NOT NULL,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    CONSTRAINT file_permissions_file_user_key UNIQUE (file_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS file_permissions_user_id_idx ON teldrive.file_permissions (user_id);
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.file_shares ADD COLUMN IF NOT EXISTS title text;
+
+CREATE TABLE IF NOT EXISTS teldrive.file_share_items (
+    share_id uuid NOT NULL REFERENCES teldrive.file_shares (id) ON DELETE CASCADE,
+    file_id uuid NOT NULL REFERENCES teldrive.files (id) ON DELETE CASCADE,
+    PRIMARY KEY (share_id, file_id)
+);
+
+CREATE INDEX IF NOT EXISTS file_share_items_file_id_idx ON teldrive.file_share_items (file_id);
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.tags (
+    id uuid PRIMARY KEY DEFAULT uuid7(),
+    user_id bigint NOT NULL,
+    name text NOT NULL,
+    color text,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS tags_user_id_name_idx ON teldrive.tags (user_id, lower(name));
+
+CREATE TABLE IF NOT EXISTS teldrive.file_tags (
+    file_id uuid NOT NULL REFERENCES teldrive.files (id) ON DELETE CASCADE,
+    tag_id uuid NOT NULL REFERENCES teldrive.tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (file_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS file_tags_tag_id_idx ON teldrive.file_tags (tag_id);
+-- +goose StatementEnd
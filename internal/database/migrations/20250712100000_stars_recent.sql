@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.file_stars (
+    user_id bigint NOT NULL,
+    file_id uuid NOT NULL REFERENCES teldrive.files (id) ON DELETE CASCADE,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    PRIMARY KEY (user_id, file_id)
+);
+
+CREATE TABLE IF NOT EXISTS teldrive.file_opens (
+    user_id bigint NOT NULL,
+    file_id uuid NOT NULL REFERENCES teldrive.files (id) ON DELETE CASCADE,
+    opened_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    PRIMARY KEY (user_id, file_id)
+);
+
+CREATE INDEX IF NOT EXISTS file_opens_user_id_opened_at_idx ON teldrive.file_opens (user_id, opened_at DESC);
+CREATE INDEX IF NOT EXISTS events_user_id_created_at_idx ON teldrive.events (user_id, created_at DESC);
+-- +goose StatementEnd
@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.files ADD COLUMN IF NOT EXISTS properties jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_files_properties ON teldrive.files USING gin (properties);
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.media_info (
+    file_id uuid PRIMARY KEY REFERENCES teldrive.files (id) ON DELETE CASCADE,
+    taken_at timestamp,
+    camera_make text,
+    camera_model text,
+    latitude double precision,
+    longitude double precision,
+    duration double precision,
+    width integer,
+    height integer,
+    video_codec text,
+    audio_codec text,
+    title text,
+    artist text,
+    album text,
+    year integer,
+    track integer,
+    genre text,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS media_info_taken_at_idx ON teldrive.media_info (taken_at);
+CREATE INDEX IF NOT EXISTS media_info_duration_idx ON teldrive.media_info (duration);
+-- +goose StatementEnd
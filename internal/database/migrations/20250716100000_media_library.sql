@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.media_info ADD COLUMN IF NOT EXISTS user_id bigint;
+ALTER TABLE teldrive.media_info ADD COLUMN IF NOT EXISTS category text;
+ALTER TABLE teldrive.media_info ADD COLUMN IF NOT EXISTS timeline_at timestamp;
+
+UPDATE teldrive.media_info m SET user_id = f.user_id, category = f.category,
+    timeline_at = coalesce(m.taken_at, f.updated_at)
+FROM teldrive.files f WHERE f.id = m.file_id;
+
+CREATE INDEX IF NOT EXISTS media_info_timeline_idx ON teldrive.media_info (user_id, timeline_at DESC, file_id DESC)
+    WHERE category IN ('image', 'video');
+CREATE INDEX IF NOT EXISTS media_info_music_idx ON teldrive.media_info (user_id, coalesce(lower(artist), ''),
+    coalesce(lower(album), ''), coalesce(track, 0), file_id) WHERE category = 'audio';
+-- +goose StatementEnd
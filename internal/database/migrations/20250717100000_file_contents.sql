@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.file_contents (
+    file_id uuid PRIMARY KEY REFERENCES teldrive.files (id) ON DELETE CASCADE,
+    content text NOT NULL,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS file_contents_content_idx ON teldrive.file_contents USING pgroonga (content);
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.saved_searches (
+    id uuid PRIMARY KEY DEFAULT uuid7(),
+    user_id bigint NOT NULL,
+    name text NOT NULL,
+    query jsonb NOT NULL,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS saved_searches_user_id_name_idx ON teldrive.saved_searches (user_id, lower(name));
+-- +goose StatementEnd
@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_files_parent_id_name_id ON teldrive.files (parent_id, name, id);
+CREATE INDEX IF NOT EXISTS idx_files_parent_id_updated_at_id ON teldrive.files (parent_id, updated_at, id);
+CREATE INDEX IF NOT EXISTS idx_files_parent_id_size_id ON teldrive.files (parent_id, coalesce(size, 0), id);
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS teldrive.jobs (
+    id uuid PRIMARY KEY DEFAULT uuid7(),
+    user_id bigint NOT NULL,
+    kind text NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    params jsonb NOT NULL DEFAULT '{}',
+    state jsonb,
+    total bigint NOT NULL DEFAULT 0,
+    done bigint NOT NULL DEFAULT 0,
+    error text NOT NULL DEFAULT '',
+    attempt integer NOT NULL DEFAULT 0,
+    heartbeat_at timestamp,
+    created_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS jobs_user_id_idx ON teldrive.jobs (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS jobs_status_idx ON teldrive.jobs (status, created_at);
+
+INSERT INTO teldrive.jobs (id, user_id, kind, status, params, state, total, done, error, heartbeat_at, created_at, updated_at)
+SELECT id, user_id, 'channel_migration', status,
+    jsonb_strip_nulls(jsonb_build_object('sourceChannelId', source_channel_id, 'folderId', folder_id,
+        'destinationChannelId', destination_channel_id)),
+    jsonb_strip_nulls(jsonb_build_object('lastFileId', last_file_id)),
+    total_files, moved_files, coalesce(error, ''), heartbeat_at, created_at, updated_at
+FROM teldrive.channel_migrations;
+
+INSERT INTO teldrive.jobs (id, user_id, kind, status, params, state, total, done, error, heartbeat_at, created_at, updated_at)
+SELECT id, user_id, 'reencryption', status,
+    jsonb_build_object('keyId', key_id),
+    jsonb_strip_nulls(jsonb_build_object('lastFileId', last_file_id)),
+    total_files, processed_files, coalesce(error, ''), heartbeat_at, created_at, updated_at
+FROM teldrive.reencryption_jobs;
+
+DROP TABLE IF EXISTS teldrive.channel_migrations;
+DROP TABLE IF EXISTS teldrive.reencryption_jobs;
+-- +goose StatementEnd
@@ -0,0 +1,4 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.channels ADD COLUMN IF NOT EXISTS rolled_over boolean NOT NULL DEFAULT false;
+-- +goose StatementEnd
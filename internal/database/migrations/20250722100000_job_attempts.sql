@@ -0,0 +1,4 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.jobs ADD COLUMN IF NOT EXISTS base_attempt integer NOT NULL DEFAULT 0;
+-- +goose StatementEnd
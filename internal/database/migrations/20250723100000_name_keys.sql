@@ -0,0 +1,5 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.files ADD COLUMN IF NOT EXISTS name_key_id text NOT NULL DEFAULT '';
+ALTER TABLE teldrive.files ADD COLUMN IF NOT EXISTS children_key_id text NOT NULL DEFAULT '';
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teldrive.file_shares ALTER COLUMN file_id DROP NOT NULL;
+
+-- Multi-file shares keep their files in file_share_items only.
+UPDATE teldrive.file_shares s SET file_id = NULL
+WHERE EXISTS (SELECT 1 FROM teldrive.file_share_items i WHERE i.share_id = s.id);
+-- +goose StatementEnd
@@ -0,0 +1,6 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The text of files with encrypted names was indexed in clear.
+DELETE FROM teldrive.file_contents c USING teldrive.files f
+WHERE f.id = c.file_id AND f.name_encrypted;
+-- +goose StatementEnd
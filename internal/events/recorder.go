@@ -17,6 +17,8 @@ const (
 	OpDelete EventType = "file_delete"
 	OpMove   EventType = "file_move"
 	OpCopy   EventType = "file_copy"
+	OpShare  EventType = "file_share"
+	OpJob    EventType = "job_progress"
 )
 
 type Recorder struct {
@@ -0,0 +1,297 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+var (
+	mpeg1Bitrates = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320}
+	mpeg2Bitrates = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160}
+	mpegRates     = [3]int{44100, 48000, 32000}
+)
+
+// id3Frames maps the ID3v2.3/2.4 and the shorter ID3v2.2 frame ids to the fields
+// they fill.
+var id3Frames = map[string]string{
+	"TIT2": "title", "TT2": "title",
+	"TPE1": "artist", "TP1": "artist",
+	"TALB": "album", "TAL": "album",
+	"TYER": "year", "TYE": "year", "TDRC": "year",
+	"TRCK": "track", "TRK": "track",
+	"TCON": "genre", "TCO": "genre",
+	"TLEN": "length", "TLE": "length",
+}
+
+func isMPEGFrame(head []byte) bool {
+	return head[0] == 0xFF && head[1]&0xE0 == 0xE0 && head[1]&0x06 != 0
+}
+
+// parseMP3 reads the ID3v2 tag and takes the duration from the tag, the Xing header of
+// the first frame or the bitrate of a constant bitrate stream.
+func parseMP3(r io.ReaderAt, size int64, info *Info) error {
+	var audioStart int64
+	header, err := readAt(r, 0, 10)
+	if err != nil {
+		return err
+	}
+	if bytes.HasPrefix(header, []byte("ID3")) {
+		tagSize := int64(synchsafe(header[6:10]))
+		audioStart = 10 + tagSize
+		if header[5]&0x10 != 0 {
+			audioStart += 10
+		}
+		// Text frames come first, large tags are mostly cover art.
+		tag, err := readAt(r, 10, int(min(tagSize, size-10, 1<<20)))
+		if err != nil {
+			return err
+		}
+		parseID3(tag, header[3], info)
+	}
+	info.AudioCodec = "mp3"
+	if info.Duration > 0 {
+		return nil
+	}
+
+	frame, err := readAt(r, audioStart, int(min(4096, size-audioStart)))
+	if err != nil {
+		return nil
+	}
+	idx := -1
+	for i := 0; i+4 <= len(frame); i++ {
+		if isMPEGFrame(frame[i:]) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil
+	}
+	info.Duration = mpegDuration(frame[idx:], size-audioStart-int64(idx))
+	return nil
+}
+
+func parseID3(tag []byte, version byte, info *Info) {
+	idLen, headerLen := 4, 10
+	if version == 2 {
+		idLen, headerLen = 3, 6
+	}
+	for pos := 0; pos+headerLen <= len(tag); {
+		id := string(tag[pos : pos+idLen])
+		if id[0] == 0 {
+			break
+		}
+		var n int
+		switch version {
+		case 2:
+			n = int(tag[pos+3])<<16 | int(tag[pos+4])<<8 | int(tag[pos+5])
+		case 4:
+			n = int(synchsafe(tag[pos+4 : pos+8]))
+		default:
+			n = int(binary.BigEndian.Uint32(tag[pos+4:]))
+		}
+		pos += headerLen
+		if n <= 0 || pos+n > len(tag) {
+			break
+		}
+		if field, ok := id3Frames[id]; ok {
+			setTag(info, field, id3Text(tag[pos:pos+n]))
+		}
+		pos += n
+	}
+}
+
+// setTag stores a tag value by field name, shared by the ID3 and Vorbis comment readers.
+func setTag(info *Info, field, value string) {
+	if value == "" {
+		return
+	}
+	switch field {
+	case "title":
+		info.Title = value
+	case "artist":
+		info.Artist = value
+	case "album":
+		info.Album = value
+	case "year":
+		if len(value) >= 4 {
+			info.Year, _ = strconv.Atoi(value[:4])
+		}
+	case "track":
+		track, _, _ := strings.Cut(value, "/")
+		info.Track, _ = strconv.Atoi(strings.TrimSpace(track))
+	case "genre":
+		info.Genre = value
+	case "length":
+		if ms, err := strconv.Atoi(value); err == nil {
+			info.Duration = float64(ms) / 1000
+		}
+	}
+}
+
+// id3Text decodes a text frame, the first byte selects the encoding.
+func id3Text(frame []byte) string {
+	if len(frame) < 2 {
+		return ""
+	}
+	data := frame[1:]
+	var text string
+	switch frame[0] {
+	case 1, 2:
+		order := binary.ByteOrder(binary.BigEndian)
+		if frame[0] == 1 && len(data) >= 2 {
+			if data[0] == 0xFF && data[1] == 0xFE {
+				order = binary.LittleEndian
+			}
+			if (data[0] == 0xFF && data[1] == 0xFE) || (data[0] == 0xFE && data[1] == 0xFF) {
+				data = data[2:]
+			}
+		}
+		units := make([]uint16, 0, len(data)/2)
+		for i := 0; i+1 < len(data); i += 2 {
+			units = append(units, order.Uint16(data[i:]))
+		}
+		text = string(utf16.Decode(units))
+	case 3:
+		text = string(data)
+	default:
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		text = string(runes)
+	}
+	// Multiple values are separated by null characters, the first one is kept.
+	text, _, _ = strings.Cut(text, "\x00")
+	return strings.TrimSpace(text)
+}
+
+// mpegDuration estimates the duration of an MPEG audio stream from its first frame.
+func mpegDuration(frame []byte, streamSize int64) float64 {
+	version := (frame[1] >> 3) & 0x03
+	layer := (frame[1] >> 1) & 0x03
+	bitrateIdx := frame[2] >> 4
+	rateIdx := (frame[2] >> 2) & 0x03
+	if layer != 1 || rateIdx == 3 || version == 1 {
+		// Only layer III is handled.
+		return 0
+	}
+	rate := mpegRates[rateIdx]
+	bitrates, samples := mpeg1Bitrates, 1152
+	switch version {
+	case 2:
+		rate, bitrates, samples = rate/2, mpeg2Bitrates, 576
+	case 0:
+		rate, bitrates, samples = rate/4, mpeg2Bitrates, 576
+	}
+
+	// Variable bitrate files carry the frame count in a Xing or Info header.
+	mono := frame[3]>>6 == 3
+	sideInfo := 32
+	switch {
+	case version == 3 && mono:
+		sideInfo = 17
+	case version != 3 && mono:
+		sideInfo = 9
+	case version != 3:
+		sideInfo = 17
+	}
+	if xing := 4 + sideInfo; len(frame) >= xing+12 {
+		tag := string(frame[xing : xing+4])
+		if (tag == "Xing" || tag == "Info") && frame[xing+7]&0x01 != 0 {
+			frames := binary.BigEndian.Uint32(frame[xing+8:])
+			return float64(frames) * float64(samples) / float64(rate)
+		}
+	}
+	bitrate := bitrates[bitrateIdx]
+	if bitrate == 0 {
+		return 0
+	}
+	return float64(streamSize*8) / float64(bitrate*1000)
+}
+
+func synchsafe(b []byte) uint32 {
+	return uint32(b[0]&0x7F)<<21 | uint32(b[1]&0x7F)<<14 | uint32(b[2]&0x7F)<<7 | uint32(b[3]&0x7F)
+}
+
+// parseFLAC reads the stream info and the Vorbis comment metadata blocks.
+func parseFLAC(r io.ReaderAt, size int64, info *Info) error {
+	info.AudioCodec = "flac"
+	for off := int64(4); off+4 <= size; {
+		header, err := readAt(r, off, 4)
+		if err != nil {
+			return err
+		}
+		last := header[0]&0x80 != 0
+		blockType := header[0] & 0x7F
+		length := int(header[1])<<16 | int(header[2])<<8 | int(header[3])
+		off += 4
+		switch blockType {
+		case 0:
+			block, err := readAt(r, off, length)
+			if err != nil {
+				return err
+			}
+			if len(block) < 18 {
+				return errors.New("invalid flac stream info")
+			}
+			rate := int(block[10])<<12 | int(block[11])<<4 | int(block[12])>>4
+			samples := uint64(block[13]&0x0F)<<32 | uint64(binary.BigEndian.Uint32(block[14:]))
+			if rate > 0 {
+				info.Duration = float64(samples) / float64(rate)
+			}
+		case 4:
+			block, err := readAt(r, off, length)
+			if err != nil {
+				return err
+			}
+			parseVorbisComment(block, info)
+		}
+		if last {
+			break
+		}
+		off += int64(length)
+	}
+	return nil
+}
+
+var vorbisFields = map[string]string{
+	"TITLE":       "title",
+	"ARTIST":      "artist",
+	"ALBUM":       "album",
+	"DATE":        "year",
+	"TRACKNUMBER": "track",
+	"GENRE":       "genre",
+}
+
+func parseVorbisComment(block []byte, info *Info) {
+	if len(block) < 4 {
+		return
+	}
+	pos := 4 + int(binary.LittleEndian.Uint32(block))
+	if pos+4 > len(block) {
+		return
+	}
+	count := int(binary.LittleEndian.Uint32(block[pos:]))
+	pos += 4
+	for range count {
+		if pos+4 > len(block) {
+			return
+		}
+		n := int(binary.LittleEndian.Uint32(block[pos:]))
+		pos += 4
+		if n < 0 || pos+n > len(block) {
+			return
+		}
+		key, value, ok := strings.Cut(string(block[pos:pos+n]), "=")
+		pos += n
+		if field, known := vorbisFields[strings.ToUpper(key)]; ok && known {
+			setTag(info, field, strings.TrimSpace(value))
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	tagImageWidth   = 0x0100
+	tagImageHeight  = 0x0101
+	tagMake         = 0x010F
+	tagModel        = 0x0110
+	tagDateTime     = 0x0132
+	tagExifIFD      = 0x8769
+	tagGPSIFD       = 0x8825
+	tagDateOriginal = 0x9003
+	tagPixelWidth   = 0xA002
+	tagPixelHeight  = 0xA003
+
+	tagGPSLatitudeRef  = 0x0001
+	tagGPSLatitude     = 0x0002
+	tagGPSLongitudeRef = 0x0003
+	tagGPSLongitude    = 0x0004
+
+	exifDateLayout = "2006:01:02 15:04:05"
+)
+
+// typeSizes are the byte sizes of the TIFF field types.
+var typeSizes = map[uint16]int{1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8}
+
+// parseJPEG walks the segments before the image data for the EXIF block and the
+// frame size.
+func parseJPEG(r io.ReaderAt, size int64, info *Info) error {
+	off := int64(2)
+	for off+4 <= size {
+		header, err := readAt(r, off, 4)
+		if err != nil {
+			return err
+		}
+		if header[0] != 0xFF {
+			return errors.New("invalid jpeg segment")
+		}
+		marker := header[1]
+		length := int64(binary.BigEndian.Uint16(header[2:]))
+		switch {
+		case marker == 0xDA || marker == 0xD9:
+			return nil
+		case marker == 0xE1:
+			data, err := readAt(r, off+4, int(length)-2)
+			if err != nil {
+				return err
+			}
+			if bytes.HasPrefix(data, []byte("Exif\x00\x00")) {
+				tiff := data[6:]
+				if err := parseTIFF(bytes.NewReader(tiff), 0, int64(len(tiff)), info); err != nil {
+					return err
+				}
+			}
+		case marker >= 0xC0 && marker <= 0xCF && marker != 0xC4 && marker != 0xC8 && marker != 0xCC:
+			frame, err := readAt(r, off+4, 5)
+			if err != nil {
+				return err
+			}
+			info.Height = int(binary.BigEndian.Uint16(frame[1:]))
+			info.Width = int(binary.BigEndian.Uint16(frame[3:]))
+		}
+		off += 2 + length
+	}
+	return nil
+}
+
+func parsePNG(r io.ReaderAt, info *Info) error {
+	ihdr, err := readAt(r, 16, 8)
+	if err != nil {
+		return err
+	}
+	info.Width = int(binary.BigEndian.Uint32(ihdr))
+	info.Height = int(binary.BigEndian.Uint32(ihdr[4:]))
+	return nil
+}
+
+// tiffReader reads the IFDs of a TIFF structure, which EXIF data is stored in.
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+type ifdEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+func parseTIFF(r io.ReaderAt, off, size int64, info *Info) error {
+	data, err := readAt(r, off, int(size))
+	if err != nil {
+		return err
+	}
+	if len(data) < 8 {
+		return errors.New("invalid tiff header")
+	}
+	t := &tiffReader{data: data}
+	switch string(data[:2]) {
+	case "II":
+		t.order = binary.LittleEndian
+	case "MM":
+		t.order = binary.BigEndian
+	default:
+		return errors.New("invalid tiff byte order")
+	}
+
+	ifd0 := t.ifd(t.order.Uint32(data[4:]))
+	info.CameraMake = t.text(ifd0[tagMake])
+	info.CameraModel = t.text(ifd0[tagModel])
+	info.Width = t.uint(ifd0[tagImageWidth], info.Width)
+	info.Height = t.uint(ifd0[tagImageHeight], info.Height)
+	taken := t.text(ifd0[tagDateTime])
+
+	if entry, ok := ifd0[tagExifIFD]; ok {
+		exif := t.ifd(uint32(t.uint(entry, 0)))
+		if original := t.text(exif[tagDateOriginal]); original != "" {
+			taken = original
+		}
+		info.Width = t.uint(exif[tagPixelWidth], info.Width)
+		info.Height = t.uint(exif[tagPixelHeight], info.Height)
+	}
+	if taken != "" {
+		if at, err := time.Parse(exifDateLayout, taken); err == nil {
+			info.TakenAt = &at
+		}
+	}
+
+	if entry, ok := ifd0[tagGPSIFD]; ok {
+		gps := t.ifd(uint32(t.uint(entry, 0)))
+		lat, latOk := t.degrees(gps[tagGPSLatitude])
+		lon, lonOk := t.degrees(gps[tagGPSLongitude])
+		if latOk && lonOk {
+			if t.text(gps[tagGPSLatitudeRef]) == "S" {
+				lat = -lat
+			}
+			if t.text(gps[tagGPSLongitudeRef]) == "W" {
+				lon = -lon
+			}
+			info.Latitude, info.Longitude = &lat, &lon
+		}
+	}
+	return nil
+}
+
+// ifd reads the entries of the IFD at off, malformed entries are skipped.
+func (t *tiffReader) ifd(off uint32) map[uint16]ifdEntry {
+	entries := make(map[uint16]ifdEntry)
+	if int(off)+2 > len(t.data) {
+		return entries
+	}
+	count := int(t.order.Uint16(t.data[off:]))
+	for i := range count {
+		pos := int(off) + 2 + i*12
+		if pos+12 > len(t.data) {
+			break
+		}
+		entry := ifdEntry{
+			tag:   t.order.Uint16(t.data[pos:]),
+			typ:   t.order.Uint16(t.data[pos+2:]),
+			count: t.order.Uint32(t.data[pos+4:]),
+		}
+		n := typeSizes[entry.typ] * int(entry.count)
+		if n <= 0 || n > len(t.data) {
+			continue
+		}
+		if n <= 4 {
+			entry.value = t.data[pos+8 : pos+8+n]
+		} else {
+			start := int(t.order.Uint32(t.data[pos+8:]))
+			if start+n > len(t.data) {
+				continue
+			}
+			entry.value = t.data[start : start+n]
+		}
+		entries[entry.tag] = entry
+	}
+	return entries
+}
+
+func (t *tiffReader) text(entry ifdEntry) string {
+	if entry.typ != 2 {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimRight(string(entry.value), "\x00"))
+}
+
+func (t *tiffReader) uint(entry ifdEntry, fallback int) int {
+	switch {
+	case entry.typ == 3 && len(entry.value) >= 2:
+		return int(t.order.Uint16(entry.value))
+	case entry.typ == 4 && len(entry.value) >= 4:
+		return int(t.order.Uint32(entry.value))
+	}
+	return fallback
+}
+
+// degrees converts a degrees, minutes, seconds triple of rationals.
+func (t *tiffReader) degrees(entry ifdEntry) (float64, bool) {
+	if entry.typ != 5 || len(entry.value) < 24 {
+		return 0, false
+	}
+	var parts [3]float64
+	for i := range parts {
+		num := t.order.Uint32(entry.value[i*8:])
+		den := t.order.Uint32(entry.value[i*8+4:])
+		if den == 0 {
+			return 0, false
+		}
+		parts[i] = float64(num) / float64(den)
+	}
+	return parts[0] + parts[1]/60 + parts[2]/3600, true
+}
@@ -0,0 +1,90 @@
+// Package media reads metadata from the headers of photos, audio and video files
+// without decoding their content.
+package media
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+)
+
+var ErrUnsupported = errors.New("unsupported media format")
+
+// Info holds what could be read from a file, unknown fields are left empty.
+type Info struct {
+	TakenAt     *time.Time
+	CameraMake  string
+	CameraModel string
+	Latitude    *float64
+	Longitude   *float64
+
+	// Duration is in seconds.
+	Duration   float64
+	Width      int
+	Height     int
+	VideoCodec string
+	AudioCodec string
+
+	Title  string
+	Artist string
+	Album  string
+	Year   int
+	Track  int
+	Genre  string
+}
+
+// maxHeader bounds the metadata read in one piece, like an EXIF segment or a moov box.
+const maxHeader = 16 << 20
+
+// Probe detects the format of r from its first bytes and parses its metadata. Only
+// header ranges are read, so r can be backed by a remote file.
+func Probe(r io.ReaderAt, size int64) (*Info, error) {
+	head := make([]byte, 12)
+	n, err := r.ReadAt(head, 0)
+	if n < len(head) {
+		if err == nil || errors.Is(err, io.EOF) {
+			err = ErrUnsupported
+		}
+		return nil, err
+	}
+	info := &Info{}
+	switch {
+	case bytes.HasPrefix(head, []byte{0xFF, 0xD8}):
+		err = parseJPEG(r, size, info)
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		err = parsePNG(r, info)
+	case bytes.HasPrefix(head, []byte("II*\x00")) || bytes.HasPrefix(head, []byte("MM\x00*")):
+		err = parseTIFF(r, 0, min(size, 1<<20), info)
+	case bytes.HasPrefix(head, []byte("ID3")) || isMPEGFrame(head):
+		err = parseMP3(r, size, info)
+	case bytes.HasPrefix(head, []byte("fLaC")):
+		err = parseFLAC(r, size, info)
+	case bytes.Equal(head[4:8], []byte("ftyp")):
+		err = parseMP4(r, size, info)
+	case bytes.HasPrefix(head, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		err = parseMKV(r, size, info)
+	default:
+		return nil, ErrUnsupported
+	}
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// readAt reads exactly n bytes at off.
+func readAt(r io.ReaderAt, off int64, n int) ([]byte, error) {
+	if n < 0 || n > maxHeader {
+		return nil, errors.New("media header too large")
+	}
+	buf := make([]byte, n)
+	read, err := r.ReadAt(buf, off)
+	if read == n {
+		return buf, nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return nil, err
+}
@@ -0,0 +1,239 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mp4Box(typ string, children ...[]byte) []byte {
+	data := bytes.Join(children, nil)
+	b := binary.BigEndian.AppendUint32(nil, uint32(8+len(data)))
+	return append(append(b, typ...), data...)
+}
+
+func testMP4() []byte {
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[4:], 3786912000) // 2024-01-01
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:], 90500)
+
+	tkhd := make([]byte, 84)
+	binary.BigEndian.PutUint32(tkhd[76:], 1920<<16)
+	binary.BigEndian.PutUint32(tkhd[80:], 1080<<16)
+	stsd := append(make([]byte, 12), "avc1"...)
+	video := mp4Box("trak", mp4Box("tkhd", tkhd), mp4Box("mdia",
+		mp4Box("hdlr", append(make([]byte, 8), "vide"...)),
+		mp4Box("minf", mp4Box("stbl", mp4Box("stsd", stsd)))))
+
+	xyz := append([]byte{0, 18, 0x15, 0xC7}, "+48.8583+002.2945/"...)
+	title := mp4Box("\xa9nam", mp4Box("data", append(make([]byte, 8), "Holiday"...)))
+	udta := mp4Box("udta", mp4Box("\xa9xyz", xyz), mp4Box("meta", make([]byte, 4), mp4Box("ilst", title)))
+
+	return bytes.Join([][]byte{
+		mp4Box("ftyp", []byte("isom\x00\x00\x02\x00")),
+		mp4Box("mdat", make([]byte, 64)),
+		mp4Box("moov", mp4Box("mvhd", mvhd), video, udta),
+	}, nil)
+}
+
+func testJPEG() []byte {
+	// Little endian TIFF with Make in IFD0 and DateTimeOriginal in the Exif IFD.
+	tiff := []byte("II*\x00\x08\x00\x00\x00")
+	ifd0 := []byte{2, 0}
+	ifd0 = append(ifd0, 0x0F, 0x01, 2, 0, 6, 0, 0, 0, 38, 0, 0, 0)
+	ifd0 = append(ifd0, 0x69, 0x87, 4, 0, 1, 0, 0, 0, 44, 0, 0, 0)
+	ifd0 = append(ifd0, 0, 0, 0, 0)
+	tiff = append(tiff, ifd0...)
+	tiff = append(tiff, "Canon\x00"...)
+	exif := []byte{1, 0, 0x03, 0x90, 2, 0, 20, 0, 0, 0, 62, 0, 0, 0, 0, 0, 0, 0}
+	tiff = append(tiff, exif...)
+	tiff = append(tiff, "2023:07:14 18:30:00\x00"...)
+
+	app1 := append([]byte("Exif\x00\x00"), tiff...)
+	jpeg := []byte{0xFF, 0xD8, 0xFF, 0xE1}
+	jpeg = binary.BigEndian.AppendUint16(jpeg, uint16(len(app1)+2))
+	jpeg = append(jpeg, app1...)
+	jpeg = append(jpeg, 0xFF, 0xC0, 0, 11, 8, 0x02, 0xD0, 0x05, 0x00, 1, 1, 0x11, 0)
+	return append(jpeg, 0xFF, 0xDA, 0, 2)
+}
+
+func testFLAC() []byte {
+	info := make([]byte, 34)
+	// 44100 Hz, 441000 samples.
+	info[10], info[11], info[12] = 0x0A, 0xC4, 0x40
+	binary.BigEndian.PutUint32(info[14:], 441000)
+
+	comment := binary.LittleEndian.AppendUint32(nil, 0)
+	comment = binary.LittleEndian.AppendUint32(comment, 2)
+	for _, c := range []string{"ARTIST=Band", "tracknumber=3/12"} {
+		comment = binary.LittleEndian.AppendUint32(comment, uint32(len(c)))
+		comment = append(comment, c...)
+	}
+
+	flac := []byte("fLaC")
+	flac = append(flac, 0, 0, 0, byte(len(info)))
+	flac = append(flac, info...)
+	flac = append(flac, 0x84, 0, 0, byte(len(comment)))
+	return append(flac, comment...)
+}
+
+func testMP3() []byte {
+	frame := func(id, text string) []byte {
+		b := []byte(id)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(text)+1))
+		return append(append(b, 0, 0, 3), text...)
+	}
+	tag := append(frame("TIT2", "Song"), frame("TDRC", "2019-05-01")...)
+	tag = append(tag, frame("TLEN", "215000")...)
+	mp3 := []byte{'I', 'D', '3', 4, 0, 0, 0, 0, byte(len(tag) >> 7), byte(len(tag) & 0x7F)}
+	mp3 = append(mp3, tag...)
+	return append(mp3, 0xFF, 0xFB, 0x90, 0x64)
+}
+
+func ebml(id []byte, children ...[]byte) []byte {
+	data := bytes.Join(children, nil)
+	return append(append(id, 0x80|byte(len(data))), data...)
+}
+
+func testMKV() []byte {
+	duration := binary.BigEndian.AppendUint64(nil, 0x40B3880000000000) // 5000 ms
+	info := ebml([]byte{0x15, 0x49, 0xA9, 0x66},
+		ebml([]byte{0x2A, 0xD7, 0xB1}, []byte{0x0F, 0x42, 0x40}),
+		ebml([]byte{0x44, 0x89}, duration))
+	tracks := ebml([]byte{0x16, 0x54, 0xAE, 0x6B},
+		ebml([]byte{0xAE},
+			ebml([]byte{0x83}, []byte{1}),
+			ebml([]byte{0x86}, []byte("V_VP9")),
+			ebml([]byte{0xE0}, ebml([]byte{0xB0}, []byte{0x05, 0x00}), ebml([]byte{0xBA}, []byte{0x02, 0xD0}))),
+		ebml([]byte{0xAE},
+			ebml([]byte{0x83}, []byte{2}),
+			ebml([]byte{0x86}, []byte("A_OPUS"))))
+	header := ebml([]byte{0x1A, 0x45, 0xDF, 0xA3}, ebml([]byte{0x42, 0x82}, []byte("webm")))
+	// The segment size is unknown, like in live recordings.
+	segment := append([]byte{0x18, 0x53, 0x80, 0x67, 0xFF}, info...)
+	segment = append(segment, tracks...)
+	return append(header, segment...)
+}
+
+func TestProbe(t *testing.T) {
+	taken := time.Date(2023, 7, 14, 18, 30, 0, 0, time.UTC)
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lat, lon := 48.8583, 2.2945
+
+	tests := []struct {
+		name string
+		data []byte
+		want *Info
+	}{
+		{
+			name: "JPEG",
+			data: testJPEG(),
+			want: &Info{TakenAt: &taken, CameraMake: "Canon", Width: 1280, Height: 720},
+		},
+		{
+			name: "MP4",
+			data: testMP4(),
+			want: &Info{TakenAt: &created, Latitude: &lat, Longitude: &lon, Duration: 90.5,
+				Width: 1920, Height: 1080, VideoCodec: "avc1", Title: "Holiday"},
+		},
+		{
+			name: "MKV",
+			data: testMKV(),
+			want: &Info{Duration: 5, Width: 1280, Height: 720, VideoCodec: "VP9", AudioCodec: "OPUS"},
+		},
+		{
+			name: "FLAC",
+			data: testFLAC(),
+			want: &Info{Duration: 10, AudioCodec: "flac", Artist: "Band", Track: 3},
+		},
+		{
+			name: "MP3",
+			data: testMP3(),
+			want: &Info{Duration: 215, AudioCodec: "mp3", Title: "Song", Year: 2019},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Probe(bytes.NewReader(tt.data), int64(len(tt.data)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProbeUnsupported(t *testing.T) {
+	data := []byte("plain text content")
+	_, err := Probe(bytes.NewReader(data), int64(len(data)))
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func largeBox(typ string, size uint64) []byte {
+	b := binary.BigEndian.AppendUint32(nil, 1)
+	b = append(b, typ...)
+	return binary.BigEndian.AppendUint64(b, size)
+}
+
+func TestProbeMalformedMP4(t *testing.T) {
+	ftyp := mp4Box("ftyp", []byte("isom\x00\x00\x02\x00"))
+	mvhd := make([]byte, 20)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:], 2000)
+
+	tests := []struct {
+		name string
+		data []byte
+		want *Info
+	}{
+		{
+			name: "Top level box past the end",
+			data: append(ftyp, largeBox("mdat", 1<<62)...),
+		},
+		{
+			name: "Top level box overflowing the offset",
+			data: append(ftyp, largeBox("mdat", 1<<63-1)...),
+		},
+		{
+			name: "Top level box with a negative size",
+			data: append(ftyp, largeBox("mdat", 1<<64-1)...),
+		},
+		{
+			name: "Top level box smaller than its header",
+			data: append(ftyp, 0, 0, 0, 4, 'm', 'd', 'a', 't'),
+		},
+		{
+			name: "Child box overflowing the parent",
+			data: append(ftyp, mp4Box("moov", mp4Box("mvhd", mvhd), largeBox("trak", 1<<63-1))...),
+			want: &Info{Duration: 2},
+		},
+		{
+			name: "Child box with a negative size",
+			data: append(ftyp, mp4Box("moov", mp4Box("mvhd", mvhd), largeBox("udta", 1<<64-1))...),
+			want: &Info{Duration: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Probe(bytes.NewReader(tt.data), int64(len(tt.data)))
+			if tt.want == nil {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func FuzzProbe(f *testing.F) {
+	for _, data := range [][]byte{testJPEG(), testMP4(), testMKV(), testFLAC(), testMP3()} {
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		Probe(bytes.NewReader(data), int64(len(data)))
+	})
+}
@@ -0,0 +1,243 @@
+package media
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strings"
+)
+
+const (
+	ebmlSegment        = 0x18538067
+	ebmlInfo           = 0x1549A966
+	ebmlTimestampScale = 0x2AD7B1
+	ebmlDuration       = 0x4489
+	ebmlTitle          = 0x7BA9
+	ebmlTracks         = 0x1654AE6B
+	ebmlTrackEntry     = 0xAE
+	ebmlTrackType      = 0x83
+	ebmlCodecID        = 0x86
+	ebmlVideo          = 0xE0
+	ebmlPixelWidth     = 0xB0
+	ebmlPixelHeight    = 0xBA
+	ebmlCluster        = 0x1F43B675
+
+	mkvVideoTrack = 1
+	mkvAudioTrack = 2
+)
+
+type ebmlElement struct {
+	id   uint32
+	data []byte
+}
+
+// parseMKV reads the segment info and the track list of a Matroska or WebM file. Both
+// come before the first cluster in files written by common muxers.
+func parseMKV(r io.ReaderAt, size int64, info *Info) error {
+	off, err := skipElement(r, 0)
+	if err != nil {
+		return err
+	}
+	id, dataSize, headerSize, err := readElementHeader(r, off)
+	if err != nil {
+		return err
+	}
+	if id != ebmlSegment {
+		return errors.New("mkv segment not found")
+	}
+	end := size
+	if dataSize >= 0 {
+		end = min(size, off+headerSize+dataSize)
+	}
+
+	scale := uint64(1000000)
+	var duration float64
+	for off += headerSize; off < end; {
+		id, dataSize, headerSize, err := readElementHeader(r, off)
+		if err != nil || id == ebmlCluster || dataSize < 0 {
+			break
+		}
+		switch id {
+		case ebmlInfo, ebmlTracks:
+			data, err := readAt(r, off+headerSize, int(dataSize))
+			if err != nil {
+				return err
+			}
+			if id == ebmlTracks {
+				parseMKVTracks(data, info)
+				break
+			}
+			for _, el := range ebmlElements(data) {
+				switch el.id {
+				case ebmlTimestampScale:
+					scale = ebmlUint(el.data)
+				case ebmlDuration:
+					duration = ebmlFloat(el.data)
+				case ebmlTitle:
+					info.Title = strings.TrimSpace(string(el.data))
+				}
+			}
+		}
+		off += headerSize + dataSize
+	}
+	info.Duration = duration * float64(scale) / 1e9
+	return nil
+}
+
+func parseMKVTracks(data []byte, info *Info) {
+	for _, entry := range ebmlElements(data) {
+		if entry.id != ebmlTrackEntry {
+			continue
+		}
+		var trackType uint64
+		var codec string
+		var width, height int
+		for _, el := range ebmlElements(entry.data) {
+			switch el.id {
+			case ebmlTrackType:
+				trackType = ebmlUint(el.data)
+			case ebmlCodecID:
+				codec = strings.TrimPrefix(strings.TrimRight(string(el.data), "\x00"), "V_")
+				codec = strings.TrimPrefix(codec, "A_")
+			case ebmlVideo:
+				for _, video := range ebmlElements(el.data) {
+					switch video.id {
+					case ebmlPixelWidth:
+						width = int(ebmlUint(video.data))
+					case ebmlPixelHeight:
+						height = int(ebmlUint(video.data))
+					}
+				}
+			}
+		}
+		switch {
+		case trackType == mkvVideoTrack && info.VideoCodec == "":
+			info.VideoCodec, info.Width, info.Height = codec, width, height
+		case trackType == mkvAudioTrack && info.AudioCodec == "":
+			info.AudioCodec = codec
+		}
+	}
+}
+
+// skipElement returns the offset right after the element at off.
+func skipElement(r io.ReaderAt, off int64) (int64, error) {
+	_, dataSize, headerSize, err := readElementHeader(r, off)
+	if err != nil {
+		return 0, err
+	}
+	if dataSize < 0 {
+		return 0, errors.New("invalid ebml header")
+	}
+	return off + headerSize + dataSize, nil
+}
+
+// readElementHeader reads the id and the size of the element at off, an unknown size
+// is returned as -1.
+func readElementHeader(r io.ReaderAt, off int64) (uint32, int64, int64, error) {
+	buf, err := readAt(r, off, 12)
+	if err != nil {
+		// The last element of a small file may have a shorter header.
+		buf, err = readAt(r, off, 2)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	id, idLen, ok := ebmlID(buf)
+	if !ok {
+		return 0, 0, 0, errors.New("invalid ebml element id")
+	}
+	dataSize, sizeLen, ok := ebmlSize(buf[idLen:])
+	if !ok {
+		return 0, 0, 0, errors.New("invalid ebml element size")
+	}
+	return id, dataSize, int64(idLen + sizeLen), nil
+}
+
+// ebmlElements splits data into its child elements, stopping at the first malformed one.
+func ebmlElements(data []byte) []ebmlElement {
+	var res []ebmlElement
+	for pos := 0; pos < len(data); {
+		id, idLen, ok := ebmlID(data[pos:])
+		if !ok {
+			return res
+		}
+		size, sizeLen, ok := ebmlSize(data[pos+idLen:])
+		if !ok || size < 0 {
+			return res
+		}
+		start := pos + idLen + sizeLen
+		if int64(len(data)-start) < size {
+			return res
+		}
+		res = append(res, ebmlElement{id: id, data: data[start : start+int(size)]})
+		pos = start + int(size)
+	}
+	return res
+}
+
+// ebmlID reads an element id, which keeps its length marker bits.
+func ebmlID(b []byte) (uint32, int, bool) {
+	if len(b) == 0 || b[0] == 0 {
+		return 0, 0, false
+	}
+	n := bitsLen(b[0])
+	if n > 4 || len(b) < n {
+		return 0, 0, false
+	}
+	var id uint32
+	for _, c := range b[:n] {
+		id = id<<8 | uint32(c)
+	}
+	return id, n, true
+}
+
+// ebmlSize reads an element size, all value bits set means the size is unknown.
+func ebmlSize(b []byte) (int64, int, bool) {
+	if len(b) == 0 || b[0] == 0 {
+		return 0, 0, false
+	}
+	n := bitsLen(b[0])
+	if len(b) < n {
+		return 0, 0, false
+	}
+	value := uint64(b[0] & (0xFF >> n))
+	for _, c := range b[1:n] {
+		value = value<<8 | uint64(c)
+	}
+	if value == 1<<(7*n)-1 {
+		return -1, n, true
+	}
+	if value > math.MaxInt64 {
+		return 0, 0, false
+	}
+	return int64(value), n, true
+}
+
+// bitsLen returns the length of a variable size integer from the leading zeros of its
+// first byte.
+func bitsLen(b byte) int {
+	n := 1
+	for mask := byte(0x80); b&mask == 0; mask >>= 1 {
+		n++
+	}
+	return n
+}
+
+func ebmlUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+func ebmlFloat(b []byte) float64 {
+	switch len(b) {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
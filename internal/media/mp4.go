@@ -0,0 +1,239 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// mp4Epoch is the origin of the timestamps in ISO base media files.
+var mp4Epoch = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// iso6709 matches locations like +37.7749-122.4194/ in the ©xyz box.
+var iso6709 = regexp.MustCompile(`^([+-]\d+(?:\.\d+)?)([+-]\d+(?:\.\d+)?)`)
+
+var ilstFields = map[string]string{
+	"\xa9nam": "title",
+	"\xa9ART": "artist",
+	"\xa9alb": "album",
+	"\xa9day": "year",
+	"\xa9gen": "genre",
+}
+
+type box struct {
+	typ  string
+	data []byte
+}
+
+// parseMP4 looks up the moov box among the top level boxes, which may come after the
+// media data, and reads the movie and track headers from it.
+func parseMP4(r io.ReaderAt, size int64, info *Info) error {
+	for off := int64(0); off+8 <= size; {
+		header, err := readAt(r, off, 8)
+		if err != nil {
+			return err
+		}
+		boxSize := int64(binary.BigEndian.Uint32(header))
+		headerSize := int64(8)
+		switch boxSize {
+		case 0:
+			boxSize = size - off
+		case 1:
+			large, err := readAt(r, off+8, 8)
+			if err != nil {
+				return err
+			}
+			boxSize, headerSize = int64(binary.BigEndian.Uint64(large)), 16
+		}
+		if boxSize < headerSize || boxSize > size-off {
+			return errors.New("invalid mp4 box")
+		}
+		if string(header[4:8]) == "moov" {
+			moov, err := readAt(r, off+headerSize, int(boxSize-headerSize))
+			if err != nil {
+				return err
+			}
+			parseMoov(moov, info)
+			return nil
+		}
+		off += boxSize
+	}
+	return errors.New("mp4 movie box not found")
+}
+
+func parseMoov(moov []byte, info *Info) {
+	for _, child := range boxes(moov) {
+		switch child.typ {
+		case "mvhd":
+			parseMvhd(child.data, info)
+		case "trak":
+			parseTrak(child.data, info)
+		case "udta":
+			parseUdta(child.data, info)
+		}
+	}
+}
+
+func parseMvhd(data []byte, info *Info) {
+	var created uint64
+	var timescale uint32
+	var duration uint64
+	switch {
+	case len(data) >= 32 && data[0] == 1:
+		created = binary.BigEndian.Uint64(data[4:])
+		timescale = binary.BigEndian.Uint32(data[20:])
+		duration = binary.BigEndian.Uint64(data[24:])
+	case len(data) >= 20:
+		created = uint64(binary.BigEndian.Uint32(data[4:]))
+		timescale = binary.BigEndian.Uint32(data[12:])
+		duration = uint64(binary.BigEndian.Uint32(data[16:]))
+	default:
+		return
+	}
+	if timescale > 0 {
+		info.Duration = float64(duration) / float64(timescale)
+	}
+	// Cameras record the capture time here, muxers often leave it at zero.
+	if created > 0 {
+		at := mp4Epoch.Add(time.Duration(created) * time.Second)
+		if at.Year() > 1970 {
+			info.TakenAt = &at
+		}
+	}
+}
+
+func parseTrak(trak []byte, info *Info) {
+	var width, height int
+	var handler, codec string
+	for _, child := range boxes(trak) {
+		switch child.typ {
+		case "tkhd":
+			offset := 76
+			if len(child.data) > 0 && child.data[0] == 1 {
+				offset = 88
+			}
+			if len(child.data) >= offset+8 {
+				width = int(binary.BigEndian.Uint32(child.data[offset:]) >> 16)
+				height = int(binary.BigEndian.Uint32(child.data[offset+4:]) >> 16)
+			}
+		case "mdia":
+			handler, codec = parseMdia(child.data)
+		}
+	}
+	switch handler {
+	case "vide":
+		if info.VideoCodec == "" {
+			info.VideoCodec, info.Width, info.Height = codec, width, height
+		}
+	case "soun":
+		if info.AudioCodec == "" {
+			info.AudioCodec = codec
+		}
+	}
+}
+
+// parseMdia returns the handler type and the sample format of a track.
+func parseMdia(mdia []byte) (string, string) {
+	var handler, codec string
+	for _, child := range boxes(mdia) {
+		switch child.typ {
+		case "hdlr":
+			if len(child.data) >= 12 {
+				handler = string(child.data[8:12])
+			}
+		case "minf":
+			for _, minf := range boxes(child.data) {
+				if minf.typ != "stbl" {
+					continue
+				}
+				for _, stbl := range boxes(minf.data) {
+					if stbl.typ == "stsd" && len(stbl.data) >= 16 {
+						codec = strings.TrimSpace(string(stbl.data[12:16]))
+					}
+				}
+			}
+		}
+	}
+	return handler, codec
+}
+
+// parseUdta reads the location and the iTunes style tags of the movie.
+func parseUdta(udta []byte, info *Info) {
+	for _, child := range boxes(udta) {
+		switch child.typ {
+		case "\xa9xyz":
+			if len(child.data) < 4 {
+				continue
+			}
+			match := iso6709.FindStringSubmatch(string(child.data[4:]))
+			if match == nil {
+				continue
+			}
+			lat, err1 := strconv.ParseFloat(match[1], 64)
+			lon, err2 := strconv.ParseFloat(match[2], 64)
+			if err1 == nil && err2 == nil {
+				info.Latitude, info.Longitude = &lat, &lon
+			}
+		case "meta":
+			// meta is a full box, its children follow the version and flags.
+			if len(child.data) < 4 {
+				continue
+			}
+			for _, meta := range boxes(child.data[4:]) {
+				if meta.typ == "ilst" {
+					parseIlst(meta.data, info)
+				}
+			}
+		}
+	}
+}
+
+func parseIlst(ilst []byte, info *Info) {
+	for _, item := range boxes(ilst) {
+		for _, data := range boxes(item.data) {
+			// The value follows the type indicator and the locale.
+			if data.typ != "data" || len(data.data) < 8 {
+				continue
+			}
+			value := data.data[8:]
+			if item.typ == "trkn" {
+				if len(value) >= 4 {
+					info.Track = int(binary.BigEndian.Uint16(value[2:]))
+				}
+				continue
+			}
+			if field, ok := ilstFields[item.typ]; ok {
+				setTag(info, field, strings.TrimSpace(string(bytes.TrimRight(value, "\x00"))))
+			}
+		}
+	}
+}
+
+// boxes splits data into its child boxes, stopping at the first malformed one.
+func boxes(data []byte) []box {
+	var res []box
+	for pos := 0; pos+8 <= len(data); {
+		size := int(binary.BigEndian.Uint32(data[pos:]))
+		headerSize := 8
+		switch size {
+		case 0:
+			size = len(data) - pos
+		case 1:
+			if pos+16 > len(data) {
+				return res
+			}
+			size, headerSize = int(binary.BigEndian.Uint64(data[pos+8:])), 16
+		}
+		if size < headerSize || size > len(data)-pos {
+			return res
+		}
+		res = append(res, box{typ: string(data[pos+4 : pos+8]), data: data[pos+headerSize : pos+size]})
+		pos += size
+	}
+	return res
+}
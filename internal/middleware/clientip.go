@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// ClientIP resolves the address of the client for rate limits and share limits, which
+// must not trust headers anyone can set. Requests are taken to come from their
+// connection address, forwarding headers are only followed when that address is one of
+// the trusted proxies. It has to run before middleware that rewrites RemoteAddr.
+func ClientIP(trustedProxies []string) (Middleware, error) {
+	proxies := make([]netip.Prefix, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(proxy)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := resolveClientIP(r, proxies)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip)))
+		})
+	}, nil
+}
+
+// GetClientIP returns the client address ClientIP resolved, or the host of RemoteAddr
+// for requests it did not see.
+func GetClientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return remoteHost(r.RemoteAddr)
+}
+
+func resolveClientIP(r *http.Request, proxies []netip.Prefix) string {
+	peer := remoteHost(r.RemoteAddr)
+	peerAddr, err := netip.ParseAddr(peer)
+	if err != nil || !trusted(peerAddr, proxies) {
+		return peer
+	}
+	// Walk the chain from the nearest hop, the first address that is not a trusted
+	// proxy is the client. Entries left of it may be forged.
+	addr := peerAddr
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		if !trusted(hop, proxies) {
+			return hop.Unmap().String()
+		}
+		addr = hop
+	}
+	if addr == peerAddr {
+		if realIP, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP"))); err == nil {
+			return realIP.Unmap().String()
+		}
+	}
+	return addr.Unmap().String()
+}
+
+func trusted(addr netip.Addr, proxies []netip.Prefix) bool {
+	addr = addr.Unmap()
+	for _, proxy := range proxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func remoteHost(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
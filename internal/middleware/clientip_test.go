@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name       string
+		proxies    []string
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		want       string
+	}{
+		{
+			name:       "No proxies ignores headers",
+			remoteAddr: "203.0.113.7:5000",
+			forwarded:  []string{"198.51.100.1"},
+			realIP:     "198.51.100.2",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "Untrusted peer ignores headers",
+			proxies:    []string{"10.0.0.0/8"},
+			remoteAddr: "203.0.113.7:5000",
+			forwarded:  []string{"198.51.100.1"},
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "Trusted proxy",
+			proxies:    []string{"10.0.0.1"},
+			remoteAddr: "10.0.0.1:5000",
+			forwarded:  []string{"198.51.100.1"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "Forged entries left of the client",
+			proxies:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.0.0.1:5000",
+			forwarded:  []string{"192.0.2.9, 198.51.100.1", "10.0.0.2"},
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "Real IP header from a trusted proxy",
+			proxies:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.0.0.1:5000",
+			realIP:     "198.51.100.2",
+			want:       "198.51.100.2",
+		},
+		{
+			name:       "Invalid forwarded entry",
+			proxies:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.0.0.1:5000",
+			forwarded:  []string{"unknown"},
+			want:       "10.0.0.1",
+		},
+		{
+			name:       "IPv6 proxy",
+			proxies:    []string{"::1"},
+			remoteAddr: "[::1]:5000",
+			forwarded:  []string{"2001:db8::1"},
+			want:       "2001:db8::1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw, err := ClientIP(tt.proxies)
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+			var got string
+			mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = GetClientIP(r)
+			})).ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClientIPInvalidProxy(t *testing.T) {
+	_, err := ClientIP([]string{"not-an-address"})
+	assert.Error(t, err)
+}
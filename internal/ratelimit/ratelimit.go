@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/tgdrive/teldrive/internal/cache"
+)
+
+// Policy controls when a key gets locked and for how long. Failures are counted in
+// fixed windows of length Window. Every lockout doubles the previous one until
+// MaxLockout is reached, the lockout count is forgotten after Memory without new
+// lockouts.
+type Policy struct {
+	Attempts   int
+	Window     time.Duration
+	Lockout    time.Duration
+	MaxLockout time.Duration
+	Memory     time.Duration
+}
+
+var DefaultPolicy = Policy{
+	Attempts:   5,
+	Window:     15 * time.Minute,
+	Lockout:    time.Minute,
+	MaxLockout: 24 * time.Hour,
+	Memory:     24 * time.Hour,
+}
+
+// Limiter counts failed attempts per key in the cache, so limits hold across
+// instances sharing a redis cache. Counters are incremented atomically, concurrent
+// failures can't overwrite each other.
+type Limiter struct {
+	cache  cache.Cacher
+	name   string
+	policy Policy
+	now    func() time.Time
+}
+
+func New(cacher cache.Cacher, name string, policy Policy) *Limiter {
+	return &Limiter{cache: cacher, name: name, policy: policy, now: time.Now}
+}
+
+// Allow reports whether none of the keys is locked and otherwise how long the caller
+// has to wait.
+func (l *Limiter) Allow(keys ...string) (time.Duration, bool) {
+	now := l.now()
+	var wait time.Duration
+	for _, key := range keys {
+		var lockedUntil time.Time
+		if err := l.cache.Get(l.key("locked", key), &lockedUntil); err != nil {
+			continue
+		}
+		if d := lockedUntil.Sub(now); d > wait {
+			wait = d
+		}
+	}
+	return wait, wait == 0
+}
+
+// Fail records a failed attempt for every key and returns the longest lockout it
+// started, or zero when no key got locked.
+func (l *Limiter) Fail(keys ...string) time.Duration {
+	now := l.now()
+	var locked time.Duration
+	for _, key := range keys {
+		failures := l.failuresKey(key, now)
+		n, err := l.cache.Incr(failures, l.policy.Window)
+		// Only the failure that reaches the limit locks, those racing it find the
+		// key locked already.
+		if err != nil || n != int64(l.policy.Attempts) {
+			continue
+		}
+		lockouts, err := l.cache.Incr(l.key("lockouts", key), l.policy.Memory)
+		if err != nil {
+			continue
+		}
+		d := l.lockout(int(lockouts) - 1)
+		l.cache.Set(l.key("locked", key), now.Add(d), max(d, time.Second))
+		l.cache.Delete(failures)
+		locked = max(locked, d)
+	}
+	return locked
+}
+
+// Reset forgets the failures of the keys after a successful attempt.
+func (l *Limiter) Reset(keys ...string) {
+	now := l.now()
+	for _, key := range keys {
+		l.cache.Delete(l.failuresKey(key, now), l.key("lockouts", key), l.key("locked", key))
+	}
+}
+
+func (l *Limiter) lockout(lockouts int) time.Duration {
+	d := l.policy.Lockout
+	for range lockouts {
+		d *= 2
+		if d >= l.policy.MaxLockout {
+			return l.policy.MaxLockout
+		}
+	}
+	return min(d, l.policy.MaxLockout)
+}
+
+// failuresKey returns the counter of the window now falls into.
+func (l *Limiter) failuresKey(key string, now time.Time) string {
+	return l.key("failures", key, now.Truncate(l.policy.Window).Unix())
+}
+
+func (l *Limiter) key(args ...any) string {
+	return cache.Key(append([]any{"ratelimit", l.name}, args...)...)
+}
@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgdrive/teldrive/internal/cache"
+)
+
+func newTestLimiter(now *time.Time) *Limiter {
+	l := New(cache.NewMemoryCache(1*1024*1024), "test", Policy{
+		Attempts:   3,
+		Window:     time.Minute,
+		Lockout:    time.Minute,
+		MaxLockout: 5 * time.Minute,
+		Memory:     time.Hour,
+	})
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+func TestLockoutAfterAttempts(t *testing.T) {
+	now := time.Now()
+	l := newTestLimiter(&now)
+
+	assert.Zero(t, l.Fail("ip"))
+	assert.Zero(t, l.Fail("ip"))
+	_, ok := l.Allow("ip")
+	assert.True(t, ok)
+
+	assert.Equal(t, time.Minute, l.Fail("ip"))
+	wait, ok := l.Allow("ip", "other")
+	assert.False(t, ok)
+	assert.Equal(t, time.Minute, wait)
+
+	_, ok = l.Allow("other")
+	assert.True(t, ok)
+
+	now = now.Add(time.Minute)
+	_, ok = l.Allow("ip")
+	assert.True(t, ok)
+}
+
+func TestExponentialLockout(t *testing.T) {
+	now := time.Now()
+	l := newTestLimiter(&now)
+
+	tests := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for _, expected := range tests {
+		l.Fail("ip")
+		l.Fail("ip")
+		assert.Equal(t, expected, l.Fail("ip"))
+		now = now.Add(expected)
+	}
+}
+
+func TestWindowExpiry(t *testing.T) {
+	now := time.Now()
+	l := newTestLimiter(&now)
+
+	l.Fail("ip")
+	l.Fail("ip")
+	now = now.Add(2 * time.Minute)
+	assert.Zero(t, l.Fail("ip"))
+	_, ok := l.Allow("ip")
+	assert.True(t, ok)
+}
+
+func TestReset(t *testing.T) {
+	now := time.Now()
+	l := newTestLimiter(&now)
+
+	l.Fail("ip")
+	l.Fail("ip")
+	l.Reset("ip")
+	assert.Zero(t, l.Fail("ip"))
+	assert.Zero(t, l.Fail("ip"))
+}
+
+func TestConcurrentFailures(t *testing.T) {
+	now := time.Now()
+	l := newTestLimiter(&now)
+
+	var wg sync.WaitGroup
+	lockouts := make(chan time.Duration, 3)
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lockouts <- l.Fail("ip")
+		}()
+	}
+	wg.Wait()
+	close(lockouts)
+
+	locked := 0
+	for d := range lockouts {
+		if d > 0 {
+			locked++
+		}
+	}
+	assert.Equal(t, 1, locked)
+	_, ok := l.Allow("ip")
+	assert.False(t, ok)
+}
@@ -12,6 +12,9 @@ import (
 	"github.com/tgdrive/teldrive/pkg/types"
 )
 
+// KeyFunc resolves the encryption key a part was sealed with from its key id.
+type KeyFunc func(keyId string) (string, error)
+
 type Range struct {
 	Start, End int64
 	PartNo     int64
@@ -26,6 +29,7 @@ type LinearReader struct {
 	reader      io.ReadCloser
 	remaining   int64
 	config      *config.TGConfig
+	keys        KeyFunc
 	client      *tg.Client
 	concurrency int
 	cache       cache.Cacher
@@ -56,6 +60,7 @@ func NewLinearReader(ctx context.Context,
 	start,
 	end int64,
 	config *config.TGConfig,
+	keys KeyFunc,
 	concurrency int,
 ) (io.ReadCloser, error) {
 
@@ -70,6 +75,7 @@ func NewLinearReader(ctx context.Context,
 		remaining:   end - start + 1,
 		ranges:      calculatePartByteRanges(start, end, size),
 		config:      config,
+		keys:        keys,
 		client:      client,
 		concurrency: concurrency,
 		cache:       cache,
@@ -130,8 +136,13 @@ func (r *LinearReader) getPartReader() (io.ReadCloser, error) {
 	currentRange := r.ranges[r.pos]
 	partId := r.parts[currentRange.PartNo].ID
 
+	channelId := r.parts[currentRange.PartNo].ChannelId
+	if channelId == 0 {
+		channelId = *r.file.ChannelId
+	}
+
 	chunkSrc := &chunkSource{
-		channelId:   *r.file.ChannelId,
+		channelId:   channelId,
 		partId:      partId,
 		client:      r.client,
 		concurrency: r.concurrency,
@@ -144,8 +155,13 @@ func (r *LinearReader) getPartReader() (io.ReadCloser, error) {
 		err    error
 	)
 	if *r.file.Encrypted {
-		salt := r.parts[r.ranges[r.pos].PartNo].Salt
-		cipher, _ := crypt.NewCipher(r.config.Uploads.EncryptionKey, salt)
+		part := r.parts[r.ranges[r.pos].PartNo]
+		var key string
+		key, err = r.keys(part.KeyId)
+		if err != nil {
+			return nil, err
+		}
+		cipher, _ := crypt.NewCipher(key, part.Salt)
 		reader, err = cipher.DecryptDataSeek(r.ctx,
 			func(ctx context.Context,
 				underlyingOffset,
@@ -0,0 +1,239 @@
+// Package searchquery parses the structured filters of file searches, such as
+// `size>1GB ext:mkv,mp4 created>2024-01-01 -in:/Trash`.
+//
+// A query is a list of terms separated by spaces, all of which have to match. A term
+// is a field, an operator and a value, a leading "-" negates it and values holding
+// spaces are quoted. Size and date fields take the comparison operators =, >, <, >=
+// and <=, the other fields take ":" and a comma separated list of values, any of which
+// can match.
+package searchquery
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type Field string
+
+const (
+	FieldSize     Field = "size"
+	FieldCreated  Field = "created"
+	FieldUpdated  Field = "updated"
+	FieldMime     Field = "mime"
+	FieldExt      Field = "ext"
+	FieldType     Field = "type"
+	FieldCategory Field = "category"
+	FieldIn       Field = "in"
+)
+
+type Op string
+
+const (
+	OpEq  Op = "="
+	OpGt  Op = ">"
+	OpLt  Op = "<"
+	OpGte Op = ">="
+	OpLte Op = "<="
+	OpIn  Op = ":"
+)
+
+// Term is a single parsed filter.
+type Term struct {
+	Field  Field
+	Op     Op
+	Negate bool
+	// Values holds the values of list fields.
+	Values []string
+	// Size holds the value of size fields, in bytes.
+	Size int64
+	// Time holds the value of date fields. Day is set when only a date was given, the
+	// term then covers the whole day.
+	Time time.Time
+	Day  bool
+}
+
+var ErrInvalid = errors.New("invalid filter")
+
+var (
+	sizeUnits = map[string]float64{
+		"": 1, "b": 1,
+		"k": 1 << 10, "kb": 1 << 10, "kib": 1 << 10,
+		"m": 1 << 20, "mb": 1 << 20, "mib": 1 << 20,
+		"g": 1 << 30, "gb": 1 << 30, "gib": 1 << 30,
+		"t": 1 << 40, "tb": 1 << 40, "tib": 1 << 40,
+	}
+	sizePattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([a-zA-Z]*)$`)
+	extPattern  = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	mimePattern = regexp.MustCompile(`^[a-zA-Z0-9!#$&^_.+*-]+(/[a-zA-Z0-9!#$&^_.+*-]+)?$`)
+
+	types      = []string{"file", "folder"}
+	categories = []string{"document", "image", "video", "audio", "archive", "other"}
+)
+
+// Parse parses a query, any term that can't be understood fails the whole query.
+func Parse(query string) ([]Term, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]Term, 0, len(tokens))
+	for _, token := range tokens {
+		term, err := parseTerm(token)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %s", ErrInvalid, token, err)
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+// tokenize splits a query on spaces outside of double quotes, the quotes are removed.
+func tokenize(query string) ([]string, error) {
+	var tokens []string
+	var sb strings.Builder
+	quoted, started := false, false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted, started = !quoted, true
+		case unicode.IsSpace(r) && !quoted:
+			if started {
+				tokens = append(tokens, sb.String())
+				sb.Reset()
+				started = false
+			}
+		default:
+			sb.WriteRune(r)
+			started = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("%w: unterminated quote", ErrInvalid)
+	}
+	if started {
+		tokens = append(tokens, sb.String())
+	}
+	return tokens, nil
+}
+
+func parseTerm(token string) (Term, error) {
+	term := Term{}
+	if rest, ok := strings.CutPrefix(token, "-"); ok {
+		term.Negate, token = true, rest
+	}
+	idx := strings.IndexAny(token, ":=<>")
+	if idx <= 0 {
+		return term, errors.New("expected a field and a value")
+	}
+	term.Field = Field(strings.ToLower(token[:idx]))
+	rest := token[idx:]
+	for _, op := range []Op{OpGte, OpLte, OpEq, OpGt, OpLt, OpIn} {
+		if value, ok := strings.CutPrefix(rest, string(op)); ok {
+			term.Op, rest = op, value
+			break
+		}
+	}
+	if rest == "" {
+		return term, errors.New("missing value")
+	}
+
+	switch term.Field {
+	case FieldSize:
+		if term.Op == OpIn {
+			term.Op = OpEq
+		}
+		size, err := parseSize(rest)
+		if err != nil {
+			return term, err
+		}
+		term.Size = size
+	case FieldCreated, FieldUpdated:
+		if term.Op == OpIn {
+			term.Op = OpEq
+		}
+		t, day, err := parseTime(rest)
+		if err != nil {
+			return term, err
+		}
+		term.Time, term.Day = t, day
+	case FieldMime, FieldExt, FieldType, FieldCategory, FieldIn:
+		if term.Op != OpIn {
+			return term, fmt.Errorf("%s only supports %q", term.Field, OpIn)
+		}
+		values, err := parseList(term.Field, rest)
+		if err != nil {
+			return term, err
+		}
+		term.Values = values
+	default:
+		return term, fmt.Errorf("unknown field %q", term.Field)
+	}
+	return term, nil
+}
+
+func parseSize(value string) (int64, error) {
+	match := sizePattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, errors.New("invalid size")
+	}
+	unit, ok := sizeUnits[strings.ToLower(match[2])]
+	if !ok {
+		return 0, fmt.Errorf("unknown size unit %q", match[2])
+	}
+	n, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, errors.New("invalid size")
+	}
+	size := n * unit
+	if size > math.MaxInt64 {
+		return 0, errors.New("size too large")
+	}
+	return int64(size), nil
+}
+
+func parseTime(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), false, nil
+	}
+	return time.Time{}, false, errors.New("invalid date, expected YYYY-MM-DD or RFC 3339")
+}
+
+func parseList(field Field, value string) ([]string, error) {
+	// Folder paths may contain commas, they take a single value.
+	if field == FieldIn {
+		return []string{value}, nil
+	}
+	values := strings.Split(value, ",")
+	for i, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if field == FieldExt {
+			v = strings.TrimPrefix(v, ".")
+		}
+		var valid bool
+		switch field {
+		case FieldMime:
+			valid = mimePattern.MatchString(v)
+		case FieldExt:
+			valid = extPattern.MatchString(v)
+		case FieldType:
+			valid = slices.Contains(types, v)
+		case FieldCategory:
+			valid = slices.Contains(categories, v)
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid %s %q", field, v)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
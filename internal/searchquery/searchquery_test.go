@@ -0,0 +1,78 @@
+package searchquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []Term
+	}{
+		{
+			name:  "Size and extensions",
+			query: "size>1GB ext:MKV,.mp4",
+			want: []Term{
+				{Field: FieldSize, Op: OpGt, Size: 1 << 30},
+				{Field: FieldExt, Op: OpIn, Values: []string{"mkv", "mp4"}},
+			},
+		},
+		{
+			name:  "Dates",
+			query: "created>=2024-01-01 updated<2024-02-01T10:00:00+02:00",
+			want: []Term{
+				{Field: FieldCreated, Op: OpGte, Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Day: true},
+				{Field: FieldUpdated, Op: OpLt, Time: time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:  "Negated folder with spaces",
+			query: `-in:"/My Trash" mime:video/*`,
+			want: []Term{
+				{Field: FieldIn, Op: OpIn, Negate: true, Values: []string{"/My Trash"}},
+				{Field: FieldMime, Op: OpIn, Values: []string{"video/*"}},
+			},
+		},
+		{
+			name:  "Fractional size",
+			query: "size<=1.5mb",
+			want:  []Term{{Field: FieldSize, Op: OpLte, Size: 1572864}},
+		},
+		{
+			name:  "Empty",
+			query: "  ",
+			want:  []Term{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, query := range []string{
+		"size>1XB",
+		"created>yesterday",
+		"ext>mkv",
+		"ext:mk*",
+		"owner:me",
+		"category:photos",
+		"size>",
+		"big",
+		`in:"/unterminated`,
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := Parse(query)
+			assert.ErrorIs(t, err, ErrInvalid)
+		})
+	}
+}
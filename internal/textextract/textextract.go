@@ -0,0 +1,128 @@
+// Package textextract pulls the plain text out of text and PDF documents for the
+// content search index.
+package textextract
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ledongthuc/pdf"
+	"golang.org/x/net/html"
+)
+
+var ErrUnsupported = errors.New("unsupported document format")
+
+var (
+	plainExtensions = []string{"txt", "md", "markdown", "csv", "json", "log"}
+	htmlExtensions  = []string{"html", "htm"}
+)
+
+// Extensions returns the file extensions text can be extracted from.
+func Extensions() []string {
+	return slices.Concat(plainExtensions, htmlExtensions, []string{"pdf"})
+}
+
+// Supported reports whether text can be extracted from a file with the given name.
+func Supported(name string) bool {
+	ext := extension(name)
+	return slices.Contains(plainExtensions, ext) || slices.Contains(htmlExtensions, ext) || ext == "pdf"
+}
+
+// Extract returns the text of a document, cut to at most limit bytes.
+func Extract(name string, data []byte, limit int) (string, error) {
+	var text string
+	switch ext := extension(name); {
+	case slices.Contains(plainExtensions, ext):
+		text = string(data)
+	case slices.Contains(htmlExtensions, ext):
+		text = htmlText(data)
+	case ext == "pdf":
+		var err error
+		if text, err = pdfText(data, limit); err != nil {
+			return "", err
+		}
+	default:
+		return "", ErrUnsupported
+	}
+	// Postgres text can't hold null characters.
+	text = strings.ReplaceAll(strings.ToValidUTF8(text, ""), "\x00", "")
+	return truncate(text, limit), nil
+}
+
+func extension(name string) string {
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))
+}
+
+// htmlText keeps the text nodes of a page, without scripts and styles.
+func htmlText(data []byte) string {
+	var sb strings.Builder
+	tokenizer := html.NewTokenizer(bytes.NewReader(data))
+	skip := 0
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return sb.String()
+		case html.StartTagToken:
+			if name, _ := tokenizer.TagName(); isHidden(name) {
+				skip++
+			}
+		case html.EndTagToken:
+			if name, _ := tokenizer.TagName(); isHidden(name) && skip > 0 {
+				skip--
+			}
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+			if text := strings.TrimSpace(string(tokenizer.Text())); text != "" {
+				if sb.Len() > 0 {
+					sb.WriteByte(' ')
+				}
+				sb.WriteString(text)
+			}
+		}
+	}
+}
+
+func isHidden(tag []byte) bool {
+	return string(tag) == "script" || string(tag) == "style"
+}
+
+// pdfText reads the text of a document page by page and stops at the page reaching
+// limit, so compressed content expanding to far more than is indexed isn't read whole.
+func pdfText(data []byte, limit int) (text string, err error) {
+	// The parser panics on some malformed files.
+	defer func() {
+		if r := recover(); r != nil {
+			text, err = "", errors.New("malformed pdf")
+		}
+	}()
+	r, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for i := 1; i <= r.NumPage() && (limit <= 0 || sb.Len() < limit); i++ {
+		page, err := r.Page(i).GetPlainText(nil)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(page)
+	}
+	return sb.String(), nil
+}
+
+// truncate cuts text to at most limit bytes without splitting a character.
+func truncate(text string, limit int) string {
+	if limit <= 0 || len(text) <= limit {
+		return text
+	}
+	for limit > 0 && !utf8.RuneStart(text[limit]) {
+		limit--
+	}
+	return text[:limit]
+}
@@ -0,0 +1,114 @@
+package textextract
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		data     string
+		limit    int
+		want     string
+	}{
+		{
+			name:     "Plain text",
+			fileName: "notes.TXT",
+			data:     "meeting notes\x00",
+			want:     "meeting notes",
+		},
+		{
+			name:     "HTML",
+			fileName: "page.html",
+			data:     "<html><head><style>p{}</style><script>var a</script></head><body><p>Hello</p> <b>world</b></body></html>",
+			want:     "Hello world",
+		},
+		{
+			name:     "Limit keeps whole characters",
+			fileName: "readme.md",
+			data:     "héllo",
+			limit:    2,
+			want:     "h",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Extract(tt.fileName, []byte(tt.data), tt.limit)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExtractUnsupported(t *testing.T) {
+	assert.False(t, Supported("photo.jpg"))
+	_, err := Extract("photo.jpg", []byte{0xFF, 0xD8}, 0)
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestExtractMalformedPDF(t *testing.T) {
+	_, err := Extract("broken.pdf", []byte("%PDF-1.4 not really"), 0)
+	assert.Error(t, err)
+}
+
+// testPDF builds a document with one line of text on each page.
+func testPDF(pages ...string) []byte {
+	objects := []string{"<< /Type /Catalog /Pages 2 0 R >>", ""}
+	kids := []string{}
+	for _, text := range pages {
+		content := fmt.Sprintf("BT /F1 12 Tf 72 720 Td (%s) Tj ET", text)
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /Contents %d 0 R >>", len(objects)+2),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+		kids = append(kids, fmt.Sprintf("%d 0 R", len(objects)-1))
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+
+	var sb strings.Builder
+	sb.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = sb.Len()
+		fmt.Fprintf(&sb, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := sb.Len()
+	fmt.Fprintf(&sb, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&sb, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&sb, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return []byte(sb.String())
+}
+
+func TestPDFText(t *testing.T) {
+	data := testPDF("first page", "second page", "third page")
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []string
+		skip  []string
+	}{
+		{name: "No limit", want: []string{"first page", "second page", "third page"}},
+		{name: "Stops at the page reaching the limit", limit: 15,
+			want: []string{"first page", "second page"}, skip: []string{"third page"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := pdfText(data, tt.limit)
+			require.NoError(t, err)
+			for _, want := range tt.want {
+				assert.Contains(t, text, want)
+			}
+			for _, skip := range tt.skip {
+				assert.NotContains(t, text, skip)
+			}
+		})
+	}
+}
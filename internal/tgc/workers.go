@@ -1,37 +1,81 @@
 package tgc
 
 import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
 	"sync"
+
+	"github.com/tgdrive/teldrive/internal/cache"
 )
 
+const botsChannel = "bots:invalidate"
+
+var ErrNoBots = errors.New("no bots available for channel")
+
 type BotWorker struct {
-	mu      sync.RWMutex
+	mu      sync.Mutex
 	bots    map[int64][]string
 	currIdx map[int64]int
+	cache   cache.Cacher
 }
 
-func NewBotWorker() *BotWorker {
+func NewBotWorker(cache cache.Cacher) *BotWorker {
 	return &BotWorker{
 		bots:    make(map[int64][]string),
 		currIdx: make(map[int64]int),
+		cache:   cache,
 	}
 }
 
+// Set reconciles the rotation for a channel with the given tokens. The rotation is
+// only reset when the token list differs from the one already held.
 func (w *BotWorker) Set(bots []string, channelId int64) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
-	if _, ok := w.bots[channelId]; ok {
+	if current, ok := w.bots[channelId]; ok && slices.Equal(current, bots) {
 		return
 	}
-	w.bots[channelId] = bots
+	w.bots[channelId] = slices.Clone(bots)
 	w.currIdx[channelId] = 0
 }
 
-func (w *BotWorker) Next(channelId int64) (string, int) {
-	w.mu.RLock()
-	defer w.mu.RUnlock()
+// Next returns the next bot of the channel's rotation. The rotation may have been
+// invalidated since it was set, callers fall back to the user's session then.
+func (w *BotWorker) Next(channelId int64) (string, int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
 	bots := w.bots[channelId]
-	index := w.currIdx[channelId]
+	if len(bots) == 0 {
+		return "", 0, ErrNoBots
+	}
+	index := w.currIdx[channelId] % len(bots)
 	w.currIdx[channelId] = (index + 1) % len(bots)
-	return bots[index], index
+	return bots[index], index, nil
+}
+
+// Invalidate drops the rotation for a channel on this instance and broadcasts the
+// invalidation so that other instances drop theirs as well.
+func (w *BotWorker) Invalidate(channelId int64) error {
+	w.remove(channelId)
+	return w.cache.Publish(botsChannel, strconv.FormatInt(channelId, 10))
+}
+
+// Subscribe listens for invalidations published by any instance until ctx is done.
+func (w *BotWorker) Subscribe(ctx context.Context) {
+	w.cache.Subscribe(ctx, botsChannel, func(message string) {
+		channelId, err := strconv.ParseInt(message, 10, 64)
+		if err != nil {
+			return
+		}
+		w.remove(channelId)
+	})
+}
+
+func (w *BotWorker) remove(channelId int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.bots, channelId)
+	delete(w.currIdx, channelId)
 }
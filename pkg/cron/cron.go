@@ -42,7 +42,16 @@ type CronService struct {
 	logger *zap.SugaredLogger
 }
 
-func StartCronJobs(ctx context.Context, db *gorm.DB, cnf *config.ServerCmdConfig) error {
+// Backfills derive data from the content of files stored before it was derived on
+// upload. They live in the services since they need their Telegram access.
+type Backfills struct {
+	Thumbnails   func(context.Context)
+	MediaInfo    func(context.Context)
+	ContentIndex func(context.Context)
+}
+
+// StartCronJobs schedules the maintenance jobs and the backfills.
+func StartCronJobs(ctx context.Context, db *gorm.DB, cnf *config.ServerCmdConfig, backfills Backfills) error {
 
 	err := db.AutoMigrate(&gormlock.CronJobLock{})
 	if err != nil {
@@ -72,6 +81,12 @@ func StartCronJobs(ctx context.Context, db *gorm.DB, cnf *config.ServerCmdConfig
 		gocron.NewTask(cron.cleanUploads, ctx))
 	scheduler.NewJob(gocron.DurationJob(time.Hour*12),
 		gocron.NewTask(cron.cleanOldEvents))
+	scheduler.NewJob(gocron.DurationJob(cnf.CronJobs.ThumbnailsInterval),
+		gocron.NewTask(backfills.Thumbnails, ctx), gocron.WithSingletonMode(gocron.LimitModeReschedule))
+	scheduler.NewJob(gocron.DurationJob(cnf.CronJobs.MediaInfoInterval),
+		gocron.NewTask(backfills.MediaInfo, ctx), gocron.WithSingletonMode(gocron.LimitModeReschedule))
+	scheduler.NewJob(gocron.DurationJob(cnf.CronJobs.ContentIndexInterval),
+		gocron.NewTask(backfills.ContentIndex, ctx), gocron.WithSingletonMode(gocron.LimitModeReschedule))
 
 	scheduler.Start()
 	return nil
@@ -81,7 +96,7 @@ func (c *CronService) cleanFiles(ctx context.Context) {
 	c.logger.Debugf("running clean-files")
 	var results []result
 	if err := c.db.Table("teldrive.files as f").
-		Select("JSONB_AGG(jsonb_build_object('id', f.id, 'parts', f.parts)) as files,f.channel_id,f.user_id,s.session").
+		Select("JSONB_AGG(jsonb_build_object('id', f.id, 'parts', coalesce(f.parts, '[]') || (CASE WHEN jsonb_typeof(f.thumbnails) = 'array' THEN f.thumbnails ELSE '[]' END))) as files,f.channel_id,f.user_id,s.session").
 		Joins("LEFT JOIN teldrive.users as u ON u.user_id = f.user_id").
 		Joins(`LEFT JOIN (
         SELECT user_id, session
@@ -108,24 +123,27 @@ func (c *CronService) cleanFiles(ctx context.Context) {
 		if row.Session == "" {
 			break
 		}
-		ids := []int{}
+		ids := make(map[int64][]int)
 
 		fileIds := []string{}
 
 		for _, file := range row.Files {
 			fileIds = append(fileIds, file.ID)
 			for _, part := range file.Parts {
-				ids = append(ids, int(part.ID))
+				channelId := part.ChannelId.Or(row.ChannelId)
+				ids[channelId] = append(ids[channelId], int(part.ID))
 			}
 
 		}
 
-		client, _ := tgc.AuthClient(ctx, &c.cnf.TG, row.Session, middlewares...)
-		err := tgc.DeleteMessages(ctx, client, row.ChannelId, ids)
+		for channelId, channelIds := range ids {
+			client, _ := tgc.AuthClient(ctx, &c.cnf.TG, row.Session, middlewares...)
+			err := tgc.DeleteMessages(ctx, client, channelId, channelIds)
 
-		if err != nil {
-			c.logger.Errorw("failed to delete messages", err)
-			return
+			if err != nil {
+				c.logger.Errorw("failed to delete messages", err)
+				return
+			}
 		}
 
 		items := pgtype.Array[string]{
@@ -193,4 +211,5 @@ func (c *CronService) updateFolderSize() {
 
 func (c *CronService) cleanOldEvents() {
 	c.db.Exec("DELETE FROM teldrive.events WHERE created_at < NOW() - INTERVAL '5 days';")
+	c.db.Exec("DELETE FROM teldrive.file_opens WHERE opened_at < NOW() - INTERVAL '30 days';")
 }
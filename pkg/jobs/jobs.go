@@ -0,0 +1,121 @@
+// Package jobs runs long operations, like channel migrations and re-encryptions, in
+// the background. Jobs are stored in the jobs table and run by a pool of workers on
+// every instance. A worker claims a job by moving it to running and counting a new
+// attempt in a single update that skips rows other workers are claiming. Every write
+// of the attempt is guarded by its number, so a job taken over stops the attempt that
+// stalled at its next write and only one attempt of each job makes progress. A job
+// whose progress stalls, because its instance stopped, is taken over after a while and
+// resumes from the state it last saved.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/datatypes"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+)
+
+// progressEventInterval limits how often progress is recorded as an event.
+const progressEventInterval = 5 * time.Second
+
+var (
+	ErrNotFound      = errors.New("job not found")
+	ErrInvalidStatus = errors.New("job can't be changed in its current status")
+	// ErrStopped is returned by the progress methods once the job was cancelled or
+	// taken over by another worker, the run should return.
+	ErrStopped = errors.New("job stopped")
+)
+
+// Kind runs the jobs of one type. Run is called again for a job that was interrupted
+// or retried, it should resume from the state the job saved.
+type Kind interface {
+	Name() string
+	Run(ctx context.Context, job *Job) error
+}
+
+type kindFunc struct {
+	name string
+	run  func(ctx context.Context, job *Job) error
+}
+
+func (k kindFunc) Name() string { return k.name }
+
+func (k kindFunc) Run(ctx context.Context, job *Job) error { return k.run(ctx, job) }
+
+// NewKind returns a Kind running its jobs with run.
+func NewKind(name string, run func(ctx context.Context, job *Job) error) Kind {
+	return kindFunc{name: name, run: run}
+}
+
+// Job is a job being run. Its methods save the progress of the run and report whether
+// it should go on.
+type Job struct {
+	models.Job
+	m         *Manager
+	lastEvent time.Time
+}
+
+// Params decodes the parameters the job was enqueued with.
+func (j *Job) Params(params any) error {
+	return json.Unmarshal(j.Job.Params, params)
+}
+
+// State decodes the state the job last saved, it's left untouched for a new job.
+func (j *Job) State(state any) error {
+	if len(j.Job.State) == 0 {
+		return nil
+	}
+	return json.Unmarshal(j.Job.State, state)
+}
+
+// Progress saves how much of the job is done and the state to resume from.
+func (j *Job) Progress(done int64, state any) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	j.Done, j.Job.State = done, data
+	return j.save(map[string]any{"done": done, "state": datatypes.JSON(data)})
+}
+
+// SetTotal updates how much there is to do, for jobs that only know once they started.
+func (j *Job) SetTotal(total int64) error {
+	j.Total = total
+	return j.save(map[string]any{"total": total})
+}
+
+// Heartbeat tells that the job is still running, for steps that take long without
+// progress.
+func (j *Job) Heartbeat() error {
+	return j.save(map[string]any{})
+}
+
+func (j *Job) save(updates map[string]any) error {
+	now := time.Now().UTC()
+	updates["heartbeat_at"] = now
+	updates["updated_at"] = now
+	res := j.m.db.Model(&models.Job{}).Where("id = ?", j.ID).Where("status = ?", StatusRunning).
+		Where("attempt = ?", j.Attempt).Updates(updates)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrStopped
+	}
+	if now.Sub(j.lastEvent) >= progressEventInterval {
+		j.lastEvent = now
+		j.m.record(&j.Job)
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tgdrive/teldrive/internal/config"
+	"github.com/tgdrive/teldrive/internal/events"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// defaultPollInterval is used when the configured interval is not positive.
+const defaultPollInterval = 30 * time.Second
+
+type Manager struct {
+	db     *gorm.DB
+	cnf    *config.ServerCmdConfig
+	events *events.Recorder
+	kinds  map[string]Kind
+	// wake starts an idle worker when a job is enqueued.
+	wake chan struct{}
+
+	mu sync.Mutex
+	// running cancels the jobs this instance runs, by job id.
+	running map[string]context.CancelFunc
+}
+
+func NewManager(db *gorm.DB, cnf *config.ServerCmdConfig, events *events.Recorder) *Manager {
+	return &Manager{
+		db:      db,
+		cnf:     cnf,
+		events:  events,
+		kinds:   make(map[string]Kind),
+		wake:    make(chan struct{}, 1),
+		running: make(map[string]context.CancelFunc),
+	}
+}
+
+// Register adds the kinds of jobs the workers run. Kinds have to be registered before
+// Start.
+func (m *Manager) Register(kinds ...Kind) {
+	for _, kind := range kinds {
+		m.kinds[kind.Name()] = kind
+	}
+}
+
+// Start runs the workers until ctx is done.
+func (m *Manager) Start(ctx context.Context) error {
+	for range max(m.cnf.Jobs.Workers, 1) {
+		go m.work(ctx)
+	}
+	return nil
+}
+
+// Enqueue stores a new job for the workers to pick up.
+func (m *Manager) Enqueue(userId int64, kind string, params any, total int64) (*models.Job, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	job := models.Job{UserId: userId, Kind: kind, Status: StatusPending, Params: data, Total: total}
+	if err := m.db.Create(&job).Error; err != nil {
+		return nil, err
+	}
+	m.record(&job)
+	m.notify()
+	return &job, nil
+}
+
+// List returns the jobs of a user, newest first. Empty filters match any job.
+func (m *Manager) List(userId int64, kind, status string) ([]models.Job, error) {
+	query := m.db.Where("user_id = ?", userId)
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var jobs []models.Job
+	if err := query.Order("created_at DESC").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (m *Manager) Get(userId int64, id string) (*models.Job, error) {
+	if uuid.Validate(id) != nil {
+		return nil, ErrNotFound
+	}
+	var jobs []models.Job
+	if err := m.db.Where("id = ?", id).Where("user_id = ?", userId).Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, ErrNotFound
+	}
+	return &jobs[0], nil
+}
+
+// Cancel stops a pending or running job. A job running on another instance stops at
+// its next progress update.
+func (m *Manager) Cancel(userId int64, id string) (*models.Job, error) {
+	job, err := m.transition(userId, id, StatusCancelled, StatusPending, StatusRunning)
+	if err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	if cancel, ok := m.running[id]; ok {
+		cancel()
+	}
+	m.mu.Unlock()
+	return job, nil
+}
+
+// Retry queues a failed or cancelled job again, it resumes from its saved state.
+func (m *Manager) Retry(userId int64, id string) (*models.Job, error) {
+	job, err := m.transition(userId, id, StatusPending, StatusFailed, StatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+	m.notify()
+	return job, nil
+}
+
+// transition moves a job to status if it's in one of the from statuses. Its attempts
+// are counted afresh, so a retried job gets the full number again.
+func (m *Manager) transition(userId int64, id, status string, from ...string) (*models.Job, error) {
+	if uuid.Validate(id) != nil {
+		return nil, ErrNotFound
+	}
+	var jobs []models.Job
+	if err := m.db.Raw(`UPDATE teldrive.jobs SET status = ?, error = '', base_attempt = attempt, updated_at = ?
+		WHERE id = ? AND user_id = ? AND status IN ? RETURNING *`,
+		status, time.Now().UTC(), id, userId, from).Scan(&jobs).Error; err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		if _, err := m.Get(userId, id); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidStatus
+	}
+	m.record(&jobs[0])
+	return &jobs[0], nil
+}
+
+func (m *Manager) notify() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) work(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval(m.cnf.Jobs.PollInterval))
+	defer ticker.Stop()
+	for {
+		for ctx.Err() == nil && m.runNext(ctx) {
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.wake:
+		}
+	}
+}
+
+func pollInterval(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return defaultPollInterval
+	}
+	return interval
+}
+
+// runNext claims and runs a job, it reports whether there was one. The claim is what
+// keeps other workers off the job, see the package documentation.
+func (m *Manager) runNext(ctx context.Context) bool {
+	if len(m.kinds) == 0 {
+		return false
+	}
+	kinds := make([]string, 0, len(m.kinds))
+	for name := range m.kinds {
+		kinds = append(kinds, name)
+	}
+	var jobs []models.Job
+	if err := m.db.Raw(`UPDATE teldrive.jobs
+		SET status = 'running', attempt = attempt + 1, heartbeat_at = timezone('utc'::text, now()),
+			updated_at = timezone('utc'::text, now())
+		WHERE id = (
+			SELECT id FROM teldrive.jobs
+			WHERE kind IN ? AND (status = 'pending' OR (status = 'running' AND heartbeat_at < ?))
+			ORDER BY created_at LIMIT 1 FOR UPDATE SKIP LOCKED
+		) RETURNING *`, kinds, time.Now().UTC().Add(-m.cnf.Jobs.StaleAfter)).Scan(&jobs).Error; err != nil {
+		logging.FromContext(ctx).Error("failed to claim job", zap.Error(err))
+		return false
+	}
+	if len(jobs) == 0 {
+		return false
+	}
+	job := &jobs[0]
+
+	if exhausted(job, m.cnf.Jobs.MaxAttempts) {
+		logging.FromContext(ctx).Error("job gave up", zap.String("jobId", job.ID), zap.Int("attempt", job.Attempt))
+		m.finish(job, StatusFailed, fmt.Sprintf("gave up after %d attempts", job.Attempt-job.BaseAttempt-1))
+		return true
+	}
+
+	m.run(ctx, job)
+	return true
+}
+
+// exhausted reports whether a claimed job went over the attempts it may start since it
+// was enqueued or retried. A limit below one lets jobs start any number of times.
+func exhausted(job *models.Job, maxAttempts int) bool {
+	return maxAttempts > 0 && job.Attempt-job.BaseAttempt > maxAttempts
+}
+
+// runKind runs a job, turning a panic of the kind into an error so it fails the job
+// instead of the instance.
+func runKind(ctx context.Context, kind Kind, job *Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return kind.Run(ctx, job)
+}
+
+func (m *Manager) run(ctx context.Context, job *models.Job) {
+	logger := logging.FromContext(ctx).With(zap.String("jobId", job.ID), zap.String("kind", job.Kind))
+	runCtx, cancel := context.WithCancel(ctx)
+	m.mu.Lock()
+	m.running[job.ID] = cancel
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		delete(m.running, job.ID)
+		m.mu.Unlock()
+		cancel()
+	}()
+
+	logger.Info("job started", zap.Int("attempt", job.Attempt))
+	m.record(job)
+	run := &Job{Job: *job, m: m, lastEvent: time.Now()}
+	err := runKind(logging.WithLogger(runCtx, logger), m.kinds[job.Kind], run)
+	if ctx.Err() != nil {
+		// Shutting down, the job is taken over once its heartbeat goes stale.
+		return
+	}
+	if errors.Is(err, ErrStopped) || runCtx.Err() != nil {
+		logger.Info("job stopped")
+		return
+	}
+
+	status, errMsg := StatusCompleted, ""
+	if err != nil {
+		status, errMsg = StatusFailed, err.Error()
+		logger.Error("job failed", zap.Error(err))
+	} else {
+		logger.Info("job completed", zap.Int64("done", run.Done))
+	}
+	m.finish(&run.Job, status, errMsg)
+}
+
+// finish ends the attempt of a job with status, unless the job was cancelled or taken
+// over meanwhile.
+func (m *Manager) finish(job *models.Job, status, errMsg string) {
+	job.Status, job.Error, job.UpdatedAt = status, errMsg, time.Now().UTC()
+	res := m.db.Model(&models.Job{}).Where("id = ?", job.ID).Where("status = ?", StatusRunning).
+		Where("attempt = ?", job.Attempt).Updates(map[string]any{
+		"status":     status,
+		"error":      errMsg,
+		"updated_at": job.UpdatedAt,
+	})
+	if res.Error == nil && res.RowsAffected > 0 {
+		m.record(job)
+	}
+}
+
+// record streams the status and progress of a job to its owner as an event.
+func (m *Manager) record(job *models.Job) {
+	m.events.Record(events.OpJob, job.UserId, &models.Source{
+		ID:     job.ID,
+		Type:   "job",
+		Name:   job.Kind,
+		Status: job.Status,
+		Done:   job.Done,
+		Total:  job.Total,
+	})
+}
@@ -0,0 +1,267 @@
+package jobs
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tgdrive/teldrive/internal/config"
+	"github.com/tgdrive/teldrive/internal/events"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"go.uber.org/zap"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testJobId = "0198a0e4-6b8e-7c3a-9d1e-1f2a3b4c5d6e"
+
+// staleCutoff matches the heartbeat time running jobs are taken over before.
+type staleCutoff struct{ staleAfter time.Duration }
+
+func (c staleCutoff) Match(v driver.Value) bool {
+	at, ok := v.(time.Time)
+	return ok && time.Since(at.Add(c.staleAfter)).Abs() < time.Minute
+}
+
+func newTestManager(t *testing.T) (*Manager, sqlmock.Sqlmock) {
+	t.Helper()
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+
+	// Events are only queued, the recorder doesn't write them.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cnf := &config.ServerCmdConfig{Jobs: config.JobsConfig{StaleAfter: 5 * time.Minute, MaxAttempts: 3}}
+	m := NewManager(db, cnf, events.NewRecorder(ctx, db, zap.NewNop()))
+	return m, mock
+}
+
+func jobRows(status string, attempt, baseAttempt int) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "user_id", "kind", "status", "attempt", "base_attempt"}).
+		AddRow(testJobId, 1, "test", status, attempt, baseAttempt)
+}
+
+func expectClaim(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`UPDATE teldrive.jobs\s+SET status = 'running', attempt = attempt \+ 1`).
+		WithArgs("test", staleCutoff{5 * time.Minute}).WillReturnRows(rows)
+}
+
+func expectFinish(mock sqlmock.Sqlmock, status, errMsg string, attempt int) {
+	mock.ExpectExec(`UPDATE "jobs" SET "error"=\$1,"status"=\$2,"updated_at"=\$3 WHERE id = \$4 AND status = \$5 AND attempt = \$6`).
+		WithArgs(errMsg, status, sqlmock.AnyArg(), testJobId, StatusRunning, attempt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestExhausted(t *testing.T) {
+	tests := []struct {
+		name        string
+		attempt     int
+		baseAttempt int
+		maxAttempts int
+		want        bool
+	}{
+		{name: "First attempt", attempt: 1, maxAttempts: 3},
+		{name: "Last attempt", attempt: 3, maxAttempts: 3},
+		{name: "Past the last attempt", attempt: 4, maxAttempts: 3, want: true},
+		{name: "Counted from the retry", attempt: 5, baseAttempt: 4, maxAttempts: 3},
+		{name: "Past the last attempt after a retry", attempt: 8, baseAttempt: 4, maxAttempts: 3, want: true},
+		{name: "No limit", attempt: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &models.Job{Attempt: tt.attempt, BaseAttempt: tt.baseAttempt}
+			assert.Equal(t, tt.want, exhausted(job, tt.maxAttempts))
+		})
+	}
+}
+
+func TestPollInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		want     time.Duration
+	}{
+		{name: "Configured", interval: time.Minute, want: time.Minute},
+		{name: "Zero", want: defaultPollInterval},
+		{name: "Negative", interval: -time.Second, want: defaultPollInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pollInterval(tt.interval))
+		})
+	}
+}
+
+func TestRunNext(t *testing.T) {
+	tests := []struct {
+		name   string
+		rows   *sqlmock.Rows
+		run    func(ctx context.Context, job *Job) error
+		expect func(mock sqlmock.Sqlmock)
+		want   bool
+		ran    bool
+	}{
+		{
+			name: "No job to claim",
+			rows: sqlmock.NewRows([]string{"id"}),
+		},
+		{
+			name: "Completes a claimed job",
+			rows: jobRows(StatusRunning, 1, 0),
+			run:  func(ctx context.Context, job *Job) error { return nil },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectFinish(mock, StatusCompleted, "", 1)
+			},
+			want: true,
+			ran:  true,
+		},
+		{
+			name: "Fails a job whose run returns an error",
+			rows: jobRows(StatusRunning, 2, 0),
+			run:  func(ctx context.Context, job *Job) error { return errors.New("channel not found") },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectFinish(mock, StatusFailed, "channel not found", 2)
+			},
+			want: true,
+			ran:  true,
+		},
+		{
+			name: "Fails a job whose run panics",
+			rows: jobRows(StatusRunning, 1, 0),
+			run:  func(ctx context.Context, job *Job) error { panic("boom") },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectFinish(mock, StatusFailed, "job panicked: boom", 1)
+			},
+			want: true,
+			ran:  true,
+		},
+		{
+			name: "Fails a job taken over too often",
+			rows: jobRows(StatusRunning, 4, 0),
+			expect: func(mock sqlmock.Sqlmock) {
+				expectFinish(mock, StatusFailed, "gave up after 3 attempts", 4)
+			},
+			want: true,
+		},
+		{
+			name: "Runs a retried job again",
+			rows: jobRows(StatusRunning, 5, 4),
+			run:  func(ctx context.Context, job *Job) error { return nil },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectFinish(mock, StatusCompleted, "", 5)
+			},
+			want: true,
+			ran:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, mock := newTestManager(t)
+			ran := false
+			m.Register(NewKind("test", func(ctx context.Context, job *Job) error {
+				ran = true
+				assert.Equal(t, testJobId, job.ID)
+				return tt.run(ctx, job)
+			}))
+			expectClaim(mock, tt.rows)
+			if tt.expect != nil {
+				tt.expect(mock)
+			}
+
+			assert.Equal(t, tt.want, m.runNext(context.Background()))
+			assert.Equal(t, tt.ran, ran)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRunNextStopped(t *testing.T) {
+	m, mock := newTestManager(t)
+	m.Register(NewKind("test", func(ctx context.Context, job *Job) error {
+		return ErrStopped
+	}))
+	expectClaim(mock, jobRows(StatusRunning, 1, 0))
+
+	// A cancelled or taken over job keeps the status it was given.
+	assert.True(t, m.runNext(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHeartbeat(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		want     error
+	}{
+		{name: "Running attempt", affected: 1},
+		{name: "Cancelled or taken over", affected: 0, want: ErrStopped},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, mock := newTestManager(t)
+			mock.ExpectExec(`UPDATE "jobs" SET "heartbeat_at"=\$1,"updated_at"=\$2 WHERE id = \$3 AND status = \$4 AND attempt = \$5`).
+				WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), testJobId, StatusRunning, 2).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			job := &Job{Job: models.Job{ID: testJobId, Status: StatusRunning, Attempt: 2}, m: m, lastEvent: time.Now()}
+			err := job.Heartbeat()
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name  string
+		rows  *sqlmock.Rows
+		found bool
+		want  error
+	}{
+		{name: "Failed job", rows: jobRows(StatusPending, 4, 4)},
+		{name: "Job in another status", rows: sqlmock.NewRows([]string{"id"}), found: true, want: ErrInvalidStatus},
+		{name: "Missing job", rows: sqlmock.NewRows([]string{"id"}), want: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, mock := newTestManager(t)
+			mock.ExpectQuery(`UPDATE teldrive.jobs SET status = \$1, error = '', base_attempt = attempt`).
+				WithArgs(StatusPending, sqlmock.AnyArg(), testJobId, int64(1), StatusFailed, StatusCancelled).
+				WillReturnRows(tt.rows)
+			if tt.want != nil {
+				rows := sqlmock.NewRows([]string{"id"})
+				if tt.found {
+					rows = jobRows(StatusRunning, 1, 0)
+				}
+				mock.ExpectQuery(`SELECT \* FROM "jobs" WHERE id = \$1 AND user_id = \$2`).
+					WithArgs(testJobId, int64(1)).WillReturnRows(rows)
+			}
+
+			job, err := m.Retry(1, testJobId)
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, StatusPending, job.Status)
+				assert.Equal(t, job.Attempt, job.BaseAttempt)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
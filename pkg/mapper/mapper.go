@@ -6,7 +6,13 @@ import (
 	"github.com/tgdrive/teldrive/pkg/models"
 )
 
-func ToFileOut(file models.File) *api.File {
+// NameDecrypter reveals names stored encrypted below folders with name encryption,
+// sealed with the key keyId.
+type NameDecrypter interface {
+	DecryptName(keyId, name string) (string, error)
+}
+
+func ToFileOut(file models.File, names NameDecrypter) *api.File {
 	res := &api.File{
 		ID:        api.NewOptString(file.ID),
 		Name:      file.Name,
@@ -24,12 +30,64 @@ func ToFileOut(file models.File) *api.File {
 	if file.Category != "" {
 		res.Category = api.NewOptCategory(api.Category(file.Category))
 	}
+	if file.EncryptNames {
+		res.EncryptNames = api.NewOptBool(true)
+	}
+	if file.NameEncrypted && names != nil {
+		if name, err := names.DecryptName(file.NameKeyId, file.Name); err == nil {
+			res.Name = name
+		}
+	}
+	if len(file.Thumbnails) > 0 {
+		res.HasThumbnail = api.NewOptBool(true)
+	}
+	if len(file.Properties) > 0 {
+		var properties api.FileProperties
+		if err := properties.UnmarshalJSON(file.Properties); err == nil && len(properties) > 0 {
+			res.Properties = api.NewOptFileProperties(properties)
+		}
+	}
+	if file.Media != nil {
+		// Files of unsupported formats have an empty row.
+		if media := ToMediaInfoOut(*file.Media); media != (api.MediaInfo{}) {
+			res.Media = api.NewOptMediaInfo(media)
+		}
+	}
+	return res
+}
+
+func ToMediaInfoOut(info models.MediaInfo) api.MediaInfo {
+	res := api.MediaInfo{}
+	if info.TakenAt != nil {
+		res.TakenAt = api.NewOptDateTime(*info.TakenAt)
+	}
+	setOpt(&res.CameraMake, info.CameraMake, api.NewOptString)
+	setOpt(&res.CameraModel, info.CameraModel, api.NewOptString)
+	setOpt(&res.Latitude, info.Latitude, api.NewOptFloat64)
+	setOpt(&res.Longitude, info.Longitude, api.NewOptFloat64)
+	setOpt(&res.Duration, info.Duration, api.NewOptFloat64)
+	setOpt(&res.Width, info.Width, api.NewOptInt)
+	setOpt(&res.Height, info.Height, api.NewOptInt)
+	setOpt(&res.VideoCodec, info.VideoCodec, api.NewOptString)
+	setOpt(&res.AudioCodec, info.AudioCodec, api.NewOptString)
+	setOpt(&res.Title, info.Title, api.NewOptString)
+	setOpt(&res.Artist, info.Artist, api.NewOptString)
+	setOpt(&res.Album, info.Album, api.NewOptString)
+	setOpt(&res.Year, info.Year, api.NewOptInt)
+	setOpt(&res.Track, info.Track, api.NewOptInt)
+	setOpt(&res.Genre, info.Genre, api.NewOptString)
 	return res
 }
 
+func setOpt[T, O any](dst *O, value *T, wrap func(T) O) {
+	if value != nil {
+		*dst = wrap(*value)
+	}
+}
+
 func ToUploadOut(parts []models.Upload) []api.UploadPart {
 	return utils.Map(parts, func(part models.Upload) api.UploadPart {
-		return api.UploadPart{
+		res := api.UploadPart{
 			Name:      part.Name,
 			PartId:    part.PartId,
 			ChannelId: part.ChannelId,
@@ -37,6 +95,11 @@ func ToUploadOut(parts []models.Upload) []api.UploadPart {
 			Size:      part.Size,
 			Encrypted: part.Encrypted,
 			Salt:      api.NewOptString(part.Salt),
+			KeyId:     api.NewOptString(part.KeyId),
+		}
+		if part.Format != "" {
+			res.Format = api.NewOptEncryptionFormat(api.EncryptionFormat(part.Format))
 		}
+		return res
 	})
 }
@@ -1,8 +1,10 @@
 package models
 
 type Channel struct {
-	ChannelId   int64  `gorm:"type:bigint;primaryKey"`
-	ChannelName string `gorm:"type:text"`
-	UserId      int64  `gorm:"type:bigint;"`
-	Selected    bool   `gorm:"type:boolean;"`
+	ChannelId    int64  `gorm:"type:bigint;primaryKey"`
+	ChannelName  string `gorm:"type:text"`
+	UserId       int64  `gorm:"type:bigint;"`
+	Selected     bool   `gorm:"type:boolean;"`
+	MessageCount int64  `gorm:"type:bigint;default:0"`
+	RolledOver   bool   `gorm:"type:boolean;default:false"`
 }
@@ -20,4 +20,8 @@ type Source struct {
 	Name         string `json:"name,omitempty"`
 	ParentID     string `json:"parentId,omitempty"`
 	DestParentID string `json:"destParentId,omitempty"`
+	// Status, Done and Total describe the progress of background jobs.
+	Status string `json:"status,omitempty"`
+	Done   int64  `json:"done,omitempty"`
+	Total  int64  `json:"total,omitempty"`
 }
@@ -8,18 +8,37 @@ import (
 )
 
 type File struct {
-	ID        string                        `gorm:"type:uuid;primaryKey;default:uuid7()"`
-	Name      string                        `gorm:"type:text;not null"`
-	Type      string                        `gorm:"type:text;not null"`
-	MimeType  string                        `gorm:"type:text;not null"`
-	Size      *int64                        `gorm:"type:bigint"`
-	Category  string                        `gorm:"type:text"`
-	Encrypted *bool                         `gorm:"default:false"`
-	UserId    int64                         `gorm:"type:bigint;not null"`
-	Status    string                        `gorm:"type:text"`
-	ParentId  *string                       `gorm:"type:uuid;index"`
-	Parts     datatypes.JSONSlice[api.Part] `gorm:"type:jsonb"`
-	ChannelId *int64                        `gorm:"type:bigint"`
-	CreatedAt time.Time                     `gorm:"default:timezone('utc'::text, now())"`
-	UpdatedAt time.Time                     `gorm:"autoUpdateTime:false"`
+	ID            string                         `gorm:"type:uuid;primaryKey;default:uuid7()"`
+	Name          string                         `gorm:"type:text;not null"`
+	Type          string                         `gorm:"type:text;not null"`
+	MimeType      string                         `gorm:"type:text;not null"`
+	Size          *int64                         `gorm:"type:bigint"`
+	Category      string                         `gorm:"type:text"`
+	Encrypted     *bool                          `gorm:"default:false"`
+	NameEncrypted bool                           `gorm:"default:false"`
+	NameKeyId     string                         `gorm:"type:text;default:''"`
+	EncryptNames  bool                           `gorm:"default:false"`
+	ChildrenKeyId string                         `gorm:"type:text;default:''"`
+	UserId        int64                          `gorm:"type:bigint;not null"`
+	Status        string                         `gorm:"type:text"`
+	ParentId      *string                        `gorm:"type:uuid;index"`
+	Parts         datatypes.JSONSlice[api.Part]  `gorm:"type:jsonb"`
+	ChannelId     *int64                         `gorm:"type:bigint"`
+	Properties    datatypes.JSON                 `gorm:"type:jsonb"`
+	Thumbnails    datatypes.JSONSlice[Thumbnail] `gorm:"type:jsonb"`
+	Media         *MediaInfo                     `gorm:"foreignKey:FileId"`
+	CreatedAt     time.Time                      `gorm:"default:timezone('utc'::text, now())"`
+	UpdatedAt     time.Time                      `gorm:"autoUpdateTime:false"`
+}
+
+// Thumbnail is a preview of an image stored as a message in the channel. Nil
+// thumbnails on a file mean they were not generated yet, an empty list that the file
+// has none.
+type Thumbnail struct {
+	Size      int    `json:"size"`
+	ID        int    `json:"id"`
+	ChannelId int64  `json:"channelId"`
+	Length    int64  `json:"length"`
+	Salt      string `json:"salt,omitempty"`
+	KeyId     string `json:"keyId,omitempty"`
 }
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+// FileContent is the text extracted from a document for the content search. Documents
+// the text can't be read from get an empty content, so they are not read again.
+type FileContent struct {
+	FileId    string    `gorm:"type:uuid;primaryKey"`
+	Content   string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"default:timezone('utc'::text, now())"`
+}
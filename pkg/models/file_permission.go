@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+)
+
+type FilePermission struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:uuid7()"`
+	FileId    string    `gorm:"type:uuid;not null"`
+	OwnerId   int64     `gorm:"type:bigint;not null"`
+	UserId    int64     `gorm:"type:bigint;not null"`
+	Role      string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"default:timezone('utc'::text, now())"`
+	UpdatedAt time.Time `gorm:"default:timezone('utc'::text, now())"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+type FileStar struct {
+	UserId    int64     `gorm:"type:bigint;primaryKey"`
+	FileId    string    `gorm:"type:uuid;primaryKey"`
+	CreatedAt time.Time `gorm:"default:timezone('utc'::text, now())"`
+}
+
+type FileOpen struct {
+	UserId   int64     `gorm:"type:bigint;primaryKey"`
+	FileId   string    `gorm:"type:uuid;primaryKey"`
+	OpenedAt time.Time `gorm:"default:timezone('utc'::text, now())"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type Job struct {
+	ID          string         `gorm:"type:uuid;primaryKey;default:uuid7()"`
+	UserId      int64          `gorm:"type:bigint;not null"`
+	Kind        string         `gorm:"type:text;not null"`
+	Status      string         `gorm:"type:text;not null"`
+	Params      datatypes.JSON `gorm:"type:jsonb"`
+	State       datatypes.JSON `gorm:"type:jsonb"`
+	Total       int64          `gorm:"type:bigint"`
+	Done        int64          `gorm:"type:bigint"`
+	Error       string         `gorm:"type:text"`
+	Attempt     int            `gorm:"type:integer"`
+	BaseAttempt int            `gorm:"type:integer"`
+	HeartbeatAt *time.Time     `gorm:"type:timestamp"`
+	CreatedAt   time.Time      `gorm:"default:timezone('utc'::text, now())"`
+	UpdatedAt   time.Time      `gorm:"default:timezone('utc'::text, now())"`
+}
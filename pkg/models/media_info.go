@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// MediaInfo is the metadata read from the headers of a photo, video or audio file.
+// Files whose format is not supported get a row without metadata, so they are
+// not read again. The owner and the category are copied from the file and TimelineAt
+// holds the capture time, or the update time of files without one, so the timeline and
+// the music library are served by indexes on this table.
+type MediaInfo struct {
+	FileId      string     `gorm:"type:uuid;primaryKey"`
+	UserId      int64      `gorm:"type:bigint"`
+	Category    string     `gorm:"type:text"`
+	TimelineAt  time.Time  `gorm:"type:timestamp"`
+	TakenAt     *time.Time `gorm:"type:timestamp"`
+	CameraMake  *string    `gorm:"type:text"`
+	CameraModel *string    `gorm:"type:text"`
+	Latitude    *float64   `gorm:"type:double precision"`
+	Longitude   *float64   `gorm:"type:double precision"`
+	Duration    *float64   `gorm:"type:double precision"`
+	Width       *int       `gorm:"type:integer"`
+	Height      *int       `gorm:"type:integer"`
+	VideoCodec  *string    `gorm:"type:text"`
+	AudioCodec  *string    `gorm:"type:text"`
+	Title       *string    `gorm:"type:text"`
+	Artist      *string    `gorm:"type:text"`
+	Album       *string    `gorm:"type:text"`
+	Year        *int       `gorm:"type:integer"`
+	Track       *int       `gorm:"type:integer"`
+	Genre       *string    `gorm:"type:text"`
+	CreatedAt   time.Time  `gorm:"default:timezone('utc'::text, now())"`
+}
+
+func (MediaInfo) TableName() string {
+	return "teldrive.media_info"
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"gorm.io/datatypes"
+)
+
+type SavedSearch struct {
+	ID        string                               `gorm:"type:uuid;primaryKey;default:uuid7()"`
+	UserId    int64                                `gorm:"type:bigint;not null"`
+	Name      string                               `gorm:"type:text;not null"`
+	Query     datatypes.JSONType[*api.SearchQuery] `gorm:"type:jsonb;not null"`
+	CreatedAt time.Time                            `gorm:"default:timezone('utc'::text, now())"`
+	UpdatedAt time.Time                            `gorm:"default:timezone('utc'::text, now())"`
+}
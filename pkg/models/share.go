@@ -2,14 +2,42 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/datatypes"
 )
 
 type FileShare struct {
-	ID        string     `gorm:"type:uuid;default:uuid_generate_v4();primary_key"`
-	FileId    string     `gorm:"type:uuid;not null"`
-	Password  *string    `gorm:"type:text"`
-	ExpiresAt *time.Time `gorm:"type:timestamp"`
-	CreatedAt time.Time  `gorm:"type:timestamp;not null;default:current_timestamp"`
-	UpdatedAt time.Time  `gorm:"type:timestamp;not null;default:current_timestamp"`
-	UserId    int64      `gorm:"type:bigint;not null"`
+	ID                string                      `gorm:"type:uuid;default:uuid_generate_v4();primary_key"`
+	FileId            *string                     `gorm:"type:uuid"`
+	Password          *string                     `gorm:"type:text"`
+	ExpiresAt         *time.Time                  `gorm:"type:timestamp"`
+	MaxDownloads      *int64                      `gorm:"type:bigint"`
+	MaxIps            *int64                      `gorm:"type:bigint"`
+	Downloads         int64                       `gorm:"type:bigint;default:0"`
+	DisabledAt        *time.Time                  `gorm:"type:timestamp"`
+	DisabledReason    *string                     `gorm:"type:text"`
+	Mode              string                      `gorm:"type:text;default:download"`
+	AllowedExtensions datatypes.JSONSlice[string] `gorm:"type:jsonb"`
+	MaxFileSize       *int64                      `gorm:"type:bigint"`
+	MaxFiles          *int64                      `gorm:"type:bigint"`
+	UploadedFiles     int64                       `gorm:"type:bigint;default:0"`
+	CreatedAt         time.Time                   `gorm:"type:timestamp;not null;default:current_timestamp"`
+	UpdatedAt         time.Time                   `gorm:"type:timestamp;not null;default:current_timestamp"`
+	UserId            int64                       `gorm:"type:bigint;not null"`
+	Title             *string                     `gorm:"type:text"`
+}
+
+type FileShareItem struct {
+	ShareId string `gorm:"type:uuid;primaryKey"`
+	FileId  string `gorm:"type:uuid;primaryKey"`
+}
+
+type ShareAccess struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:uuid7()"`
+	ShareId   string    `gorm:"type:uuid;not null"`
+	Type      string    `gorm:"type:text;not null"`
+	IP        string    `gorm:"type:text;not null"`
+	UserAgent string    `gorm:"type:text"`
+	Bytes     int64     `gorm:"type:bigint"`
+	CreatedAt time.Time `gorm:"default:timezone('utc'::text, now())"`
 }
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type StoragePolicy struct {
+	UserId            int64                      `gorm:"type:bigint;primaryKey"`
+	Mode              string                     `gorm:"type:text;not null"`
+	ChannelIds        datatypes.JSONSlice[int64] `gorm:"type:jsonb"`
+	RolloverThreshold int64                      `gorm:"type:bigint"`
+	UpdatedAt         time.Time                  `gorm:"default:timezone('utc'::text, now())"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+type Tag struct {
+	ID        string    `gorm:"type:uuid;primaryKey;default:uuid7()"`
+	UserId    int64     `gorm:"type:bigint;not null"`
+	Name      string    `gorm:"type:text;not null"`
+	Color     *string   `gorm:"type:text"`
+	CreatedAt time.Time `gorm:"default:timezone('utc'::text, now())"`
+}
+
+type FileTag struct {
+	FileId string `gorm:"type:uuid;primaryKey"`
+	TagId  string `gorm:"type:uuid;primaryKey"`
+}
@@ -12,6 +12,8 @@ type Upload struct {
 	PartId    int       `gorm:"type:integer"`
 	Encrypted bool      `gorm:"default:false"`
 	Salt      string    `gorm:"type:text"`
+	KeyId     string    `gorm:"type:text"`
+	Format    string    `gorm:"type:text"`
 	ChannelId int64     `gorm:"type:bigint"`
 	Size      int64     `gorm:"type:bigint"`
 	CreatedAt time.Time `gorm:"default:timezone('utc'::text, now())"`
@@ -0,0 +1,13 @@
+package models
+
+import (
+	"time"
+)
+
+type UserKey struct {
+	UserId     int64     `gorm:"type:bigint;primaryKey"`
+	WrappedKey string    `gorm:"type:text;not null"`
+	Salt       string    `gorm:"type:text;not null"`
+	CreatedAt  time.Time `gorm:"default:timezone('utc'::text, now())"`
+	UpdatedAt  time.Time `gorm:"default:timezone('utc'::text, now())"`
+}
@@ -3,9 +3,11 @@ package services
 import (
 	"context"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/google/uuid"
 	"github.com/gotd/td/telegram"
 	"github.com/ogen-go/ogen/ogenerrors"
 	"go.uber.org/zap"
@@ -17,21 +19,37 @@ import (
 	"github.com/tgdrive/teldrive/internal/config"
 	"github.com/tgdrive/teldrive/internal/events"
 	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/ratelimit"
 	"github.com/tgdrive/teldrive/internal/tgc"
 	"github.com/tgdrive/teldrive/internal/utils"
 	"github.com/tgdrive/teldrive/internal/version"
+	"github.com/tgdrive/teldrive/pkg/jobs"
 	"github.com/tgdrive/teldrive/pkg/models"
 	"gorm.io/gorm"
 )
 
 type apiService struct {
-	db          *gorm.DB
-	cnf         *config.ServerCmdConfig
-	cache       cache.Cacher
+	db    *gorm.DB
+	cnf   *config.ServerCmdConfig
+	cache cache.Cacher
+	// userKeys holds unlocked personal keys, in process memory so they never reach a
+	// shared cache.
+	userKeys    cache.Cacher
 	tgdb        *gorm.DB
 	worker      *tgc.BotWorker
 	middlewares []telegram.Middleware
 	events      *events.Recorder
+	jobs        *jobs.Manager
+	nameCiphers sync.Map
+	shareAuth   *ratelimit.Limiter
+	loginAuth   *ratelimit.Limiter
+	keyAuth     *ratelimit.Limiter
+	// thumbnailCursor is the last file id the thumbnail backfill went through.
+	thumbnailCursor string
+	// mediaCursor is the last file id the media info backfill went through.
+	mediaCursor string
+	// contentCursor is the last file id the content index backfill went through.
+	contentCursor string
 }
 
 func (a *apiService) VersionVersion(ctx context.Context) (*api.ApiVersion, error) {
@@ -45,7 +63,7 @@ func (a *apiService) EventsGetEvents(ctx context.Context) ([]api.Event, error) {
 	a.db.Model(&models.Event{}).Where("created_at > ?", time.Now().UTC().Add(-10*time.Minute).Format(time.RFC3339)).
 		Where("user_id = ?", userId).Order("created_at desc").Find(&res)
 	return utils.Map(res, func(item models.Event) api.Event {
-		return api.Event{
+		event := api.Event{
 			ID:        item.ID,
 			Type:      item.Type,
 			CreatedAt: item.CreatedAt,
@@ -57,6 +75,12 @@ func (a *apiService) EventsGetEvents(ctx context.Context) ([]api.Event, error) {
 				DestParentId: api.NewOptString(item.Source.Data().DestParentID),
 			},
 		}
+		if event.Source.Type == api.SourceTypeJob {
+			event.Source.Status = api.NewOptString(item.Source.Data().Status)
+			event.Source.Done = api.NewOptInt64(item.Source.Data().Done)
+			event.Source.Total = api.NewOptInt64(item.Source.Data().Total)
+		}
+		return event
 	}), nil
 }
 
@@ -89,18 +113,27 @@ func (a *apiService) NewError(ctx context.Context, err error) *api.ErrorStatusCo
 
 func NewApiService(db *gorm.DB,
 	cnf *config.ServerCmdConfig,
-	cache cache.Cacher,
+	cacher cache.Cacher,
 	tgdb *gorm.DB,
 	worker *tgc.BotWorker,
 	events *events.Recorder) *apiService {
 	return &apiService{
 		db:          db,
 		cnf:         cnf,
-		cache:       cache,
+		cache:       cacher,
+		userKeys:    cache.NewMemoryCache(userKeysCacheSize),
 		tgdb:        tgdb,
 		worker:      worker,
 		middlewares: tgc.NewMiddleware(&cnf.TG, tgc.WithFloodWait(), tgc.WithRateLimit()),
 		events:      events,
+		jobs:        jobs.NewManager(db, cnf, events),
+		shareAuth:   ratelimit.New(cacher, "shares", ratelimit.DefaultPolicy),
+		loginAuth:   ratelimit.New(cacher, "login", ratelimit.DefaultPolicy),
+		keyAuth:     ratelimit.New(cacher, "keys", ratelimit.DefaultPolicy),
+
+		thumbnailCursor: uuid.Nil.String(),
+		mediaCursor:     uuid.Nil.String(),
+		contentCursor:   uuid.Nil.String(),
 	}
 }
 
@@ -135,6 +168,14 @@ func (m *extendedMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		args := route.Args()
 		m.srv.SharesStream(w, r, args[0], args[1])
 		return
+	case api.FilesThumbnailOperation:
+		args := route.Args()
+		m.srv.FilesThumbnail(w, r, args[0])
+		return
+	case api.SharesZipOperation:
+		args := route.Args()
+		m.srv.SharesZip(w, r, args[0])
+		return
 	}
 	m.next.ServeHTTP(w, r)
 }
@@ -40,7 +40,15 @@ var authCookieName = "access_token"
 
 func (a *apiService) AuthLogin(ctx context.Context, session *api.SessionCreate) (*api.AuthLoginNoContent, error) {
 
+	// Login is limited per client only, a per-account limit would let anyone lock
+	// a user out of their own account.
+	keys := []string{ipKey(requestIP(ctx))}
+	if err := checkAttempts(ctx, a.loginAuth, scopeLogin, keys...); err != nil {
+		return nil, err
+	}
+
 	if !checkUserIsAllowed(a.cnf.JWT.AllowedUsers, session.UserName) {
+		failedAttempt(ctx, a.loginAuth, scopeLogin, keys...)
 		return nil, &apiError{code: http.StatusForbidden, err: errors.New("user not allowed")}
 	}
 
@@ -94,7 +102,12 @@ func (a *apiService) AuthLogin(ctx context.Context, session *api.SessionCreate)
 	if err != nil {
 		return nil, &apiError{err: err}
 	}
-	client, _ := tgc.AuthClient(ctx, &a.cnf.TG, session.Session, a.middlewares...)
+	client, err := tgc.AuthClient(ctx, &a.cnf.TG, session.Session, a.middlewares...)
+	if err != nil {
+		// The session string doesn't decode.
+		failedAttempt(ctx, a.loginAuth, scopeLogin, keys...)
+		return nil, &apiError{err: errors.New("invalid session"), code: http.StatusUnauthorized}
+	}
 
 	var auth *tg.Authorization
 
@@ -112,9 +125,16 @@ func (a *apiService) AuthLogin(ctx context.Context, session *api.SessionCreate)
 		return nil
 	})
 
-	if err != nil {
+	// Only a session Telegram rejects counts as a failed attempt, other errors are not
+	// the client's doing.
+	if err != nil && !tgerr.IsCode(err, http.StatusUnauthorized) {
 		return nil, &apiError{err: err}
 	}
+	if err != nil || auth == nil {
+		failedAttempt(ctx, a.loginAuth, scopeLogin, keys...)
+		return nil, &apiError{err: errors.New("invalid session"), code: http.StatusUnauthorized}
+	}
+	a.loginAuth.Reset(keys[0])
 
 	if err := a.db.Create(&models.Session{UserId: session.UserId, Hash: hexToken,
 		Session: session.Session, SessionDate: auth.DateCreated}).Error; err != nil {
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/cache"
+	"github.com/tgdrive/teldrive/internal/database"
+	"github.com/tgdrive/teldrive/internal/events"
+	"github.com/tgdrive/teldrive/pkg/mapper"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/gorm"
+)
+
+var errBatchRolledBack = errors.New("batch rolled back")
+
+// batchEvent is an event of a batch operation, recorded once the batch is committed.
+type batchEvent struct {
+	op     events.EventType
+	source *models.Source
+}
+
+// FilesBatch runs the operations on the user's files in one transaction, each in a
+// savepoint so a failed operation doesn't undo the others unless the batch is atomic.
+func (a *apiService) FilesBatch(ctx context.Context, req *api.FileBatch) (*api.FileBatchResult, error) {
+	userId := auth.GetUser(ctx)
+	atomic := req.Atomic.Value
+
+	results := make([]api.FileBatchItemResult, len(req.Operations))
+	var copies []int
+	for i, op := range req.Operations {
+		results[i] = api.FileBatchItemResult{Index: i, ID: op.ID, Status: api.FileBatchItemResultStatusOk}
+		if op.Op == api.FileBatchOperationOpCopy {
+			if atomic {
+				return nil, &apiError{err: errors.New("copies can't be part of an atomic batch"), code: http.StatusBadRequest}
+			}
+			copies = append(copies, i)
+		}
+	}
+
+	var recorded []batchEvent
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		for i, op := range req.Operations {
+			if op.Op == api.FileBatchOperationOpCopy {
+				continue
+			}
+			var opEvents []batchEvent
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				var err error
+				opEvents, err = a.batchOperation(tx, userId, &op)
+				return err
+			})
+			if err != nil {
+				results[i].Status = api.FileBatchItemResultStatusFailed
+				results[i].Error = api.NewOptString(err.Error())
+				if atomic {
+					return errBatchRolledBack
+				}
+				continue
+			}
+			recorded = append(recorded, opEvents...)
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchRolledBack) {
+		for i := range results {
+			if results[i].Status == api.FileBatchItemResultStatusOk {
+				results[i].Status = api.FileBatchItemResultStatusRolledBack
+			}
+		}
+		return &api.FileBatchResult{Results: results}, nil
+	}
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	for _, event := range recorded {
+		if event.op == events.OpUpdate {
+			a.cache.Delete(cache.Key("files", event.source.ID))
+		}
+		a.events.Record(event.op, userId, event.source)
+	}
+
+	for _, i := range copies {
+		op := req.Operations[i]
+		file, err := a.batchCopy(ctx, userId, &op)
+		if err != nil {
+			results[i].Status = api.FileBatchItemResultStatusFailed
+			results[i].Error = api.NewOptString(err.Error())
+			continue
+		}
+		results[i].File = api.NewOptFile(*mapper.ToFileOut(*file, a.fileNames(userId)))
+	}
+	return &api.FileBatchResult{Results: results}, nil
+}
+
+// batchOperation runs a database operation of a batch and returns its events.
+func (a *apiService) batchOperation(tx *gorm.DB, userId int64, op *api.FileBatchOperation) ([]batchEvent, error) {
+	var file models.File
+	if err := tx.Where("id = ?", op.ID).Where("user_id = ?", userId).First(&file).Error; err != nil {
+		if database.IsRecordNotFoundErr(err) {
+			return nil, ErrFileNotFound
+		}
+		return nil, err
+	}
+
+	switch op.Op {
+	case api.FileBatchOperationOpMove:
+		parentId, err := a.batchDestination(tx, userId, op.Destination.Value)
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Model(&models.File{}).Where("id = ?", file.ID).Update("parent_id", parentId).Error; err != nil {
+			return nil, err
+		}
+		if err := a.renameForParent(tx, userId, []string{file.ID}, parentId); err != nil {
+			return nil, err
+		}
+		source := fileSource(&file)
+		source.DestParentID = parentId
+		return []batchEvent{{op: events.OpMove, source: source}}, nil
+
+	case api.FileBatchOperationOpRename:
+		plain := strings.TrimSpace(op.Name.Value)
+		if plain == "" {
+			return nil, errors.New("name is required")
+		}
+		name, err := a.nameForParent(tx, userId, file.ParentId, plain)
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Model(&models.File{}).Where("id = ?", file.ID).Updates(name.columns()).Error; err != nil {
+			return nil, err
+		}
+		file.Name, file.NameEncrypted, file.NameKeyId = name.Name, name.Encrypted, name.KeyId
+		return []batchEvent{{op: events.OpUpdate, source: fileSource(&file)}}, nil
+
+	case api.FileBatchOperationOpDelete:
+		if err := tx.Exec("call teldrive.delete_files_bulk($1 , $2)", []string{file.ID}, userId).Error; err != nil {
+			return nil, err
+		}
+		return []batchEvent{{op: events.OpDelete, source: fileSource(&file)}}, nil
+
+	case api.FileBatchOperationOpTag:
+		if err := updateFileTags(tx, userId, []string{file.ID}, op.AddTags, op.RemoveTags); err != nil {
+			return nil, err
+		}
+		return []batchEvent{{op: events.OpUpdate, source: fileSource(&file)}}, nil
+
+	case api.FileBatchOperationOpShare:
+		if err := createFileShare(tx, userId, file.ID, &op.Share.Value); err != nil {
+			return nil, err
+		}
+		return []batchEvent{{op: events.OpShare, source: fileSource(&file)}}, nil
+	}
+	return nil, errors.New("unsupported operation")
+}
+
+func (a *apiService) batchCopy(ctx context.Context, userId int64, op *api.FileBatchOperation) (*models.File, error) {
+	var owned int64
+	if err := a.db.Model(&models.File{}).Where("id = ?", op.ID).Where("user_id = ?", userId).
+		Where("type = ?", "file").Count(&owned).Error; err != nil {
+		return nil, err
+	}
+	if owned == 0 {
+		return nil, ErrFileNotFound
+	}
+	if op.Destination.Value == "" {
+		return nil, errors.New("destination is required")
+	}
+	return a.copyFile(ctx, userId, op.ID, op.Destination.Value, op.Name, api.OptDateTime{})
+}
+
+// batchDestination resolves a destination folder given by id or path.
+func (a *apiService) batchDestination(tx *gorm.DB, userId int64, destination string) (string, error) {
+	if destination == "" {
+		return "", errors.New("destination is required")
+	}
+	if !isUUID(destination) {
+		folder, err := a.getFileFromPath(destination, userId)
+		if err != nil {
+			return "", err
+		}
+		return folder.ID, nil
+	}
+	var folders int64
+	if err := tx.Model(&models.File{}).Where("id = ?", destination).Where("user_id = ?", userId).
+		Where("type = ?", "folder").Count(&folders).Error; err != nil {
+		return "", err
+	}
+	if folders == 0 {
+		return "", errors.New("destination folder not found")
+	}
+	return destination, nil
+}
@@ -0,0 +1,318 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gotd/td/telegram/query"
+	"github.com/gotd/td/telegram/query/messages"
+	"github.com/gotd/td/tg"
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/category"
+	"github.com/tgdrive/teldrive/internal/tgc"
+	"github.com/tgdrive/teldrive/pkg/jobs"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/datatypes"
+)
+
+// splitPartName matches the numbered pieces of split archives, like movie.mkv.001.
+var splitPartName = regexp.MustCompile(`^(.+)\.(\d{3})$`)
+
+// channelImportParams are the parameters of a channel import job.
+type channelImportParams struct {
+	ChannelId int64  `json:"channelId"`
+	Path      string `json:"path"`
+}
+
+// importDocument is a document message of an imported channel.
+type importDocument struct {
+	ID       int
+	Name     string
+	Size     int64
+	MimeType string
+	Date     time.Time
+}
+
+// EnqueueChannelImport queues the import of the documents of a user's channel into the
+// folder at path.
+func EnqueueChannelImport(m *jobs.Manager, userId, channelId int64, path string) (*models.Job, error) {
+	if path == "" {
+		path = "/"
+	}
+	return m.Enqueue(userId, kindChannelImport, channelImportParams{ChannelId: channelId, Path: path}, 0)
+}
+
+func (a *apiService) UsersCreateChannelImport(ctx context.Context, req *api.ChannelImportCreate) (*api.Job, error) {
+	userId := auth.GetUser(ctx)
+
+	var count int64
+	if err := a.db.Model(&models.Channel{}).Where("channel_id = ?", req.ChannelId).
+		Where("user_id = ?", userId).Count(&count).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if count == 0 {
+		return nil, &apiError{err: errors.New("channel not found"), code: http.StatusNotFound}
+	}
+	job, err := EnqueueChannelImport(a.jobs, userId, req.ChannelId, req.Path.Value)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	return toJobOut(job), nil
+}
+
+// runChannelImport creates files for the document messages of a channel that no file
+// references yet. Files created by an interrupted run reference their messages, so a
+// resumed run goes on with the rest.
+func (a *apiService) runChannelImport(ctx context.Context, job *jobs.Job) error {
+	var params channelImportParams
+	if err := job.Params(&params); err != nil {
+		return err
+	}
+
+	var count int64
+	if err := a.db.Model(&models.Channel{}).Where("channel_id = ?", params.ChannelId).
+		Where("user_id = ?", job.UserId).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.New("channel not found")
+	}
+	var sessions []models.Session
+	if err := a.db.Where("user_id = ?", job.UserId).Order("created_at DESC").Limit(1).Find(&sessions).Error; err != nil {
+		return err
+	}
+	if len(sessions) == 0 {
+		return errors.New("no active session for user")
+	}
+	folder, err := a.createDirectories(job.UserId, params.Path)
+	if err != nil {
+		return err
+	}
+	parentId := folder.ID
+
+	client, err := tgc.AuthClient(ctx, &a.cnf.TG, sessions[0].Session, a.middlewares...)
+	if err != nil {
+		return err
+	}
+	return tgc.RunWithAuth(ctx, client, "", func(ctx context.Context) error {
+		documents, err := channelDocuments(ctx, client.API(), params.ChannelId, job)
+		if err != nil {
+			return err
+		}
+		referenced, err := a.referencedMessages(params.ChannelId)
+		if err != nil {
+			return err
+		}
+		documents = slices.DeleteFunc(documents, func(doc importDocument) bool {
+			return referenced[doc.ID]
+		})
+		if err := job.SetTotal(int64(len(documents))); err != nil {
+			return err
+		}
+
+		done := int64(0)
+		for _, group := range groupSplitDocuments(documents) {
+			if err := a.importFile(job.UserId, parentId, params.ChannelId, group); err != nil {
+				return fmt.Errorf("message %d: %w", group[0].ID, err)
+			}
+			done += int64(len(group))
+			if err := job.Progress(done, struct{}{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// channelDocuments loads the document messages of a channel, oldest first.
+func channelDocuments(ctx context.Context, client *tg.Client, channelId int64, job *jobs.Job) ([]importDocument, error) {
+	channel, err := tgc.GetChannelById(ctx, client, channelId)
+	if err != nil {
+		return nil, err
+	}
+	iter := messages.NewIterator(query.NewQuery(client).Messages().GetHistory(&tg.InputPeerChannel{
+		ChannelID:  channel.ChannelID,
+		AccessHash: channel.AccessHash,
+	}), 100)
+
+	documents := []importDocument{}
+	for loaded := 1; iter.Next(ctx); loaded++ {
+		// Loading a large channel takes a while, keep the job from going stale.
+		if loaded%1000 == 0 {
+			if err := job.Heartbeat(); err != nil {
+				return nil, err
+			}
+		}
+		msg := iter.Value()
+		doc, ok := msg.Document()
+		if !ok {
+			continue
+		}
+		document := importDocument{
+			ID:       msg.Msg.GetID(),
+			Size:     doc.Size,
+			MimeType: doc.MimeType,
+			Date:     time.Unix(int64(msg.Msg.GetDate()), 0).UTC(),
+		}
+		for _, attr := range doc.Attributes {
+			if filename, ok := attr.(*tg.DocumentAttributeFilename); ok {
+				document.Name = filename.FileName
+			}
+		}
+		if document.Name == "" {
+			document.Name = fmt.Sprintf("file_%d", document.ID)
+			if exts, _ := mime.ExtensionsByType(doc.MimeType); len(exts) > 0 {
+				document.Name += exts[0]
+			}
+		}
+		documents = append(documents, document)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(documents, func(a, b importDocument) int { return cmp.Compare(a.ID, b.ID) })
+	return documents, nil
+}
+
+// referencedMessages returns the ids of the channel messages files, their thumbnails or
+// uploads refer to.
+func (a *apiService) referencedMessages(channelId int64) (map[int]bool, error) {
+	channel := fmt.Sprintf(`[{"channelId": %d}]`, channelId)
+	var files []models.File
+	if err := a.db.Select("channel_id", "parts", "thumbnails").Where("type = ?", "file").
+		Where("(channel_id = ? OR parts @> ?::jsonb OR thumbnails @> ?::jsonb)", channelId, channel, channel).
+		Find(&files).Error; err != nil {
+		return nil, err
+	}
+	referenced := make(map[int]bool)
+	for _, file := range files {
+		for _, part := range file.Parts {
+			if file.ChannelId != nil && part.ChannelId.Or(*file.ChannelId) == channelId {
+				referenced[part.ID] = true
+			}
+		}
+		for _, thumb := range file.Thumbnails {
+			if thumb.ChannelId == channelId {
+				referenced[thumb.ID] = true
+			}
+		}
+	}
+	var uploads []int
+	if err := a.db.Model(&models.Upload{}).Where("channel_id = ?", channelId).Pluck("part_id", &uploads).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range uploads {
+		referenced[id] = true
+	}
+	return referenced, nil
+}
+
+// groupSplitDocuments groups the pieces of split archives into one entry each, ordered by
+// piece number, and leaves other documents on their own. A split archive is only grouped
+// when its pieces are numbered from 001 without gaps or repeats.
+func groupSplitDocuments(documents []importDocument) [][]importDocument {
+	pieces := make(map[string][]importDocument)
+	for _, doc := range documents {
+		if m := splitPartName.FindStringSubmatch(doc.Name); m != nil {
+			pieces[m[1]] = append(pieces[m[1]], doc)
+		}
+	}
+	grouped := make(map[int]bool)
+	groups := make(map[int][]importDocument)
+	for _, docs := range pieces {
+		if len(docs) < 2 {
+			continue
+		}
+		slices.SortFunc(docs, func(a, b importDocument) int {
+			return cmp.Compare(a.Name, b.Name)
+		})
+		complete := true
+		for i, doc := range docs {
+			n, _ := strconv.Atoi(doc.Name[len(doc.Name)-3:])
+			if n != i+1 {
+				complete = false
+				break
+			}
+		}
+		if !complete {
+			continue
+		}
+		for _, doc := range docs {
+			grouped[doc.ID] = true
+		}
+		groups[docs[0].ID] = docs
+	}
+
+	res := [][]importDocument{}
+	for _, doc := range documents {
+		if group, ok := groups[doc.ID]; ok {
+			res = append(res, group)
+		} else if !grouped[doc.ID] {
+			res = append(res, []importDocument{doc})
+		}
+	}
+	return res
+}
+
+// importFile creates the file for a document or the pieces of a split archive. A name
+// taken in the folder gets the message id appended rather than replacing the file.
+func (a *apiService) importFile(userId int64, parentId string, channelId int64, group []importDocument) error {
+	first := group[0]
+	plain, mimeType := first.Name, first.MimeType
+	size := int64(0)
+	parts := make([]api.Part, len(group))
+	for i, doc := range group {
+		size += doc.Size
+		parts[i] = api.Part{ID: doc.ID}
+	}
+	if len(group) > 1 {
+		plain = splitPartName.FindStringSubmatch(first.Name)[1]
+		mimeType = ""
+	}
+	if mimeType == "" || mimeType == "application/octet-stream" {
+		if byExt := mime.TypeByExtension(filepath.Ext(plain)); byExt != "" {
+			mimeType = byExt
+		} else {
+			mimeType = "application/octet-stream"
+		}
+	}
+
+	for _, candidate := range []string{plain, fmt.Sprintf("%s (%d)%s",
+		plain[:len(plain)-len(filepath.Ext(plain))], first.ID, filepath.Ext(plain))} {
+		name, err := a.nameForParent(a.db, userId, &parentId, candidate)
+		if err != nil {
+			return err
+		}
+		var ids []string
+		if err := a.db.Raw(`
+		INSERT INTO teldrive.files (
+			name, parent_id, user_id, mime_type, category, parts,
+			size, type, encrypted, updated_at, channel_id, status, name_encrypted, name_key_id
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, 'file', false, ?, ?, 'active', ?, ?)
+		ON CONFLICT (name, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), user_id)
+		WHERE status = 'active'
+		DO NOTHING
+		RETURNING id`,
+			name.Name, parentId, userId, mimeType, string(category.GetCategory(candidate)),
+			datatypes.NewJSONSlice(parts), size, first.Date, channelId, name.Encrypted, name.KeyId,
+		).Scan(&ids).Error; err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			return a.db.Exec("UPDATE teldrive.channels SET message_count = message_count + ? WHERE channel_id = ?",
+				len(parts), channelId).Error
+		}
+	}
+	return fmt.Errorf("%s already exists", plain)
+}
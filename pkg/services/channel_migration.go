@@ -0,0 +1,306 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gotd/td/tg"
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/tgc"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/jobs"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const migrationBatchSize = 50
+
+// channelMigrationParams are the parameters of a channel migration job.
+type channelMigrationParams struct {
+	SourceChannelId      *int64  `json:"sourceChannelId,omitempty"`
+	FolderId             *string `json:"folderId,omitempty"`
+	DestinationChannelId int64   `json:"destinationChannelId"`
+}
+
+// jobCursor is the state of the jobs walking through files in id order.
+type jobCursor struct {
+	LastFileId string `json:"lastFileId,omitempty"`
+}
+
+func (a *apiService) UsersCreateChannelMigration(ctx context.Context, req *api.ChannelMigrationCreate) (*api.ChannelMigration, error) {
+	userId := auth.GetUser(ctx)
+
+	if !req.SourceChannelId.IsSet() && !req.FolderId.IsSet() {
+		return nil, &apiError{err: errors.New("source channel or folder is required"), code: 400}
+	}
+	if req.SourceChannelId.Value == req.DestinationChannelId {
+		return nil, &apiError{err: errors.New("source and destination channels are the same"), code: 400}
+	}
+
+	var count int64
+	if err := a.db.Model(&models.Channel{}).Where("channel_id = ?", req.DestinationChannelId).
+		Where("user_id = ?", userId).Count(&count).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if count == 0 {
+		return nil, &apiError{err: errors.New("destination channel not found"), code: 404}
+	}
+
+	params := channelMigrationParams{DestinationChannelId: req.DestinationChannelId}
+	if req.SourceChannelId.IsSet() {
+		params.SourceChannelId = utils.Ptr(req.SourceChannelId.Value)
+	}
+	if req.FolderId.IsSet() {
+		if err := a.db.Model(&models.File{}).Where("id = ?", req.FolderId.Value).Where("user_id = ?", userId).
+			Where("type = ?", "folder").Count(&count).Error; err != nil {
+			return nil, &apiError{err: err}
+		}
+		if count == 0 {
+			return nil, &apiError{err: errors.New("folder not found"), code: 404}
+		}
+		params.FolderId = utils.Ptr(req.FolderId.Value)
+	}
+
+	var total int64
+	if err := a.migrationFiles(userId, &params).Count(&total).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	job, err := a.jobs.Enqueue(userId, kindChannelMigration, params, total)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	return toChannelMigrationOut(job), nil
+}
+
+func (a *apiService) UsersListChannelMigrations(ctx context.Context) ([]api.ChannelMigration, error) {
+	migrations, err := a.jobs.List(auth.GetUser(ctx), kindChannelMigration, "")
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	return utils.Map(migrations, func(job models.Job) api.ChannelMigration {
+		return *toChannelMigrationOut(&job)
+	}), nil
+}
+
+func (a *apiService) UsersGetChannelMigration(ctx context.Context, params api.UsersGetChannelMigrationParams) (*api.ChannelMigration, error) {
+	job, err := a.jobs.Get(auth.GetUser(ctx), params.ID)
+	if errors.Is(err, jobs.ErrNotFound) || (err == nil && job.Kind != kindChannelMigration) {
+		return nil, &apiError{err: errors.New("migration not found"), code: 404}
+	}
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	return toChannelMigrationOut(job), nil
+}
+
+func toChannelMigrationOut(job *models.Job) *api.ChannelMigration {
+	var params channelMigrationParams
+	json.Unmarshal(job.Params, &params)
+	res := &api.ChannelMigration{
+		ID:                   job.ID,
+		DestinationChannelId: params.DestinationChannelId,
+		Status:               api.ChannelMigrationStatus(job.Status),
+		TotalFiles:           job.Total,
+		MovedFiles:           job.Done,
+		CreatedAt:            job.CreatedAt,
+		UpdatedAt:            job.UpdatedAt,
+	}
+	if params.SourceChannelId != nil {
+		res.SourceChannelId = api.NewOptInt64(*params.SourceChannelId)
+	}
+	if params.FolderId != nil {
+		res.FolderId = api.NewOptString(*params.FolderId)
+	}
+	if job.Error != "" {
+		res.Error = api.NewOptString(job.Error)
+	}
+	return res
+}
+
+// migrationFiles selects the files a migration covers, in the order they are processed.
+func (a *apiService) migrationFiles(userId int64, params *channelMigrationParams) *gorm.DB {
+	query := a.db.Model(&models.File{}).Where("user_id = ?", userId).
+		Where("type = ?", "file").Where("status = ?", "active")
+	if params.SourceChannelId != nil {
+		query = query.Where("(channel_id = ? OR parts @> ?::jsonb)", *params.SourceChannelId,
+			fmt.Sprintf(`[{"channelId": %d}]`, *params.SourceChannelId))
+	}
+	if params.FolderId != nil {
+		query = query.Where(`parent_id IN (
+			WITH RECURSIVE folder_tree AS (
+				SELECT id FROM teldrive.files WHERE id = ? AND user_id = ?
+				UNION ALL
+				SELECT f.id FROM teldrive.files f JOIN folder_tree ft ON f.parent_id = ft.id
+				WHERE f.type = 'folder'
+			) SELECT id FROM folder_tree)`, *params.FolderId, userId)
+	}
+	return query
+}
+
+// runMigration moves the files of a migration job, resuming after the last file it
+// moved.
+func (a *apiService) runMigration(ctx context.Context, job *jobs.Job) error {
+	var (
+		params channelMigrationParams
+		cursor jobCursor
+	)
+	if err := job.Params(&params); err != nil {
+		return err
+	}
+	if err := job.State(&cursor); err != nil {
+		return err
+	}
+	var sessions []models.Session
+	if err := a.db.Where("user_id = ?", job.UserId).Order("created_at DESC").Limit(1).Find(&sessions).Error; err != nil {
+		return err
+	}
+	if len(sessions) == 0 {
+		return errors.New("no active session for user")
+	}
+
+	for {
+		var files []models.File
+		query := a.migrationFiles(job.UserId, &params).Order("id").Limit(migrationBatchSize)
+		if cursor.LastFileId != "" {
+			query = query.Where("id > ?", cursor.LastFileId)
+		}
+		if err := query.Find(&files).Error; err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return nil
+		}
+
+		client, err := tgc.AuthClient(ctx, &a.cnf.TG, sessions[0].Session, a.middlewares...)
+		if err != nil {
+			return err
+		}
+		err = tgc.RunWithAuth(ctx, client, "", func(ctx context.Context) error {
+			for i := range files {
+				if err := a.migrateFile(ctx, client.API(), &params, &files[i]); err != nil {
+					return fmt.Errorf("file %s: %w", files[i].ID, err)
+				}
+				cursor.LastFileId = files[i].ID
+				if err := job.Progress(job.Done+1, cursor); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// migrateFile copies the file's parts into the destination channel, verifies that the
+// copies reference the same documents, swaps the parts in a single update guarded by the
+// previous parts and only then deletes the original messages.
+func (a *apiService) migrateFile(ctx context.Context, client *tg.Client, m *channelMigrationParams, file *models.File) error {
+	if len(file.Parts) == 0 || file.ChannelId == nil {
+		return nil
+	}
+	channelId := *file.ChannelId
+
+	moves := []int{}
+	for i, part := range file.Parts {
+		current := part.ChannelId.Or(channelId)
+		if current == m.DestinationChannelId {
+			continue
+		}
+		if m.SourceChannelId != nil && current != *m.SourceChannelId {
+			continue
+		}
+		moves = append(moves, i)
+	}
+	if len(moves) == 0 {
+		return nil
+	}
+
+	messages, err := getPartMessages(ctx, client, file)
+	if err != nil {
+		return err
+	}
+	channel, err := tgc.GetChannelById(ctx, client, m.DestinationChannelId)
+	if err != nil {
+		return err
+	}
+
+	parts := slices.Clone(file.Parts)
+	copied := []int{}
+	for _, i := range moves {
+		document, err := partDocument(messages[i])
+		if err != nil {
+			deleteChannelMessages(ctx, client, m.DestinationChannelId, copied)
+			return err
+		}
+		msg, err := sendDocument(ctx, client, channel, document)
+		if err != nil {
+			deleteChannelMessages(ctx, client, m.DestinationChannelId, copied)
+			return err
+		}
+		copied = append(copied, msg.ID)
+		if sent, err := partDocument(msg); err != nil || sent.ID != document.ID {
+			deleteChannelMessages(ctx, client, m.DestinationChannelId, copied)
+			return errors.New("copied part does not match original")
+		}
+		parts[i].ID = msg.ID
+		parts[i].ChannelId = api.NewOptInt64(m.DestinationChannelId)
+	}
+
+	newChannelId := channelId
+	if m.SourceChannelId == nil || channelId == *m.SourceChannelId {
+		newChannelId = m.DestinationChannelId
+	}
+	for i := range parts {
+		if current := parts[i].ChannelId.Or(channelId); current == newChannelId {
+			parts[i].ChannelId = api.OptInt64{}
+		} else {
+			parts[i].ChannelId = api.NewOptInt64(current)
+		}
+	}
+
+	originals := make(map[int64][]int)
+	for _, i := range moves {
+		current := file.Parts[i].ChannelId.Or(channelId)
+		originals[current] = append(originals[current], file.Parts[i].ID)
+	}
+
+	updated := false
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&models.File{}).Where("id = ?", file.ID).Where("parts = ?::jsonb", file.Parts).
+			Updates(map[string]any{"parts": datatypes.NewJSONSlice(parts), "channel_id": newChannelId})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		updated = true
+		for sourceId, ids := range originals {
+			if err := tx.Exec("UPDATE teldrive.channels SET message_count = GREATEST(message_count - ?, 0) WHERE channel_id = ?",
+				len(ids), sourceId).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Exec("UPDATE teldrive.channels SET message_count = message_count + ? WHERE channel_id = ?",
+			len(copied), m.DestinationChannelId).Error
+	})
+	if err != nil || !updated {
+		// The file changed or was removed while copying, keep it as it is.
+		deleteChannelMessages(ctx, client, m.DestinationChannelId, copied)
+		return err
+	}
+
+	for sourceId, ids := range originals {
+		deleteChannelMessages(ctx, client, sourceId, ids)
+	}
+
+	a.cache.Delete(fileCacheKeys(file)...)
+	return nil
+}
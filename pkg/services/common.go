@@ -22,9 +22,7 @@ import (
 
 func getParts(ctx context.Context, client *telegram.Client, c cache.Cacher, file *models.File) ([]types.Part, error) {
 	return cache.Fetch(c, cache.Key("files", "messages", file.ID), 60*time.Minute, func() ([]types.Part, error) {
-		messages, err := tgc.GetMessages(ctx, client.API(), utils.Map(file.Parts, func(part api.Part) int {
-			return part.ID
-		}), *file.ChannelId)
+		messages, err := getPartMessages(ctx, client.API(), file)
 
 		if err != nil {
 			return nil, err
@@ -42,12 +40,15 @@ func getParts(ctx context.Context, client *telegram.Client, c cache.Cacher, file
 					continue
 				}
 				part := types.Part{
-					ID:   int64(file.Parts[i].ID),
-					Size: document.Size,
-					Salt: file.Parts[i].Salt.Value,
+					ID:        int64(file.Parts[i].ID),
+					Size:      document.Size,
+					Salt:      file.Parts[i].Salt.Value,
+					KeyId:     file.Parts[i].KeyId.Value,
+					Format:    string(file.Parts[i].Format.Value),
+					ChannelId: file.Parts[i].ChannelId.Or(*file.ChannelId),
 				}
 				if *file.Encrypted {
-					part.DecryptedSize, _ = crypt.DecryptedSize(document.Size)
+					part.DecryptedSize, _ = crypt.Format(part.Format).DecryptedSize(document.Size)
 				}
 				parts = append(parts, part)
 			}
@@ -62,6 +63,45 @@ func getParts(ctx context.Context, client *telegram.Client, c cache.Cacher, file
 	})
 }
 
+// getPartMessages fetches the messages backing a file's parts, in part order. Parts
+// may live in different channels, so messages are requested per channel.
+func getPartMessages(ctx context.Context, client *tg.Client, file *models.File) ([]tg.MessageClass, error) {
+	messages := make([]tg.MessageClass, len(file.Parts))
+	for channelId, indexes := range groupPartsByChannel(file.Parts, *file.ChannelId) {
+		res, err := tgc.GetMessages(ctx, client, utils.Map(indexes, func(i int) int {
+			return file.Parts[i].ID
+		}), channelId)
+		if err != nil {
+			return nil, err
+		}
+		for j, message := range res {
+			if j < len(indexes) {
+				messages[indexes[j]] = message
+			}
+		}
+	}
+	return messages, nil
+}
+
+// groupPartsByChannel maps each channel to the indexes of the parts it holds.
+func groupPartsByChannel(parts []api.Part, channelId int64) map[int64][]int {
+	groups := make(map[int64][]int)
+	for i, part := range parts {
+		id := part.ChannelId.Or(channelId)
+		groups[id] = append(groups[id], i)
+	}
+	return groups
+}
+
+// partIdsByChannel maps each channel to the message ids of the parts it holds.
+func partIdsByChannel(parts []api.Part, channelId int64) map[int64][]int {
+	ids := make(map[int64][]int)
+	for channel, indexes := range groupPartsByChannel(parts, channelId) {
+		ids[channel] = utils.Map(indexes, func(i int) int { return parts[i].ID })
+	}
+	return ids
+}
+
 func getDefaultChannel(db *gorm.DB, c cache.Cacher, userId int64) (int64, error) {
 	return cache.Fetch(c, cache.Key("users", "channel", userId), 0, func() (int64, error) {
 		var channelIds []int64
@@ -77,7 +117,7 @@ func getDefaultChannel(db *gorm.DB, c cache.Cacher, userId int64) (int64, error)
 }
 
 func getBotsToken(db *gorm.DB, c cache.Cacher, userId, channelId int64) ([]string, error) {
-	return cache.Fetch(c, cache.Key("users", "bots", userId, channelId), 0, func() ([]string, error) {
+	return cache.Fetch(c, cache.Key("users", "bots", userId, channelId), 5*time.Minute, func() ([]string, error) {
 		var bots []string
 		if err := db.Model(&models.Bot{}).Where("user_id = ?", userId).
 			Where("channel_id = ?", channelId).Pluck("token", &bots).Error; err != nil {
@@ -87,3 +127,81 @@ func getBotsToken(db *gorm.DB, c cache.Cacher, userId, channelId int64) ([]strin
 	})
 
 }
+
+// sendDocument posts an existing document to a channel and returns the new message.
+func sendDocument(ctx context.Context, client *tg.Client, channel *tg.InputChannel, document *tg.Document) (*tg.Message, error) {
+	id, _ := randInt64()
+	request := tg.MessagesSendMediaRequest{
+		Silent:   true,
+		Peer:     &tg.InputPeerChannel{ChannelID: channel.ChannelID, AccessHash: channel.AccessHash},
+		Media:    &tg.InputMediaDocument{ID: document.AsInput()},
+		RandomID: id,
+	}
+	res, err := client.MessagesSendMedia(ctx, &request)
+	if err != nil {
+		return nil, err
+	}
+	return sentMessage(res)
+}
+
+// sentMessage extracts the channel message created by a send request.
+func sentMessage(res tg.UpdatesClass) (*tg.Message, error) {
+	updates, ok := res.(*tg.Updates)
+	if !ok {
+		return nil, errors.New("unexpected send media response")
+	}
+	for _, update := range updates.Updates {
+		if channelMsg, ok := update.(*tg.UpdateNewChannelMessage); ok {
+			if msg, ok := channelMsg.Message.(*tg.Message); ok {
+				return msg, nil
+			}
+		}
+	}
+	return nil, errors.New("sent message not found")
+}
+
+// deleteChannelMessages removes messages in batches, logging failures since callers
+// have nothing left to roll back.
+func deleteChannelMessages(ctx context.Context, client *tg.Client, channelId int64, ids []int) {
+	if len(ids) == 0 {
+		return
+	}
+	channel, err := tgc.GetChannelById(ctx, client, channelId)
+	if err == nil {
+		for start := 0; start < len(ids) && err == nil; start += 100 {
+			_, err = client.ChannelsDeleteMessages(ctx, &tg.ChannelsDeleteMessagesRequest{
+				Channel: channel,
+				ID:      ids[start:min(start+100, len(ids))],
+			})
+		}
+	}
+	if err != nil {
+		logging.FromContext(ctx).Error("failed to delete messages", zap.Int64("channelId", channelId),
+			zap.Ints("ids", ids), zap.Error(err))
+	}
+}
+
+func partDocument(message tg.MessageClass) (*tg.Document, error) {
+	item, ok := message.(*tg.Message)
+	if !ok {
+		return nil, errors.New("file part not found")
+	}
+	media, ok := item.Media.(*tg.MessageMediaDocument)
+	if !ok {
+		return nil, errors.New("file part has no document")
+	}
+	document, ok := media.Document.(*tg.Document)
+	if !ok {
+		return nil, errors.New("file part has no document")
+	}
+	return document, nil
+}
+
+// fileCacheKeys lists the cache entries derived from a file's parts.
+func fileCacheKeys(file *models.File) []string {
+	keys := []string{cache.Key("files", file.ID), cache.Key("files", "messages", file.ID)}
+	for _, part := range file.Parts {
+		keys = append(keys, cache.Key("files", "location", file.ID, part.ID))
+	}
+	return keys
+}
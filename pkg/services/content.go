@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gotd/td/telegram"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/reader"
+	"github.com/tgdrive/teldrive/internal/textextract"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const contentBatchSize = 20
+
+// contentSlots bounds the indexing started after uploads, the backfill picks up
+// whatever doesn't get a slot.
+var contentSlots = make(chan struct{}, 2)
+
+// queueContentIndex starts indexing the text of a new document when a slot is free.
+func (a *apiService) queueContentIndex(ctx context.Context, file models.File, name string) {
+	if !a.canIndexContent(&file, name) {
+		return
+	}
+	select {
+	case contentSlots <- struct{}{}:
+	default:
+		return
+	}
+	go func() {
+		defer func() { <-contentSlots }()
+		if err := a.indexContent(context.WithoutCancel(ctx), &file, name); err != nil {
+			logging.FromContext(ctx).Warn("failed to index content", zap.String("fileId", file.ID), zap.Error(err))
+		}
+	}()
+}
+
+// BackfillContentIndex indexes the text of documents stored before they were indexed,
+// a batch per run.
+func (a *apiService) BackfillContentIndex(ctx context.Context) {
+	if !a.cnf.Search.IndexContent {
+		return
+	}
+	pattern := fmt.Sprintf(`\.(%s)$`, strings.Join(textextract.Extensions(), "|"))
+	var files []models.File
+	if err := a.db.Where("type = ?", "file").Where("status = ?", "active").Where("NOT encrypted").
+		Where("NOT name_encrypted").Where("size BETWEEN 1 AND ?", a.cnf.Search.MaxFileSize).
+		Where("name ~* ?", pattern).
+		Where("NOT EXISTS (SELECT 1 FROM teldrive.file_contents c WHERE c.file_id = files.id)").
+		Where("id > ?", a.contentCursor).
+		Order("id").Limit(contentBatchSize).Find(&files).Error; err != nil {
+		return
+	}
+	if len(files) < contentBatchSize {
+		a.contentCursor = uuid.Nil.String()
+	} else {
+		a.contentCursor = files[len(files)-1].ID
+	}
+	logger := logging.FromContext(ctx)
+	for i := range files {
+		if ctx.Err() != nil {
+			return
+		}
+		if !a.canIndexContent(&files[i], files[i].Name) {
+			saveFileContent(a.db, files[i].ID, "")
+			continue
+		}
+		if err := a.indexContent(ctx, &files[i], files[i].Name); err != nil {
+			logger.Warn("failed to index content", zap.String("fileId", files[i].ID), zap.Error(err))
+		}
+	}
+}
+
+// indexContent downloads a document and stores its text. Documents the text can't be
+// read from get an empty content, failed downloads are retried by the backfill.
+func (a *apiService) indexContent(ctx context.Context, file *models.File, name string) error {
+	return a.runAsOwner(ctx, file.UserId, func(ctx context.Context, client *telegram.Client) error {
+		parts, err := getParts(ctx, client, a.cache, file)
+		if err != nil {
+			return err
+		}
+		lr, err := reader.NewLinearReader(ctx, client.API(), a.cache, file, parts, 0, *file.Size-1, &a.cnf.TG,
+			a.partKeys(file.UserId, ""), 0)
+		if err != nil {
+			return err
+		}
+		defer lr.Close()
+		data, err := io.ReadAll(io.LimitReader(lr, a.cnf.Search.MaxFileSize))
+		if err != nil {
+			return err
+		}
+		text, err := textextract.Extract(name, data, a.cnf.Search.MaxTextSize)
+		if err != nil {
+			text = ""
+		}
+		return saveFileContent(a.db, file.ID, text)
+	})
+}
+
+func saveFileContent(db *gorm.DB, fileId, text string) error {
+	return db.Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(&models.FileContent{FileId: fileId, Content: text}).Error
+}
+
+// copyFileContent gives a copied file the indexed text of its source.
+func copyFileContent(db *gorm.DB, fromId, toId string) error {
+	return db.Exec(`INSERT INTO teldrive.file_contents (file_id, content)
+		SELECT ?, content FROM teldrive.file_contents WHERE file_id = ?`, toId, fromId).Error
+}
+
+// resetExtracted drops what was read from the content of a file whose content changed.
+func resetExtracted(db *gorm.DB, fileId string) error {
+	if err := db.Where("file_id = ?", fileId).Delete(&models.MediaInfo{}).Error; err != nil {
+		return err
+	}
+	return db.Where("file_id = ?", fileId).Delete(&models.FileContent{}).Error
+}
+
+// contentSnippets returns highlighted passages of the given files matching query.
+func contentSnippets(db *gorm.DB, ids []string, query string) (map[string][]string, error) {
+	res := make(map[string][]string, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	var rows []struct {
+		FileId   string
+		Snippets string
+	}
+	if err := db.Model(&models.FileContent{}).
+		Select("file_id, array_to_json(pgroonga_snippet_html(content, pgroonga_query_extract_keywords(?)))::text AS snippets", query).
+		Where("file_id IN ?", ids).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		var snippets []string
+		if err := json.Unmarshal([]byte(row.Snippets), &snippets); err == nil && len(snippets) > 0 {
+			res[row.FileId] = snippets
+		}
+	}
+	return res, nil
+}
+
+// canIndexContent reports whether the text of a file can be indexed. The text of
+// encrypted files and of files with encrypted names is not indexed, since it would be
+// stored in clear.
+func (a *apiService) canIndexContent(file *models.File, name string) bool {
+	if !a.cnf.Search.IndexContent || file.Type != "file" || file.Size == nil || *file.Size == 0 ||
+		*file.Size > a.cnf.Search.MaxFileSize || (file.Encrypted != nil && *file.Encrypted) || file.NameEncrypted {
+		return false
+	}
+	return textextract.Supported(name) && serverReadable(file)
+}
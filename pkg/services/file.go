@@ -9,6 +9,7 @@ import (
 	"io"
 	"mime"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -30,7 +31,6 @@ import (
 	"github.com/tgdrive/teldrive/internal/utils"
 	"github.com/tgdrive/teldrive/pkg/mapper"
 	"github.com/tgdrive/teldrive/pkg/models"
-	"github.com/tgdrive/teldrive/pkg/types"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -86,6 +86,10 @@ func (a *apiService) getFileFromPath(path string, userId int64) (*models.File, e
 
 	var res []models.File
 
+	path, err := a.storedPath(userId, path)
+	if err != nil {
+		return nil, err
+	}
 	if err := a.db.Raw("select * from teldrive.get_file_from_path(?, ?, ?)", path, userId, true).
 		Scan(&res).Error; err != nil {
 		return nil, err
@@ -112,11 +116,22 @@ func (a *apiService) FilesCategoryStats(ctx context.Context) ([]api.CategoryStat
 func (a *apiService) FilesCopy(ctx context.Context, req *api.FileCopy, params api.FilesCopyParams) (*api.File, error) {
 	userId := auth.GetUser(ctx)
 
+	file, err := a.copyFile(ctx, userId, params.ID, req.Destination, req.NewName, req.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.ToFileOut(*file, a.fileNames(userId)), nil
+}
+
+// copyFile sends the parts of a file again and stores them as a new file under the
+// destination, which is a folder id or a path created as needed.
+func (a *apiService) copyFile(ctx context.Context, userId int64, fileId, destination string, newName api.OptString,
+	updatedAt api.OptDateTime) (*models.File, error) {
 	client, _ := tgc.AuthClient(ctx, &a.cnf.TG, auth.GetJWTUser(ctx).TgSession, a.middlewares...)
 
 	var res []models.File
 
-	if err := a.db.Model(&models.File{}).Where("id = ?", params.ID).Find(&res).Error; err != nil {
+	if err := a.db.Model(&models.File{}).Where("id = ?", fileId).Find(&res).Error; err != nil {
 		return nil, &apiError{err: err}
 	}
 	if len(res) == 0 {
@@ -134,8 +149,7 @@ func (a *apiService) FilesCopy(ctx context.Context, req *api.FileCopy, params ap
 
 	err = tgc.RunWithAuth(ctx, client, "", func(ctx context.Context) error {
 
-		ids := utils.Map(file.Parts, func(part api.Part) int { return part.ID })
-		messages, err := tgc.GetMessages(ctx, client.API(), ids, *file.ChannelId)
+		messages, err := getPartMessages(ctx, client.API(), &file)
 
 		if err != nil {
 			return err
@@ -147,38 +161,22 @@ func (a *apiService) FilesCopy(ctx context.Context, req *api.FileCopy, params ap
 			return err
 		}
 		for i, message := range messages {
-			item := message.(*tg.Message)
+			item, ok := message.(*tg.Message)
+			if !ok {
+				return errors.New("file part not found")
+			}
 			media := item.Media.(*tg.MessageMediaDocument)
 			document := media.Document.(*tg.Document)
 
-			id, _ := randInt64()
-			request := tg.MessagesSendMediaRequest{
-				Silent:   true,
-				Peer:     &tg.InputPeerChannel{ChannelID: channel.ChannelID, AccessHash: channel.AccessHash},
-				Media:    &tg.InputMediaDocument{ID: document.AsInput()},
-				RandomID: id,
-			}
-			res, err := client.API().MessagesSendMedia(ctx, &request)
-
+			msg, err := sendDocument(ctx, client.API(), channel, document)
 			if err != nil {
 				return err
 			}
-
-			updates := res.(*tg.Updates)
-
-			var msg *tg.Message
-
-			for _, update := range updates.Updates {
-				channelMsg, ok := update.(*tg.UpdateNewChannelMessage)
-				if ok {
-					msg = channelMsg.Message.(*tg.Message)
-					break
-				}
-
-			}
 			p := api.Part{ID: msg.ID}
 			if file.Parts[i].Salt.Value != "" {
 				p.Salt = file.Parts[i].Salt
+				p.KeyId = file.Parts[i].KeyId
+				p.Format = file.Parts[i].Format
 			}
 			newIds = append(newIds, p)
 
@@ -195,20 +193,27 @@ func (a *apiService) FilesCopy(ctx context.Context, req *api.FileCopy, params ap
 	}
 
 	var parentId string
-	if !isUUID(req.Destination) {
-		var destRes []models.File
-		if err := a.db.Raw("select * from teldrive.create_directories(?, ?)", userId, req.Destination).
-			Scan(&destRes).Error; err != nil {
+	if !isUUID(destination) {
+		dest, err := a.createDirectories(userId, destination)
+		if err != nil {
 			return nil, &apiError{err: err}
 		}
-		parentId = destRes[0].ID
+		parentId = dest.ID
 	} else {
-		parentId = req.Destination
+		parentId = destination
 	}
 
 	dbFile := models.File{}
 
-	dbFile.Name = req.NewName.Or(file.Name)
+	name, err := a.plainName(&file)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	stored, err := a.nameForParent(a.db, userId, &parentId, newName.Or(name))
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	dbFile.Name, dbFile.NameEncrypted, dbFile.NameKeyId = stored.Name, stored.Encrypted, stored.KeyId
 	dbFile.Size = file.Size
 	dbFile.Type = string(file.Type)
 	dbFile.MimeType = file.MimeType
@@ -221,23 +226,32 @@ func (a *apiService) FilesCopy(ctx context.Context, req *api.FileCopy, params ap
 	dbFile.ChannelId = &channelId
 	dbFile.Encrypted = file.Encrypted
 	dbFile.Category = string(file.Category)
-	if req.UpdatedAt.IsSet() && !req.UpdatedAt.Value.IsZero() {
-		dbFile.UpdatedAt = req.UpdatedAt.Value
+	dbFile.Properties = file.Properties
+	if updatedAt.IsSet() && !updatedAt.Value.IsZero() {
+		dbFile.UpdatedAt = updatedAt.Value
 	} else {
 		dbFile.UpdatedAt = time.Now().UTC()
 	}
 
-	if err := a.db.Create(&dbFile).Error; err != nil {
+	if err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&dbFile).Error; err != nil {
+			return err
+		}
+		if err := copyMediaInfo(tx, file.ID, &dbFile); err != nil {
+			return err
+		}
+		return copyFileContent(tx, file.ID, dbFile.ID)
+	}); err != nil {
 		return nil, &apiError{err: err}
 	}
 
 	a.events.Record(events.OpCopy, userId, &models.Source{
 		ID:       dbFile.ID,
 		Type:     dbFile.Type,
-		Name:     dbFile.Name,
+		Name:     newName.Or(name),
 		ParentID: parentId,
 	})
-	return mapper.ToFileOut(dbFile), nil
+	return &dbFile, nil
 }
 
 func (a *apiService) FilesCreate(ctx context.Context, fileIn *api.File) (*api.File, error) {
@@ -290,11 +304,23 @@ func (a *apiService) FilesCreate(ctx context.Context, fileIn *api.File) (*api.Fi
 		fileDB.MimeType = fileIn.MimeType.Value
 		fileDB.Category = string(category.GetCategory(fileIn.Name))
 		if len(fileIn.Parts) > 0 {
-			fileDB.Parts = datatypes.NewJSONSlice(mapParts(fileIn.Parts))
+			parts := mapParts(fileIn.Parts)
+			if err := fillPartsFromUploads(a.db, userId, "", parts, channelId); err != nil {
+				return nil, &apiError{err: err}
+			}
+			fileDB.Parts = datatypes.NewJSONSlice(parts)
 		}
 		fileDB.Size = utils.Ptr(fileIn.Size.Value)
 	}
-	fileDB.Name = fileIn.Name
+	stored, err := a.nameForParent(a.db, userId, fileDB.ParentId, fileIn.Name)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	fileDB.Name, fileDB.NameEncrypted, fileDB.NameKeyId = stored.Name, stored.Encrypted, stored.KeyId
+	fileDB.EncryptNames = fileIn.Type == "folder" && (fileDB.NameEncrypted || fileIn.EncryptNames.Value)
+	if fileDB.EncryptNames {
+		fileDB.ChildrenKeyId = a.childrenKeyId()
+	}
 	fileDB.Type = string(fileIn.Type)
 	fileDB.UserId = userId
 	fileDB.Status = "active"
@@ -305,14 +331,23 @@ func (a *apiService) FilesCreate(ctx context.Context, fileIn *api.File) (*api.Fi
 		fileDB.UpdatedAt = time.Now().UTC()
 	}
 
+	// An upsert replaces the thumbnails of the file it overwrites.
+	var previous []models.File
+	if err := a.db.Select("thumbnails").Where("name = ?", fileDB.Name).Where("user_id = ?", userId).
+		Where("COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid) = COALESCE(?::uuid, '00000000-0000-0000-0000-000000000000'::uuid)", fileDB.ParentId).
+		Where("status = ?", "active").Find(&previous).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+
 	//For some reason, gorm conflict clauses are not working with partial index so using raw query
 
 	if err := a.db.Raw(`
     INSERT INTO teldrive.files (
         name, parent_id, user_id, mime_type, category, parts, 
-        size, type, encrypted, updated_at, channel_id, status
+        size, type, encrypted, updated_at, channel_id, status, name_encrypted, name_key_id,
+        encrypt_names, children_key_id
     ) 
-    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
     ON CONFLICT (name, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), user_id) 
     WHERE status = 'active'
     DO UPDATE SET 
@@ -324,27 +359,44 @@ func (a *apiService) FilesCreate(ctx context.Context, fileIn *api.File) (*api.Fi
         encrypted = EXCLUDED.encrypted,
         updated_at = EXCLUDED.updated_at,
         channel_id = EXCLUDED.channel_id,
-        status = EXCLUDED.status
+        status = EXCLUDED.status,
+        encrypt_names = EXCLUDED.encrypt_names,
+        children_key_id = CASE WHEN teldrive.files.encrypt_names
+            THEN teldrive.files.children_key_id ELSE EXCLUDED.children_key_id END,
+        thumbnails = NULL
     RETURNING *
 `,
 		fileDB.Name, fileDB.ParentId, fileDB.UserId, fileDB.MimeType,
 		fileDB.Category, fileDB.Parts, fileDB.Size, fileDB.Type,
 		fileDB.Encrypted, fileDB.UpdatedAt, fileDB.ChannelId, fileDB.Status,
+		fileDB.NameEncrypted, fileDB.NameKeyId, fileDB.EncryptNames, fileDB.ChildrenKeyId,
 	).Scan(&fileDB).Error; err != nil {
 		return nil, &apiError{err: err}
 	}
 	a.events.Record(events.OpCreate, userId, &models.Source{
 		ID:       fileDB.ID,
 		Type:     fileDB.Type,
-		Name:     fileDB.Name,
+		Name:     fileIn.Name,
 		ParentID: *fileDB.ParentId,
 	})
-	return mapper.ToFileOut(fileDB), nil
+	// An upsert over an existing file replaced its content.
+	if err := resetExtracted(a.db, fileDB.ID); err != nil {
+		return nil, &apiError{err: err}
+	}
+	if len(previous) > 0 {
+		a.dropThumbnails(ctx, userId, previous[0].Thumbnails)
+	}
+	a.queueThumbnails(ctx, fileDB)
+	a.queueMediaInfo(ctx, fileDB)
+	a.queueContentIndex(ctx, fileDB, fileIn.Name)
+	return mapper.ToFileOut(fileDB, a.fileNames(userId)), nil
 }
 
 func (a *apiService) FilesCreateShare(ctx context.Context, req *api.FileShareCreate, params api.FilesCreateShareParams) error {
-	userId := auth.GetUser(ctx)
+	return createFileShare(a.db, auth.GetUser(ctx), params.ID, req)
+}
 
+func createFileShare(db *gorm.DB, userId int64, fileId string, req *api.FileShareCreate) error {
 	var fileShare models.FileShare
 
 	if req.Password.Value != "" {
@@ -355,13 +407,37 @@ func (a *apiService) FilesCreateShare(ctx context.Context, req *api.FileShareCre
 		fileShare.Password = utils.Ptr(string(bytes))
 	}
 
-	fileShare.FileId = params.ID
+	fileShare.FileId = &fileId
 	if req.ExpiresAt.IsSet() {
 		fileShare.ExpiresAt = utils.Ptr(req.ExpiresAt.Value)
 	}
+	if req.MaxDownloads.IsSet() {
+		fileShare.MaxDownloads = utils.Ptr(req.MaxDownloads.Value)
+	}
+	if req.MaxIps.IsSet() {
+		fileShare.MaxIps = utils.Ptr(req.MaxIps.Value)
+	}
+	fileShare.Mode = string(req.Mode.Or(api.FileShareCreateModeDownload))
+	if fileShare.Mode == shareModeUpload {
+		var folders int64
+		if err := db.Model(&models.File{}).Where("id = ?", fileId).Where("user_id = ?", userId).
+			Where("type = ?", "folder").Count(&folders).Error; err != nil {
+			return &apiError{err: err}
+		}
+		if folders == 0 {
+			return &apiError{err: errors.New("upload shares require a folder"), code: 400}
+		}
+		fileShare.AllowedExtensions = normalizeExtensions(req.AllowedExtensions)
+		if req.MaxFileSize.IsSet() {
+			fileShare.MaxFileSize = utils.Ptr(req.MaxFileSize.Value)
+		}
+		if req.MaxFiles.IsSet() {
+			fileShare.MaxFiles = utils.Ptr(req.MaxFiles.Value)
+		}
+	}
 	fileShare.UserId = userId
 
-	if err := a.db.Create(&fileShare).Error; err != nil {
+	if err := db.Create(&fileShare).Error; err != nil {
 		return &apiError{err: err}
 	}
 
@@ -375,27 +451,34 @@ func (a *apiService) FilesDelete(ctx context.Context, req *api.FileDelete) error
 		return &apiError{err: errors.New("ids should not be empty"), code: 409}
 	}
 
-	var fileDB models.File
-
-	if err := a.db.Model(&models.File{}).Where("id = ?", req.Ids[0]).Where("user_id = ?", userId).
-		First(&fileDB).Error; err != nil {
+	var files []models.File
+	if err := a.db.Where("id IN ?", req.Ids).Where("user_id = ?", userId).Find(&files).Error; err != nil {
 		return &apiError{err: err}
 	}
+	if len(files) == 0 {
+		return &apiError{err: ErrFileNotFound, code: http.StatusNotFound}
+	}
 
 	if err := a.db.Exec("call teldrive.delete_files_bulk($1 , $2)", req.Ids, userId).Error; err != nil {
 		return &apiError{err: err}
 	}
 
-	a.events.Record(events.OpDelete, userId, &models.Source{
-		ID:       fileDB.ID,
-		Type:     fileDB.Type,
-		Name:     fileDB.Name,
-		ParentID: *fileDB.ParentId,
-	})
+	for i := range files {
+		a.events.Record(events.OpDelete, userId, fileSource(&files[i]))
+	}
 
 	return nil
 }
 
+// fileSource describes a file in the event of an operation on it.
+func fileSource(file *models.File) *models.Source {
+	source := &models.Source{ID: file.ID, Type: file.Type, Name: file.Name}
+	if file.ParentId != nil {
+		source.ParentID = *file.ParentId
+	}
+	return source
+}
+
 func (a *apiService) FilesDeleteShare(ctx context.Context, params api.FilesDeleteShareParams) error {
 	userId := auth.GetUser(ctx)
 
@@ -406,7 +489,7 @@ func (a *apiService) FilesDeleteShare(ctx context.Context, params api.FilesDelet
 		return &apiError{err: err}
 	}
 	if deletedShare.ID != "" {
-		a.cache.Delete(cache.Key("shared", deletedShare.ID))
+		a.cache.Delete(cache.Key("shares", deletedShare.ID))
 	}
 
 	return nil
@@ -427,30 +510,95 @@ func (a *apiService) FilesEditShare(ctx context.Context, req *api.FileShareCreat
 	if req.ExpiresAt.IsSet() {
 		fileShareUpdate.ExpiresAt = utils.Ptr(req.ExpiresAt.Value)
 	}
+	if req.MaxDownloads.IsSet() {
+		fileShareUpdate.MaxDownloads = utils.Ptr(req.MaxDownloads.Value)
+	}
+	if req.MaxIps.IsSet() {
+		fileShareUpdate.MaxIps = utils.Ptr(req.MaxIps.Value)
+	}
+	if req.AllowedExtensions != nil {
+		fileShareUpdate.AllowedExtensions = normalizeExtensions(req.AllowedExtensions)
+	}
+	if req.MaxFileSize.IsSet() {
+		fileShareUpdate.MaxFileSize = utils.Ptr(req.MaxFileSize.Value)
+	}
+	if req.MaxFiles.IsSet() {
+		fileShareUpdate.MaxFiles = utils.Ptr(req.MaxFiles.Value)
+	}
+	limitsRaised := req.MaxDownloads.IsSet() || req.MaxIps.IsSet() || req.MaxFiles.IsSet()
 
-	if err := a.db.Model(&models.FileShare{}).Where("file_id = ?", params.ID).Where("user_id = ?", userId).
-		Updates(fileShareUpdate).Error; err != nil {
+	var updated []models.FileShare
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&updated).Clauses(clause.Returning{}).Where("file_id = ?", params.ID).
+			Where("user_id = ?", userId).Updates(fileShareUpdate).Error; err != nil {
+			return err
+		}
+		// Raising a limit brings a disabled share back.
+		if limitsRaised {
+			return tx.Model(&models.FileShare{}).Where("file_id = ?", params.ID).Where("user_id = ?", userId).
+				Where("disabled_at IS NOT NULL").
+				Where("(max_downloads IS NULL OR downloads < max_downloads)").
+				Where("(max_files IS NULL OR uploaded_files < max_files)").
+				Where(`(max_ips IS NULL OR (SELECT count(DISTINCT sa.ip) FROM teldrive.share_accesses sa
+					WHERE sa.share_id = file_shares.id) < max_ips)`).
+				Updates(map[string]any{"disabled_at": nil, "disabled_reason": nil}).Error
+		}
+		return nil
+	})
+	if err != nil {
 		return &apiError{err: err}
 	}
+	for _, share := range updated {
+		a.cache.Delete(cache.Key("shares", share.ID))
+	}
 
 	return nil
 }
 
 func (a *apiService) FilesGetById(ctx context.Context, params api.FilesGetByIdParams) (*api.File, error) {
 	var result []fullFileDB
-	if err := a.db.Model(&models.File{}).Select("*",
-		"(select get_path_from_file_id as path from teldrive.get_path_from_file_id(id))").
-		Where("id = ?", params.ID).Scan(&result).Error; err != nil {
+	if err := a.db.Model(&models.File{}).Where("id = ?", params.ID).Scan(&result).Error; err != nil {
 		return nil, &apiError{err: err}
 	}
 	if len(result) == 0 {
 		return nil, &apiError{err: errors.New("file not found"), code: 404}
 	}
-	res := mapper.ToFileOut(result[0].File)
+	owner := result[0].UserId == auth.GetUser(ctx)
+	var (
+		names mapper.NameDecrypter
+		owned *userNames
+	)
+	if owner {
+		owned = a.fileNames(result[0].UserId)
+		names = owned
+	}
+	path, err := a.filePath(result[0].ID, owned)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	result[0].Path = path
+	media, err := loadMediaInfo(a.db, []string{result[0].ID})
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	result[0].Media = media[result[0].ID]
+	res := mapper.ToFileOut(result[0].File, names)
 	res.Path = api.NewOptString(result[0].Path)
 	if result[0].ChannelId != nil {
 		res.ChannelId = api.NewOptInt64(*result[0].ChannelId)
 	}
+	if owner {
+		tags, err := a.fileTagNames(result[0].ID)
+		if err != nil {
+			return nil, &apiError{err: err}
+		}
+		res.Tags = tags
+		starred, err := a.isStarred(result[0].UserId, result[0].ID)
+		if err != nil {
+			return nil, &apiError{err: err}
+		}
+		res.Starred = api.NewOptBool(starred)
+	}
 
 	return res, nil
 }
@@ -458,15 +606,33 @@ func (a *apiService) FilesGetById(ctx context.Context, params api.FilesGetByIdPa
 func (a *apiService) FilesList(ctx context.Context, params api.FilesListParams) (*api.FileList, error) {
 	userId := auth.GetUser(ctx)
 
-	queryBuilder := &fileQueryBuilder{db: a.db}
+	if params.SmartFolderId.Value != "" {
+		search, err := a.savedSearch(userId, params.SmartFolderId.Value)
+		if err != nil {
+			return nil, err
+		}
+		applySavedSearch(&params, search.Query.Data())
+	}
 
-	return queryBuilder.execute(&params, userId)
+	// Listing a folder another user shared runs as its owner.
+	ownerId := userId
+	if params.ParentId.Value != "" && params.ParentId.Value != "nil" && !params.SharedWithMe.Value {
+		var err error
+		if ownerId, err = a.checkAccess(userId, params.ParentId.Value, roleViewer); err != nil {
+			return nil, err
+		}
+	}
+
+	queryBuilder := &fileQueryBuilder{db: a.db, names: a.fileNames(ownerId), ownerNames: a.fileNames,
+		storedPath: a.storedPath, callerId: userId}
+
+	return queryBuilder.execute(&params, ownerId)
 }
 
 func (a *apiService) FilesMkdir(ctx context.Context, req *api.FileMkDir) error {
 	userId := auth.GetUser(ctx)
 
-	if err := a.db.Exec("select * from teldrive.create_directories(?, ?)", userId, req.Path).Error; err != nil {
+	if _, err := a.createDirectories(userId, req.Path); err != nil {
 		return &apiError{err: err}
 	}
 	return nil
@@ -475,6 +641,10 @@ func (a *apiService) FilesMkdir(ctx context.Context, req *api.FileMkDir) error {
 func (a *apiService) FilesMove(ctx context.Context, req *api.FileMove) error {
 	userId := auth.GetUser(ctx)
 
+	if len(req.Ids) == 0 {
+		return &apiError{err: errors.New("ids should not be empty"), code: 409}
+	}
+
 	if !isUUID(req.DestinationParent) {
 		r, err := a.getFileFromPath(req.DestinationParent, userId)
 		if err != nil {
@@ -483,15 +653,37 @@ func (a *apiService) FilesMove(ctx context.Context, req *api.FileMove) error {
 		req.DestinationParent = r.ID
 	}
 
-	err := a.db.Transaction(func(tx *gorm.DB) error {
+	// Editors of a shared folder move items as the owner, within the owner's tree.
+	ownerId, err := a.checkAccess(userId, req.DestinationParent, roleEditor)
+	if err != nil {
+		return err
+	}
+	// Owners need no per item check, the updates below only touch their own files.
+	if ownerId != userId {
+		for _, id := range req.Ids {
+			fileOwner, err := a.checkAccess(userId, id, roleEditor)
+			if err != nil {
+				return err
+			}
+			if fileOwner != ownerId {
+				return &apiError{err: errors.New("cannot move files between users"), code: http.StatusBadRequest}
+			}
+		}
+	}
+
+	err = a.db.Transaction(func(tx *gorm.DB) error {
 		var srcFile models.File
-		if err := tx.Where("id = ? AND user_id = ?", req.Ids[0], userId).First(&srcFile).Error; err != nil {
+		if err := tx.Where("id = ? AND user_id = ?", req.Ids[0], ownerId).First(&srcFile).Error; err != nil {
 			return err
 		}
 		if len(req.Ids) == 1 && req.DestinationName.Value != "" {
+			name, err := a.nameForParent(tx, ownerId, &req.DestinationParent, req.DestinationName.Value)
+			if err != nil {
+				return err
+			}
 			var existing models.File
 			if err := tx.Where("name = ? AND parent_id = ? AND user_id = ? AND status = 'active'",
-				req.DestinationName.Value, req.DestinationParent, userId).First(&existing).Error; err == nil {
+				name.Name, req.DestinationParent, ownerId).First(&existing).Error; err == nil {
 				if srcFile.Type == "folder" && existing.Type == "folder" {
 					if err := tx.Model(&models.File{}).
 						Where("parent_id = ? AND status = 'active'", existing.ID).
@@ -504,33 +696,37 @@ func (a *apiService) FilesMove(ctx context.Context, req *api.FileMove) error {
 						return err
 					}
 				}
-				if err := tx.Exec("call teldrive.delete_files_bulk($1 , $2)", []string{existing.ID}, userId).Error; err != nil {
+				if err := tx.Exec("call teldrive.delete_files_bulk($1 , $2)", []string{existing.ID}, ownerId).Error; err != nil {
 					return err
 				}
 			}
+			columns := a.folderNameColumns(&srcFile, name)
+			columns["parent_id"] = req.DestinationParent
 			return tx.Model(&models.File{}).
-				Where("id = ? AND user_id = ?", req.Ids[0], userId).
-				Updates(map[string]any{
-					"parent_id": req.DestinationParent,
-					"name":      req.DestinationName.Value,
-				}).Error
+				Where("id = ? AND user_id = ?", req.Ids[0], ownerId).
+				Updates(columns).Error
+		}
+		var moved []models.File
+		if err := tx.Where("id IN ?", req.Ids).Where("user_id = ?", ownerId).Find(&moved).Error; err != nil {
+			return err
 		}
 		items := pgtype.Array[string]{
 			Elements: req.Ids,
 			Valid:    true,
 			Dims:     []pgtype.ArrayDimension{{Length: int32(len(req.Ids)), LowerBound: 1}},
 		}
-		if err := a.db.Model(&models.File{}).Where("id = any(?)", items).Where("user_id = ?", userId).
+		if err := a.db.Model(&models.File{}).Where("id = any(?)", items).Where("user_id = ?", ownerId).
 			Update("parent_id", req.DestinationParent).Error; err != nil {
 			return err
 		}
-		a.events.Record(events.OpMove, userId, &models.Source{
-			ID:           req.DestinationParent,
-			Type:         srcFile.Type,
-			Name:         srcFile.Name,
-			ParentID:     *srcFile.ParentId,
-			DestParentID: req.DestinationParent,
-		})
+		if err := a.renameForParent(tx, ownerId, req.Ids, req.DestinationParent); err != nil {
+			return err
+		}
+		for i := range moved {
+			source := fileSource(&moved[i])
+			source.DestParentID = req.DestinationParent
+			a.events.Record(events.OpMove, ownerId, source)
+		}
 		return nil
 
 	})
@@ -566,6 +762,32 @@ func (a *apiService) FilesShareByid(ctx context.Context, params api.FilesShareBy
 	if result[0].ExpiresAt != nil {
 		res.ExpiresAt = api.NewOptDateTime(*result[0].ExpiresAt)
 	}
+	if result[0].MaxDownloads != nil {
+		res.MaxDownloads = api.NewOptInt64(*result[0].MaxDownloads)
+	}
+	if result[0].MaxIps != nil {
+		res.MaxIps = api.NewOptInt64(*result[0].MaxIps)
+	}
+	res.Downloads = api.NewOptInt64(result[0].Downloads)
+	res.Disabled = api.NewOptBool(result[0].DisabledAt != nil)
+	if result[0].DisabledReason != nil {
+		res.DisabledReason = api.NewOptString(*result[0].DisabledReason)
+	}
+	if err := a.db.Model(&models.FileShareItem{}).Where("share_id = ?", result[0].ID).
+		Pluck("file_id", &res.FileIds).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	res.Mode = api.NewOptFileShareMode(api.FileShareMode(result[0].Mode))
+	if result[0].Mode == shareModeUpload {
+		res.AllowedExtensions = result[0].AllowedExtensions
+		if result[0].MaxFileSize != nil {
+			res.MaxFileSize = api.NewOptInt64(*result[0].MaxFileSize)
+		}
+		if result[0].MaxFiles != nil {
+			res.MaxFiles = api.NewOptInt64(*result[0].MaxFiles)
+		}
+		res.UploadedFiles = api.NewOptInt64(result[0].UploadedFiles)
+	}
 	return res, nil
 }
 
@@ -577,9 +799,25 @@ func (a *apiService) FilesUpdate(ctx context.Context, req *api.FileUpdate, param
 
 	userId := auth.GetUser(ctx)
 
+	ownerId, err := a.checkAccess(userId, params.ID, roleEditor)
+	if err != nil {
+		return nil, err
+	}
+
 	updateDb := models.File{}
 	if req.Name.Value != "" {
-		updateDb.Name = req.Name.Value
+		var current models.File
+		if err := a.db.Where("id = ?", params.ID).Where("user_id = ?", ownerId).First(&current).Error; err != nil {
+			if database.IsRecordNotFoundErr(err) {
+				return nil, &apiError{err: errors.New("file not found"), code: 404}
+			}
+			return nil, &apiError{err: err}
+		}
+		name, err := a.nameForParent(a.db, ownerId, current.ParentId, req.Name.Value)
+		if err != nil {
+			return nil, &apiError{err: err}
+		}
+		updateDb.Name, updateDb.NameKeyId = name.Name, name.KeyId
 	}
 	if len(req.Parts) > 0 {
 		updateDb.Parts = datatypes.NewJSONSlice(mapParts(req.Parts))
@@ -594,24 +832,48 @@ func (a *apiService) FilesUpdate(ctx context.Context, req *api.FileUpdate, param
 		updateDb.UpdatedAt = time.Now().UTC()
 	}
 
-	if err := a.db.Model(&models.File{}).Where("id = ?", params.ID).Updates(updateDb).Error; err != nil {
+	var previous models.File
+	if err := a.db.Transaction(func(tx *gorm.DB) error {
+		if len(req.Parts) > 0 {
+			if err := tx.Select("thumbnails").Where("id = ?", params.ID).First(&previous).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.File{}).Where("id = ?", params.ID).Updates(updateDb).Error; err != nil {
+			return err
+		}
+		if len(req.Parts) > 0 {
+			if err := tx.Model(&models.File{}).Where("id = ?", params.ID).Update("thumbnails", nil).Error; err != nil {
+				return err
+			}
+			if err := resetExtracted(tx, params.ID); err != nil {
+				return err
+			}
+		}
+		if req.Properties.IsSet() {
+			return mergeProperties(tx, params.ID, req.Properties.Value)
+		}
+		return nil
+	}); err != nil {
 		return nil, &apiError{err: err}
 	}
 
 	a.cache.Delete(cache.Key("files", params.ID))
+	a.dropThumbnails(ctx, ownerId, previous.Thumbnails)
 
 	file := models.File{}
 	if err := a.db.Where("id = ?", params.ID).First(&file).Error; err != nil {
 		return nil, &apiError{err: err}
 	}
 
-	a.events.Record(events.OpUpdate, userId, &models.Source{
+	res := mapper.ToFileOut(file, a.fileNames(ownerId))
+	a.events.Record(events.OpUpdate, ownerId, &models.Source{
 		ID:       file.ID,
 		Type:     file.Type,
-		Name:     file.Name,
+		Name:     res.Name,
 		ParentID: *file.ParentId,
 	})
-	return mapper.ToFileOut(file), nil
+	return res, nil
 }
 
 func (a *apiService) FilesUpdateParts(ctx context.Context, req *api.FilePartsUpdate, params api.FilesUpdatePartsParams) error {
@@ -649,9 +911,22 @@ func (a *apiService) FilesUpdateParts(ctx context.Context, req *api.FilePartsUpd
 		if err := tx.Where("id = ?", params.ID).First(&file).Error; err != nil {
 			return err
 		}
+		if len(updatePayload.Parts) > 0 {
+			if err := fillPartsFromUploads(tx, userId, req.UploadId.Value, updatePayload.Parts, *updatePayload.ChannelId); err != nil {
+				return err
+			}
+		}
 		if err := tx.Model(models.File{}).Where("id = ?", params.ID).Updates(updatePayload).Error; err != nil {
 			return err
 		}
+		if len(updatePayload.Parts) > 0 {
+			if err := tx.Model(&models.File{}).Where("id = ?", params.ID).Update("thumbnails", nil).Error; err != nil {
+				return err
+			}
+			if err := resetExtracted(tx, params.ID); err != nil {
+				return err
+			}
+		}
 		if req.UploadId.Value != "" {
 			if err := tx.Where("upload_id = ?", req.UploadId.Value).Delete(&models.Upload{}).Error; err != nil {
 				return err
@@ -667,9 +942,13 @@ func (a *apiService) FilesUpdateParts(ctx context.Context, req *api.FilePartsUpd
 
 	keys := []string{cache.Key("files", params.ID)}
 	if len(file.Parts) > 0 && file.ChannelId != nil {
-		ids := utils.Map(file.Parts, func(part api.Part) int { return part.ID })
-		client, _ := tgc.AuthClient(ctx, &a.cnf.TG, auth.GetJWTUser(ctx).TgSession, a.middlewares...)
-		tgc.DeleteMessages(ctx, client, *file.ChannelId, ids)
+		for channelId, ids := range partIdsByChannel(file.Parts, *file.ChannelId) {
+			client, _ := tgc.AuthClient(ctx, &a.cnf.TG, auth.GetJWTUser(ctx).TgSession, a.middlewares...)
+			tgc.DeleteMessages(ctx, client, channelId, ids)
+		}
+		if len(updatePayload.Parts) > 0 {
+			a.dropThumbnails(ctx, userId, file.Thumbnails)
+		}
 		keys = append(keys, cache.Key("files", "messages", params.ID))
 		for _, part := range file.Parts {
 			keys = append(keys, cache.Key("files", "location", params.ID, part.ID))
@@ -681,34 +960,42 @@ func (a *apiService) FilesUpdateParts(ctx context.Context, req *api.FilePartsUpd
 	return nil
 }
 
+// requestSession authenticates requests browsers make without headers, like media
+// sources, through the hash query parameter or the auth cookie.
+func (e *extendedService) requestSession(w http.ResponseWriter, r *http.Request) (*models.Session, bool) {
+	authHash := r.URL.Query().Get("hash")
+	if authHash != "" {
+		session, err := auth.GetSessionByHash(e.api.db, e.api.cache, authHash)
+		if err != nil {
+			http.Error(w, "invalid hash", http.StatusBadRequest)
+			return nil, false
+		}
+		return session, true
+	}
+	cookie, err := r.Cookie(authCookieName)
+	if err != nil {
+		http.Error(w, "missing token or authash", http.StatusUnauthorized)
+		return nil, false
+	}
+	user, err := auth.VerifyUser(e.api.db, e.api.cache, e.api.cnf.JWT.Secret, cookie.Value)
+	if err != nil {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return nil, false
+	}
+	userId, _ := strconv.ParseInt(user.Subject, 10, 64)
+	return &models.Session{UserId: userId, Session: user.TgSession, Hash: user.Hash}, true
+}
+
 func (e *extendedService) FilesStream(w http.ResponseWriter, r *http.Request, fileId string, userId int64) {
 	ctx := r.Context()
 	var (
 		session *models.Session
 		err     error
-		user    *types.JWTClaims
 	)
 	if userId == 0 {
-
-		authHash := r.URL.Query().Get("hash")
-		if authHash == "" {
-			cookie, err := r.Cookie(authCookieName)
-			if err != nil {
-				http.Error(w, "missing token or authash", http.StatusUnauthorized)
-				return
-			}
-			user, err = auth.VerifyUser(e.api.db, e.api.cache, e.api.cnf.JWT.Secret, cookie.Value)
-			if err != nil {
-				http.Error(w, "invalid token", http.StatusUnauthorized)
-			}
-			userId, _ := strconv.ParseInt(user.Subject, 10, 64)
-			session = &models.Session{UserId: userId, Session: user.TgSession}
-		} else {
-			session, err = auth.GetSessionByHash(e.api.db, e.api.cache, authHash)
-			if err != nil {
-				http.Error(w, "invalid hash", http.StatusBadRequest)
-				return
-			}
+		var ok bool
+		if session, ok = e.requestSession(w, r); !ok {
+			return
 		}
 	} else {
 		session = &models.Session{UserId: userId}
@@ -727,6 +1014,33 @@ func (e *extendedService) FilesStream(w http.ResponseWriter, r *http.Request, fi
 		return
 	}
 
+	// Files other users shared are streamed through the owner's bots and channel.
+	viewerId := session.UserId
+	if userId == 0 && file.UserId != session.UserId {
+		if _, _, err := fileAccess(e.api.db, session.UserId, fileId); err != nil {
+			http.Error(w, ErrFileNotFound.Error(), http.StatusNotFound)
+			return
+		}
+		session = &models.Session{UserId: file.UserId, Hash: session.Hash}
+	}
+	if userId == 0 && r.Method != http.MethodHead {
+		e.api.recordFileOpen(viewerId, fileId)
+	}
+
+	if *file.Encrypted && slices.ContainsFunc(file.Parts, func(part api.Part) bool {
+		return part.KeyId.Value == userKeyId
+	}) {
+		// Keys are unlocked for the owner's sessions only, no one else can unlock them.
+		if userId != 0 || viewerId != file.UserId {
+			http.Error(w, errOwnerKeyRequired.Error(), http.StatusForbidden)
+			return
+		}
+		if _, err := e.api.unlockedUserKey(file.UserId, session.Hash); err != nil {
+			http.Error(w, err.Error(), http.StatusLocked)
+			return
+		}
+	}
+
 	w.Header().Set("Accept-Ranges", "bytes")
 
 	var start, end int64
@@ -790,12 +1104,12 @@ func (e *extendedService) FilesStream(w http.ResponseWriter, r *http.Request, fi
 
 	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": file.Name}))
 
-	w.WriteHeader(status)
-
-	if r.Method == "HEAD" {
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
 		return
 	}
 
+	// The client is set up before the headers are sent, so failures get their status.
 	tokens, err := getBotsToken(e.api.db, e.api.cache, session.UserId, *file.ChannelId)
 
 	if err != nil {
@@ -815,25 +1129,37 @@ func (e *extendedService) FilesStream(w http.ResponseWriter, r *http.Request, fi
 		tgc.WithRecovery(ctx),
 		tgc.WithRetry(5),
 		tgc.WithRateLimit())
-	if e.api.cnf.TG.DisableStreamBots || len(tokens) == 0 {
-		client, err = tgc.AuthClient(ctx, &e.api.cnf.TG, session.Session, middlewares...)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		multiThreads = 0
-
-	} else {
+	if !e.api.cnf.TG.DisableStreamBots && len(tokens) > 0 {
 		e.api.worker.Set(tokens, *file.ChannelId)
 
-		token, _ = e.api.worker.Next(*file.ChannelId)
-
-		client, err = tgc.BotClient(ctx, e.api.tgdb, &e.api.cnf.TG, token, middlewares...)
+		if token, _, err = e.api.worker.Next(*file.ChannelId); err == nil {
+			client, err = tgc.BotClient(ctx, e.api.tgdb, &e.api.cnf.TG, token, middlewares...)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	if client == nil {
+		if session.Session == "" {
+			// Shared files and public shares are read with the owner's session, the viewer's
+			// doesn't reach the owner's channel.
+			owner, err := e.api.ownerSession(file.UserId)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+			session.Session = owner.Session
+		}
+		client, err = tgc.AuthClient(ctx, &e.api.cnf.TG, session.Session, middlewares...)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+		multiThreads = 0
 	}
+	w.WriteHeader(status)
+
 	if download {
 		multiThreads = 0
 	}
@@ -845,7 +1171,8 @@ func (e *extendedService) FilesStream(w http.ResponseWriter, r *http.Request, fi
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return nil
 			}
-			lr, err = reader.NewLinearReader(ctx, client.API(), e.api.cache, file, parts, start, end, &e.api.cnf.TG, multiThreads)
+			lr, err = reader.NewLinearReader(ctx, client.API(), e.api.cache, file, parts, start, end, &e.api.cnf.TG,
+				e.api.partKeys(file.UserId, session.Hash), multiThreads)
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
 				return nil
@@ -876,10 +1203,151 @@ func (e *extendedService) SharesStream(w http.ResponseWriter, r *http.Request, s
 		return
 	}
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		http.Error(w, err.Error(), shareErrorStatus(err))
+		return
+	}
+	if share.Mode == shareModeUpload {
+		http.Error(w, ErrShareUploadOnly.Error(), http.StatusForbidden)
 		return
 	}
-	e.FilesStream(w, r, fileId, share.UserId)
+	if ok, err := e.api.shareContains(share, fileId); err != nil || !ok {
+		http.Error(w, ErrFileNotFound.Error(), http.StatusNotFound)
+		return
+	}
+	if r.Method == http.MethodGet {
+		var sizes []*int64
+		if err := e.api.db.Model(&models.File{}).Where("id = ?", fileId).Pluck("size", &sizes).Error; err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(sizes) > 0 && readsFirstByte(r, sizes[0]) {
+			if err := e.api.countShareDownload(share); err != nil {
+				http.Error(w, err.Error(), shareErrorStatus(err))
+				return
+			}
+		}
+	}
+	cw := &countingWriter{ResponseWriter: w}
+	e.FilesStream(cw, r, fileId, share.UserId)
+	if r.Method == http.MethodGet {
+		e.api.recordShareAccess(r, share.ID, shareAccessDownload, cw.n)
+	}
+}
+
+// readsFirstByte reports whether a request is served the first byte of a file of the
+// given size. Every copy of the file needs that byte, so counting these requests
+// counts each download however it is split into ranges, while the further requests
+// players seek with are not counted. Ranges are read as FilesStream serves them.
+func readsFirstByte(r *http.Request, size *int64) bool {
+	rangeHeader := r.Header.Get("Range")
+	if rangeHeader == "" || size == nil || *size == 0 {
+		return true
+	}
+	ranges, err := http_range.Parse(rangeHeader, *size)
+	if err != nil || len(ranges) > 1 {
+		// Refused by FilesStream.
+		return false
+	}
+	return ranges[0].Start == 0
+}
+
+func shareErrorStatus(err error) int {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) && apiErr.code != 0 {
+		return apiErr.code
+	}
+	return http.StatusUnauthorized
+}
+
+type countingWriter struct {
+	http.ResponseWriter
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	w.n += int64(n)
+	return n, err
+}
+
+// fillPartsFromUploads sets what the server knows about uploaded parts from their upload
+// records: the channel of parts uploaded outside the file channel and the key and format
+// they were encrypted with, which older clients don't send back. Without an upload id
+// the records are matched by the user's part ids, preferring the file channel when a
+// message id was uploaded to several channels.
+func fillPartsFromUploads(tx *gorm.DB, userId int64, uploadId string, parts []api.Part, channelId int64) error {
+	query := tx.Where("user_id = ?", userId)
+	if uploadId != "" {
+		query = query.Where("upload_id = ?", uploadId)
+	} else {
+		query = query.Where("part_id IN ?", utils.Map(parts, func(p api.Part) int { return p.ID }))
+	}
+	var uploads []models.Upload
+	if err := query.Find(&uploads).Error; err != nil {
+		return err
+	}
+	byPart := make(map[int][]models.Upload, len(uploads))
+	for _, upload := range uploads {
+		byPart[upload.PartId] = append(byPart[upload.PartId], upload)
+	}
+	for i := range parts {
+		upload := partUpload(byPart[parts[i].ID], parts[i], channelId)
+		if upload == nil {
+			continue
+		}
+		if !parts[i].ChannelId.IsSet() && upload.ChannelId != channelId {
+			parts[i].ChannelId = api.NewOptInt64(upload.ChannelId)
+		}
+		if !upload.Encrypted {
+			continue
+		}
+		parts[i].KeyId, parts[i].Format = api.OptString{}, api.OptEncryptionFormat{}
+		if upload.KeyId != "" {
+			parts[i].KeyId = api.NewOptString(upload.KeyId)
+		}
+		if upload.Format != "" {
+			parts[i].Format = api.NewOptEncryptionFormat(api.EncryptionFormat(upload.Format))
+		}
+		if !parts[i].Salt.IsSet() && upload.Salt != "" {
+			parts[i].Salt = api.NewOptString(upload.Salt)
+		}
+	}
+	return nil
+}
+
+// partUpload picks the upload record of a part among the records sharing its message id.
+func partUpload(uploads []models.Upload, part api.Part, channelId int64) *models.Upload {
+	want := part.ChannelId.Or(channelId)
+	for i := range uploads {
+		if uploads[i].ChannelId == want {
+			return &uploads[i]
+		}
+	}
+	if len(uploads) == 1 && !part.ChannelId.IsSet() {
+		return &uploads[0]
+	}
+	return nil
+}
+
+// mergeProperties merges properties into the ones stored on a file, keys set to null
+// are removed.
+func mergeProperties(tx *gorm.DB, fileId string, properties api.FileUpdateProperties) error {
+	set := api.FileUpdateProperties{}
+	var removed []any
+	for key, value := range properties {
+		if strings.TrimSpace(string(value)) == "null" {
+			removed = append(removed, key)
+			continue
+		}
+		set[key] = value
+	}
+	data, err := set.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	expr := "nullif((coalesce(properties, '{}'::jsonb) || ?::jsonb)" + strings.Repeat(" - ?::text", len(removed)) + ", '{}'::jsonb)"
+	return tx.Model(&models.File{}).Where("id = ?", fileId).
+		Update("properties", gorm.Expr(expr, append([]any{string(data)}, removed...)...)).Error
 }
 
 func mapParts(_parts []api.Part) []api.Part {
@@ -888,6 +1356,15 @@ func mapParts(_parts []api.Part) []api.Part {
 		if part.Salt.Value != "" {
 			p.Salt = part.Salt
 		}
+		if part.ChannelId.Value != 0 {
+			p.ChannelId = part.ChannelId
+		}
+		if part.KeyId.Value != "" {
+			p.KeyId = part.KeyId
+		}
+		if part.Format.Value != "" {
+			p.Format = part.Format
+		}
 		return p
 	})
 
@@ -1,14 +1,21 @@
 package services
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/WinterYukky/gorm-extra-clause-plugin/exclause"
 	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/database"
+	"github.com/tgdrive/teldrive/internal/searchquery"
 	"github.com/tgdrive/teldrive/internal/utils"
 	"github.com/tgdrive/teldrive/pkg/mapper"
 	"github.com/tgdrive/teldrive/pkg/models"
@@ -18,44 +25,134 @@ import (
 
 type fileQueryBuilder struct {
 	db *gorm.DB
+	// names is set for the owner's listings, where encrypted names are revealed and
+	// can be matched exactly.
+	names *userNames
+	// ownerNames resolves the decrypter per owner, for listings that include items
+	// other users shared.
+	ownerNames func(userId int64) *userNames
+	// callerId is the user the listing is made for, whose stars and recent files are
+	// matched. It differs from the owner when listing a folder another user shared.
+	callerId int64
+	// storedPath converts the readable paths of the query to the stored ones the path
+	// lookups compare against.
+	storedPath func(userId int64, path string) (string, error)
 }
 
-type fileResponse struct {
-	models.File
-	Total int
-}
-
-var selectedFields = []string{"id", "name", "type", "mime_type", "category", "channel_id", "encrypted", "size", "parent_id", "updated_at"}
+var selectedFields = []string{"id", "name", "type", "mime_type", "category", "channel_id", "encrypted", "size", "parent_id", "updated_at",
+	"name_encrypted", "name_key_id", "encrypt_names", "user_id", "properties", "thumbnails"}
 
 func (afb *fileQueryBuilder) execute(filesQuery *api.FilesListParams, userId int64) (*api.FileList, error) {
-	query := afb.db.Where("user_id = ?", userId).Where("status = ?", filesQuery.Status.Value)
-	switch filesQuery.Operation.Value {
-	case api.FileQueryOperationList:
-		query = afb.applyListFilters(query, filesQuery, userId)
-	case api.FileQueryOperationFind:
-		query = afb.applyFindFilters(query, filesQuery, userId)
-
+	if filesQuery.Path.Value != "" && afb.storedPath != nil {
+		path, err := afb.storedPath(userId, filesQuery.Path.Value)
+		if err != nil {
+			return nil, &apiError{err: err}
+		}
+		params := *filesQuery
+		params.Path.Value = path
+		filesQuery = &params
+	}
+	query := afb.db.Where("status = ?", filesQuery.Status.Value)
+	if filesQuery.SharedWithMe.Value {
+		if hasFilters(filesQuery) {
+			return nil, &apiError{err: errors.New("filters can't be combined with sharedWithMe"), code: http.StatusBadRequest}
+		}
+		query = query.Where("id in (SELECT file_id FROM teldrive.file_permissions WHERE user_id = ?)", userId)
+	} else {
+		query = query.Where("user_id = ?", userId)
+		switch filesQuery.Operation.Value {
+		case api.FileQueryOperationList:
+			query = afb.applyListFilters(query, filesQuery, userId)
+		case api.FileQueryOperationFind:
+			var err error
+			if query, err = afb.applyFindFilters(query, filesQuery, userId); err != nil {
+				return nil, err
+			}
+		}
+		query = afb.applyTagFilter(query, filesQuery, userId)
+		query = afb.applyPropertyFilter(query, filesQuery)
+		var err error
+		if query, err = afb.applyMediaFilter(query, filesQuery); err != nil {
+			return nil, err
+		}
+		if query, err = afb.applyStructuredFilter(query, filesQuery, userId); err != nil {
+			return nil, err
+		}
+		if filesQuery.Starred.Value {
+			query = query.Where("id in (?)", starredFiles(afb.db, afb.callerId))
+		}
+		if filesQuery.Recent.Value {
+			query = query.Where("id in (?)", recentFiles(afb.db, afb.callerId))
+		}
+	}
+	listQuery, err := afb.buildFileQuery(query, filesQuery, userId)
+	if err != nil {
+		return nil, err
+	}
+	res := []models.File{}
+	if err := listQuery.Find(&res).Error; err != nil {
+		return nil, listError(err)
 	}
-	query = afb.buildFileQuery(query, filesQuery, userId)
-	res := []fileResponse{}
-	if err := query.Scan(&res).Error; err != nil {
-		if strings.Contains(err.Error(), "file not found") {
-			return nil, &apiError{err: errors.New("invalid path"), code: 404}
+	meta := api.Meta{CurrentPage: filesQuery.Page.Value}
+	if !filesQuery.SkipCount.Value {
+		var count int64
+		if err := afb.buildSubqueryCTE(filesQuery, userId).Model(&models.File{}).Where(query).
+			Count(&count).Error; err != nil {
+			return nil, listError(err)
 		}
+		meta.Count = api.NewOptInt(int(count))
+		meta.TotalPages = api.NewOptInt(int(math.Ceil(float64(count) / float64(filesQuery.Limit.Value))))
+	}
+	// One extra row is read to know whether there is a next page.
+	hasMore := len(res) > filesQuery.Limit.Value
+	if hasMore {
+		res = res[:filesQuery.Limit.Value]
+	}
+
+	media, err := loadMediaInfo(afb.db, utils.Map(res, func(item models.File) string { return item.ID }))
+	if err != nil {
 		return nil, &apiError{err: err}
 	}
-	count := 0
+	for i := range res {
+		res[i].Media = media[res[i].ID]
+	}
+	if hasMore {
+		meta.NextCursor = api.NewOptString(encodeFileCursor(filesQuery, &res[len(res)-1]))
+	}
 
-	if len(res) > 0 {
-		count = res[0].Total
+	var names mapper.NameDecrypter
+	if afb.names != nil {
+		names = afb.names
+	}
+	files := utils.Map(res, func(item models.File) api.File {
+		if afb.ownerNames != nil {
+			return *mapper.ToFileOut(item, afb.ownerNames(item.UserId))
+		}
+		return *mapper.ToFileOut(item, names)
+	})
+
+	if filesQuery.SearchType.Value == api.FileQuerySearchTypeContent && filesQuery.Query.Value != "" {
+		snippets, err := contentSnippets(afb.db, utils.Map(res, func(item models.File) string { return item.ID }),
+			filesQuery.Query.Value)
+		if err != nil {
+			return nil, listError(err)
+		}
+		for i := range files {
+			files[i].Snippets = snippets[files[i].ID.Value]
+		}
 	}
 
-	files := utils.Map(res, func(item fileResponse) api.File { return *mapper.ToFileOut(item.File) })
+	return &api.FileList{Items: files, Meta: meta}, nil
+}
 
-	return &api.FileList{Items: files,
-		Meta: api.Meta{Count: count,
-			TotalPages:  int(math.Ceil(float64(count) / float64(filesQuery.Limit.Value))),
-			CurrentPage: filesQuery.Page.Value}}, nil
+// hasFilters reports whether a listing narrows the files down beyond a folder, which
+// the listing of the items shared with the user doesn't support.
+func hasFilters(filesQuery *api.FilesListParams) bool {
+	return filesQuery.Operation.Value == api.FileQueryOperationFind || filesQuery.Query.Value != "" ||
+		filesQuery.Filter.Value != "" || len(filesQuery.Category) > 0 || len(filesQuery.Tags) > 0 ||
+		len(filesQuery.Properties) > 0 || filesQuery.TakenAt.Value != "" || filesQuery.Camera.Value != "" ||
+		filesQuery.Duration.Value != "" || filesQuery.HasLocation.IsSet() || filesQuery.Starred.Value ||
+		filesQuery.Recent.Value
 }
 
 func (afb *fileQueryBuilder) applyListFilters(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) *gorm.DB {
@@ -68,12 +165,15 @@ func (afb *fileQueryBuilder) applyListFilters(query *gorm.DB, filesQuery *api.Fi
 	return query
 }
 
-func (afb *fileQueryBuilder) applyFindFilters(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) *gorm.DB {
+func (afb *fileQueryBuilder) applyFindFilters(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) (*gorm.DB, error) {
 	if filesQuery.DeepSearch.Value && filesQuery.Query.Value != "" && filesQuery.Path.Value != "" {
 		query = query.Where("files.id in (select id  from subdirs)")
 	}
 	if filesQuery.UpdatedAt.Value != "" {
-		query, _ = afb.applyDateFilters(query, filesQuery.UpdatedAt.Value)
+		var err error
+		if query, err = afb.applyDateFilters(query, "updated_at", filesQuery.UpdatedAt.Value); err != nil {
+			return nil, err
+		}
 	}
 
 	if filesQuery.Query.Value != "" {
@@ -84,12 +184,12 @@ func (afb *fileQueryBuilder) applyFindFilters(query *gorm.DB, filesQuery *api.Fi
 
 	query = afb.applyFileSpecificFilters(query, filesQuery, userId)
 
-	return query
+	return query, nil
 }
 
 func (afb *fileQueryBuilder) applyFileSpecificFilters(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) *gorm.DB {
 	if filesQuery.Name.Value != "" {
-		query = query.Where("name = ?", filesQuery.Name.Value)
+		query = query.Where(afb.nameMatch("name = ?", filesQuery.Name.Value))
 	}
 
 	if filesQuery.ParentId.Value != "" {
@@ -111,57 +211,232 @@ func (afb *fileQueryBuilder) applyFileSpecificFilters(query *gorm.DB, filesQuery
 	}
 
 	if filesQuery.Shared.Value {
-		query = query.Where("id in (SELECT file_id FROM teldrive.file_shares where user_id = ?)", userId)
+		query = query.Where(`id in (SELECT file_id FROM teldrive.file_shares where user_id = ?
+			UNION SELECT i.file_id FROM teldrive.file_share_items i
+			JOIN teldrive.file_shares s ON s.id = i.share_id WHERE s.user_id = ?)`, userId, userId)
+	}
+
+	return query
+}
+
+// applyTagFilter keeps files carrying any or all of the requested tags.
+func (afb *fileQueryBuilder) applyTagFilter(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) *gorm.DB {
+	if len(filesQuery.Tags) == 0 {
+		return query
+	}
+	tags := utils.Map(filesQuery.Tags, strings.ToLower)
+	tagged := afb.db.Table("teldrive.file_tags as ft").Select("ft.file_id").
+		Joins("JOIN teldrive.tags t ON t.id = ft.tag_id").
+		Where("t.user_id = ?", userId).Where("lower(t.name) IN ?", tags).
+		Group("ft.file_id")
+	if filesQuery.TagMatch.Value == api.FileQueryTagMatchAll {
+		tagged = tagged.Having("count(DISTINCT lower(t.name)) = ?", len(slices.Compact(slices.Sorted(slices.Values(tags)))))
 	}
+	return query.Where("id in (?)", tagged)
+}
 
+// applyPropertyFilter matches key=value filters by containment and bare keys by
+// existence, both of which can use the GIN index on properties.
+func (afb *fileQueryBuilder) applyPropertyFilter(query *gorm.DB, filesQuery *api.FilesListParams) *gorm.DB {
+	for _, filter := range filesQuery.Properties {
+		key, value, hasValue := strings.Cut(filter, "=")
+		if key == "" {
+			continue
+		}
+		if !hasValue {
+			path, _ := json.Marshal(key)
+			query = query.Where("properties @@ ?::jsonpath", fmt.Sprintf("exists($.%s)", path))
+			continue
+		}
+		text, _ := json.Marshal(map[string]string{key: value})
+		match := afb.db.Where("properties @> ?::jsonb", string(text))
+		// Values that are valid JSON, like numbers and booleans, also match their typed form.
+		if json.Valid([]byte(value)) {
+			typed, _ := json.Marshal(map[string]json.RawMessage{key: json.RawMessage(value)})
+			match = match.Or("properties @> ?::jsonb", string(typed))
+		}
+		query = query.Where(match)
+	}
 	return query
 }
 
-func (afb *fileQueryBuilder) applyDateFilters(query *gorm.DB, dateFilters string) (*gorm.DB, error) {
+// applyMediaFilter matches the metadata read from photos, videos and audio files.
+func (afb *fileQueryBuilder) applyMediaFilter(query *gorm.DB, filesQuery *api.FilesListParams) (*gorm.DB, error) {
+	if !filesQuery.TakenAt.IsSet() && !filesQuery.Camera.IsSet() && !filesQuery.Duration.IsSet() &&
+		!filesQuery.HasLocation.IsSet() {
+		return query, nil
+	}
+	media := afb.db.Model(&models.MediaInfo{}).Select("file_id")
+	if filesQuery.TakenAt.Value != "" {
+		var err error
+		if media, err = afb.applyDateFilters(media, "taken_at", filesQuery.TakenAt.Value); err != nil {
+			return nil, err
+		}
+	}
+	if filesQuery.Camera.Value != "" {
+		pattern := "%" + filesQuery.Camera.Value + "%"
+		media = media.Where(afb.db.Where("camera_make ILIKE ?", pattern).Or("camera_model ILIKE ?", pattern))
+	}
+	if filesQuery.Duration.Value != "" {
+		for _, filter := range strings.Split(filesQuery.Duration.Value, ",") {
+			op, value, _ := strings.Cut(filter, ":")
+			seconds, err := strconv.ParseFloat(value, 64)
+			sqlOp, ok := comparisonOps[op]
+			if !ok || err != nil {
+				return nil, invalidFilter("duration", filter)
+			}
+			media = media.Where(fmt.Sprintf("duration %s ?", sqlOp), seconds)
+		}
+	}
+	if filesQuery.HasLocation.IsSet() {
+		if filesQuery.HasLocation.Value {
+			media = media.Where("latitude IS NOT NULL")
+		} else {
+			media = media.Where("latitude IS NULL")
+		}
+	}
+	return query.Where("id in (?)", media), nil
+}
+
+var comparisonOps = map[string]string{"gte": ">=", "lte": "<=", "eq": "=", "gt": ">", "lt": "<"}
+
+func (afb *fileQueryBuilder) applyDateFilters(query *gorm.DB, column, dateFilters string) (*gorm.DB, error) {
 	dateFiltersArr := strings.Split(dateFilters, ",")
 	for _, dateFilter := range dateFiltersArr {
-		query = afb.applySingleDateFilter(query, dateFilter)
+		var err error
+		if query, err = afb.applySingleDateFilter(query, column, dateFilter); err != nil {
+			return nil, err
+		}
 	}
 	return query, nil
 }
 
-func (afb *fileQueryBuilder) applySingleDateFilter(query *gorm.DB, dateFilter string) *gorm.DB {
+func (afb *fileQueryBuilder) applySingleDateFilter(query *gorm.DB, column, dateFilter string) (*gorm.DB, error) {
 	parts := strings.Split(dateFilter, ":")
 	if len(parts) != 2 {
-		return query
+		return nil, invalidFilter(column, dateFilter)
 	}
 	op, date := parts[0], parts[1]
 	t, err := time.Parse(time.DateOnly, date)
 	if err != nil {
-		return query
+		return nil, invalidFilter(column, dateFilter)
+	}
+	sqlOp, ok := comparisonOps[op]
+	if !ok {
+		return nil, invalidFilter(column, dateFilter)
 	}
 
 	formattedDate := t.Format(time.RFC3339)
-	switch op {
-	case "gte":
-		query = query.Where("updated_at >= ?", formattedDate)
-	case "lte":
-		query = query.Where("updated_at <= ?", formattedDate)
-	case "eq":
-		query = query.Where("updated_at = ?", formattedDate)
-	case "gt":
-		query = query.Where("updated_at > ?", formattedDate)
-	case "lt":
-		query = query.Where("updated_at < ?", formattedDate)
+	return query.Where(fmt.Sprintf("%s %s ?", column, sqlOp), formattedDate), nil
+}
+
+func invalidFilter(name, value string) error {
+	return &apiError{err: fmt.Errorf("invalid %s filter %q", name, value), code: http.StatusBadRequest}
+}
+
+// applyStructuredFilter applies the terms of the filter query, see package searchquery
+// for its syntax. Extensions can't be matched on encrypted names.
+func (afb *fileQueryBuilder) applyStructuredFilter(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) (*gorm.DB, error) {
+	if filesQuery.Filter.Value == "" {
+		return query, nil
+	}
+	terms, err := searchquery.Parse(filesQuery.Filter.Value)
+	if err != nil {
+		return nil, &apiError{err: err, code: http.StatusBadRequest}
+	}
+	for _, term := range terms {
+		if term.Field == searchquery.FieldIn && !isUUID(term.Values[0]) && afb.storedPath != nil {
+			if term.Values[0], err = afb.storedPath(userId, term.Values[0]); err != nil {
+				return nil, &apiError{err: err}
+			}
+		}
+		condition, args := termCondition(term, userId)
+		if term.Negate {
+			// Columns that are null don't match the term, so they match its negation.
+			condition = fmt.Sprintf("NOT coalesce((%s), false)", condition)
+		}
+		query = query.Where(condition, args...)
+	}
+	return query, nil
+}
+
+func termCondition(term searchquery.Term, userId int64) (string, []any) {
+	switch term.Field {
+	case searchquery.FieldSize:
+		return fmt.Sprintf("size %s ?", term.Op), []any{term.Size}
+	case searchquery.FieldCreated, searchquery.FieldUpdated:
+		return dateCondition(string(term.Field)+"_at", term)
+	case searchquery.FieldMime:
+		conditions := make([]string, len(term.Values))
+		args := make([]any, len(term.Values))
+		for i, value := range term.Values {
+			conditions[i] = `mime_type ILIKE ? ESCAPE '\'`
+			args[i] = strings.ReplaceAll(mimeEscaper.Replace(value), "*", "%")
+		}
+		return "(" + strings.Join(conditions, " OR ") + ")", args
+	case searchquery.FieldExt:
+		return "NOT name_encrypted AND lower(name) ~ ?", []any{fmt.Sprintf(`\.(%s)$`, strings.Join(term.Values, "|"))}
+	case searchquery.FieldType:
+		return "type IN ?", []any{term.Values}
+	case searchquery.FieldCategory:
+		return "category IN ?", []any{term.Values}
+	default:
+		root, args := "?", []any{term.Values[0]}
+		if !isUUID(term.Values[0]) {
+			root, args = "(SELECT id FROM teldrive.get_file_from_path(?, ?, true))", []any{term.Values[0], userId}
+		}
+		return `id IN (WITH RECURSIVE tree AS (SELECT id FROM teldrive.files WHERE parent_id = ` + root + `
+			UNION ALL SELECT f.id FROM teldrive.files f JOIN tree ON f.parent_id = tree.id) SELECT id FROM tree)`, args
+	}
+}
+
+var mimeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// dateCondition compares a timestamp column, a date without a time covers the whole day.
+func dateCondition(column string, term searchquery.Term) (string, []any) {
+	if !term.Day {
+		return fmt.Sprintf("%s %s ?", column, term.Op), []any{term.Time}
+	}
+	next := term.Time.AddDate(0, 0, 1)
+	switch term.Op {
+	case searchquery.OpGt:
+		return column + " >= ?", []any{next}
+	case searchquery.OpGte:
+		return column + " >= ?", []any{term.Time}
+	case searchquery.OpLt:
+		return column + " < ?", []any{term.Time}
+	case searchquery.OpLte:
+		return column + " < ?", []any{next}
+	default:
+		return column + " >= ? AND " + column + " < ?", []any{term.Time, next}
 	}
-	return query
 }
 
 func (afb *fileQueryBuilder) applySearchQuery(query *gorm.DB, filesQuery *api.FilesListParams) *gorm.DB {
 	switch filesQuery.SearchType.Value {
 	case api.FileQuerySearchTypeText:
-		query = query.Where("name &@~ lower(regexp_replace(?, '[^[:alnum:]\\s]', ' ', 'g'))", filesQuery.Query.Value)
+		query = query.Where(afb.nameMatch("name &@~ lower(regexp_replace(?, '[^[:alnum:]\\s]', ' ', 'g'))", filesQuery.Query.Value))
 	case api.FileQuerySearchTypeRegex:
 		query = query.Where("name &~ ?", filesQuery.Query.Value)
+	case api.FileQuerySearchTypeContent:
+		query = query.Where("id in (SELECT file_id FROM teldrive.file_contents WHERE content &@~ ?)", filesQuery.Query.Value)
 	}
 	return query
 }
 
+// nameMatch extends a name condition so that encrypted names, which cannot be searched
+// by content, are matched exactly against the encrypted form of the value.
+func (afb *fileQueryBuilder) nameMatch(condition string, value string) *gorm.DB {
+	match := afb.db.Where(condition, value)
+	if afb.names == nil {
+		return match
+	}
+	for keyId, name := range afb.names.sealed(value) {
+		match = match.Or("name_encrypted AND name_key_id = ? AND name = ?", keyId, name)
+	}
+	return match
+}
+
 func (afb *fileQueryBuilder) applyCategoryFilter(query *gorm.DB, categories []api.Category) *gorm.DB {
 	if len(categories) == 0 {
 		return query
@@ -185,20 +460,27 @@ func (afb *fileQueryBuilder) applyCategoryFilter(query *gorm.DB, categories []ap
 	return query.Where(filterQuery)
 }
 
-func (afb *fileQueryBuilder) buildFileQuery(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) *gorm.DB {
-	orderField := utils.CamelToSnake(string(filesQuery.Sort.Value))
-	op := getOrderOperation(filesQuery)
-
-	return afb.buildSubqueryCTE(query, filesQuery, userId).Clauses(exclause.NewWith("ranked_scores", afb.db.Model(&models.File{}).Select(orderField, "count(*) OVER () as total",
-		fmt.Sprintf("ROW_NUMBER() OVER (ORDER BY %s %s) AS rank", orderField, strings.ToUpper(string(filesQuery.Order.Value)))).
-		Where(query))).Model(&models.File{}).
-		Select(selectedFields, "(select total from ranked_scores limit 1) as total").
-		Where(fmt.Sprintf("%s %s (SELECT %s FROM ranked_scores WHERE rank = ?)", orderField, op, orderField),
-			max((filesQuery.Page.Value-1)*filesQuery.Limit.Value, 1)).
-		Where(query).Order(getOrder(filesQuery)).Limit(filesQuery.Limit.Value)
+// buildFileQuery selects a page of the listing, the page after a cursor or else the
+// page by number. The id breaks ties between equal sort values, so pages neither skip
+// nor repeat files.
+func (afb *fileQueryBuilder) buildFileQuery(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) (*gorm.DB, error) {
+	listQuery := afb.buildSubqueryCTE(filesQuery, userId).Model(&models.File{}).Select(selectedFields).
+		Where(query).Order(getOrder(filesQuery)).Limit(filesQuery.Limit.Value + 1)
+	if filesQuery.Cursor.Value == "" {
+		return listQuery.Offset((filesQuery.Page.Value - 1) * filesQuery.Limit.Value), nil
+	}
+	value, id, err := decodeFileCursor(filesQuery)
+	if err != nil {
+		return nil, &apiError{err: err, code: http.StatusBadRequest}
+	}
+	op := ">"
+	if filesQuery.Order.Value == api.FileQueryOrderDesc {
+		op = "<"
+	}
+	return listQuery.Where(fmt.Sprintf("(%s, files.id) %s (?, ?)", orderExpression(filesQuery), op), value, id), nil
 }
 
-func (afb *fileQueryBuilder) buildSubqueryCTE(query *gorm.DB, filesQuery *api.FilesListParams, userId int64) *gorm.DB {
+func (afb *fileQueryBuilder) buildSubqueryCTE(filesQuery *api.FilesListParams, userId int64) *gorm.DB {
 	if filesQuery.DeepSearch.Value && filesQuery.Query.Value != "" && filesQuery.Path.Value != "" {
 		return afb.db.Clauses(exclause.With{Recursive: true, CTEs: []exclause.CTE{{Name: "subdirs",
 			Subquery: exclause.Subquery{DB: afb.db.Model(&models.File{}).Select("id", "parent_id").
@@ -207,26 +489,88 @@ func (afb *fileQueryBuilder) buildSubqueryCTE(query *gorm.DB, filesQuery *api.Fi
 					afb.db.Table("teldrive.files as f").Select("f.id", "f.parent_id").
 						Joins("inner join subdirs ON f.parent_id = subdirs.id")))}}}})
 	}
-	return query
+	return afb.db
+}
+
+func listError(err error) error {
+	if strings.Contains(err.Error(), "file not found") {
+		return &apiError{err: errors.New("invalid path"), code: 404}
+	}
+	if database.IsQuerySyntaxErr(err) {
+		return &apiError{err: errors.New("invalid search query"), code: 400}
+	}
+	return &apiError{err: err}
 }
 
 func getOrder(filesQuery *api.FilesListParams) string {
-	orderField := utils.CamelToSnake(string(filesQuery.Sort.Value))
-	return fmt.Sprintf("%s %s", orderField, strings.ToUpper(string(filesQuery.Order.Value)))
+	order := strings.ToUpper(string(filesQuery.Order.Value))
+	return fmt.Sprintf("%s %s, files.id %s", orderExpression(filesQuery), order, order)
 }
 
-func getOrderOperation(filesQuery *api.FilesListParams) string {
-	if filesQuery.Page.Value == 1 {
-		if filesQuery.Order.Value == api.FileQueryOrderAsc {
-			return ">="
-		} else {
-			return "<="
+// orderExpression returns what the listing is sorted by. Files without a capture time
+// are sorted by their update time, folders have a size of 0.
+func orderExpression(filesQuery *api.FilesListParams) string {
+	switch filesQuery.Sort.Value {
+	case api.FileQuerySortTakenAt:
+		return "coalesce((SELECT m.taken_at FROM teldrive.media_info m WHERE m.file_id = files.id), files.updated_at)"
+	case api.FileQuerySortSize:
+		return "coalesce(files.size, 0)"
+	}
+	return "files." + utils.CamelToSnake(string(filesQuery.Sort.Value))
+}
+
+// fileCursor is the position after the last file of a page. It holds the sort and order
+// it was made for, so it can't be used with another.
+type fileCursor struct {
+	Sort  api.FileQuerySort  `json:"sort"`
+	Order api.FileQueryOrder `json:"order"`
+	Value json.RawMessage    `json:"value"`
+	ID    string             `json:"id"`
+}
+
+func encodeFileCursor(filesQuery *api.FilesListParams, file *models.File) string {
+	var value any
+	switch filesQuery.Sort.Value {
+	case api.FileQuerySortName:
+		value = file.Name
+	case api.FileQuerySortUpdatedAt:
+		value = file.UpdatedAt
+	case api.FileQuerySortSize:
+		value = int64(0)
+		if file.Size != nil {
+			value = *file.Size
 		}
-	} else {
-		if filesQuery.Order.Value == api.FileQueryOrderAsc {
-			return ">"
-		} else {
-			return "<"
+	case api.FileQuerySortTakenAt:
+		value = file.UpdatedAt
+		if file.Media != nil && file.Media.TakenAt != nil {
+			value = *file.Media.TakenAt
 		}
+	default:
+		value = file.ID
+	}
+	data, _ := json.Marshal(value)
+	return encodeCursor(fileCursor{Sort: filesQuery.Sort.Value, Order: filesQuery.Order.Value, Value: data, ID: file.ID})
+}
+
+func decodeFileCursor(filesQuery *api.FilesListParams) (any, string, error) {
+	var cursor fileCursor
+	if err := decodeCursor(filesQuery.Cursor.Value, &cursor); err != nil {
+		return nil, "", err
+	}
+	if cursor.Sort != filesQuery.Sort.Value || cursor.Order != filesQuery.Order.Value || !isUUID(cursor.ID) {
+		return nil, "", ErrInvalidCursor
+	}
+	var value any
+	switch cursor.Sort {
+	case api.FileQuerySortUpdatedAt, api.FileQuerySortTakenAt:
+		value = &time.Time{}
+	case api.FileQuerySortSize:
+		value = new(int64)
+	default:
+		value = new(string)
+	}
+	if err := json.Unmarshal(cursor.Value, value); err != nil {
+		return nil, "", ErrInvalidCursor
 	}
+	return reflect.ValueOf(value).Elem().Interface(), cursor.ID, nil
 }
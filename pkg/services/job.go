@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/jobs"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"go.uber.org/zap"
+)
+
+const (
+	kindChannelMigration = "channel_migration"
+	kindReencryption     = "reencryption"
+	kindChannelImport    = "channel_import"
+)
+
+// StartBackgroundJobs runs the job workers until ctx is done.
+func (a *apiService) StartBackgroundJobs(ctx context.Context) {
+	a.jobs.Register(
+		jobs.NewKind(kindChannelMigration, a.runMigration),
+		jobs.NewKind(kindReencryption, a.runReencryption),
+		jobs.NewKind(kindChannelImport, a.runChannelImport),
+	)
+	if err := a.jobs.Start(ctx); err != nil {
+		logging.FromContext(ctx).Error("failed to start job workers", zap.Error(err))
+	}
+}
+
+func (a *apiService) JobsList(ctx context.Context, params api.JobsListParams) ([]api.Job, error) {
+	res, err := a.jobs.List(auth.GetUser(ctx), params.Kind.Value, string(params.Status.Value))
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	return utils.Map(res, func(job models.Job) api.Job {
+		return *toJobOut(&job)
+	}), nil
+}
+
+func (a *apiService) JobsGet(ctx context.Context, params api.JobsGetParams) (*api.Job, error) {
+	job, err := a.jobs.Get(auth.GetUser(ctx), params.ID)
+	if err != nil {
+		return nil, jobError(err)
+	}
+	return toJobOut(job), nil
+}
+
+func (a *apiService) JobsCancel(ctx context.Context, params api.JobsCancelParams) (*api.Job, error) {
+	job, err := a.jobs.Cancel(auth.GetUser(ctx), params.ID)
+	if err != nil {
+		return nil, jobError(err)
+	}
+	return toJobOut(job), nil
+}
+
+func (a *apiService) JobsRetry(ctx context.Context, params api.JobsRetryParams) (*api.Job, error) {
+	job, err := a.jobs.Retry(auth.GetUser(ctx), params.ID)
+	if err != nil {
+		return nil, jobError(err)
+	}
+	return toJobOut(job), nil
+}
+
+func jobError(err error) error {
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return &apiError{err: err, code: http.StatusNotFound}
+	case errors.Is(err, jobs.ErrInvalidStatus):
+		return &apiError{err: err, code: http.StatusConflict}
+	}
+	return &apiError{err: err}
+}
+
+func toJobOut(job *models.Job) *api.Job {
+	res := &api.Job{
+		ID:        job.ID,
+		Kind:      job.Kind,
+		Status:    api.JobStatus(job.Status),
+		Total:     job.Total,
+		Done:      job.Done,
+		Attempt:   job.Attempt,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+	if job.Error != "" {
+		res.Error = api.NewOptString(job.Error)
+	}
+	return res
+}
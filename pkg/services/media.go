@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gotd/td/telegram"
+	"github.com/tgdrive/teldrive/internal/category"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/media"
+	"github.com/tgdrive/teldrive/internal/reader"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// mediaBlockSize is the unit header ranges are downloaded and kept in.
+	mediaBlockSize = 256 << 10
+
+	mediaBatchSize = 50
+)
+
+var mediaCategories = []string{string(category.Image), string(category.Video), string(category.Audio)}
+
+// mediaSlots bounds the extractions started after uploads, the backfill picks up
+// whatever doesn't get a slot.
+var mediaSlots = make(chan struct{}, 2)
+
+// queueMediaInfo starts reading the metadata of a new media file when a slot is free.
+func (a *apiService) queueMediaInfo(ctx context.Context, file models.File) {
+	if !canReadMedia(&file) {
+		return
+	}
+	select {
+	case mediaSlots <- struct{}{}:
+	default:
+		return
+	}
+	go func() {
+		defer func() { <-mediaSlots }()
+		if err := a.tryExtractMediaInfo(context.WithoutCancel(ctx), &file); err != nil {
+			logging.FromContext(ctx).Warn("failed to read media info", zap.String("fileId", file.ID), zap.Error(err))
+		}
+	}()
+}
+
+// BackfillMediaInfo reads the metadata of media files stored before it was extracted,
+// a batch per run.
+func (a *apiService) BackfillMediaInfo(ctx context.Context) {
+	var files []models.File
+	if err := a.db.Where("type = ?", "file").Where("status = ?", "active").
+		Where("category IN ?", mediaCategories).Where("size > 0").
+		Where("NOT EXISTS (SELECT 1 FROM teldrive.media_info m WHERE m.file_id = files.id)").
+		Where("id > ?", a.mediaCursor).
+		Order("id").Limit(mediaBatchSize).Find(&files).Error; err != nil {
+		return
+	}
+	if len(files) < mediaBatchSize {
+		a.mediaCursor = uuid.Nil.String()
+	} else {
+		a.mediaCursor = files[len(files)-1].ID
+	}
+	logger := logging.FromContext(ctx)
+	for i := range files {
+		if ctx.Err() != nil {
+			return
+		}
+		if !canReadMedia(&files[i]) {
+			continue
+		}
+		if err := a.tryExtractMediaInfo(ctx, &files[i]); err != nil {
+			logger.Warn("failed to read media info", zap.String("fileId", files[i].ID), zap.Error(err))
+		}
+	}
+}
+
+// extractMediaInfo parses the headers of a file and stores what they hold. Formats
+// that can't be parsed get an empty row, failed downloads are retried by the backfill.
+// tryExtractMediaInfo extracts the metadata of a file, turning a panic of the parsers
+// on a malformed file into an error so it doesn't take down the server.
+func (a *apiService) tryExtractMediaInfo(ctx context.Context, file *models.File) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("reading media info panicked: %v", r)
+		}
+	}()
+	return a.extractMediaInfo(ctx, file)
+}
+
+func (a *apiService) extractMediaInfo(ctx context.Context, file *models.File) error {
+	return a.runAsOwner(ctx, file.UserId, func(ctx context.Context, client *telegram.Client) error {
+		parts, err := getParts(ctx, client, a.cache, file)
+		if err != nil {
+			return err
+		}
+		r := &fileReaderAt{
+			open: func(start, end int64) (io.ReadCloser, error) {
+				return reader.NewLinearReader(ctx, client.API(), a.cache, file, parts, start, end, &a.cnf.TG,
+					a.partKeys(file.UserId, ""), 0)
+			},
+			size:   *file.Size,
+			blocks: make(map[int64][]byte),
+		}
+		info, err := media.Probe(r, *file.Size)
+		if r.err != nil {
+			return r.err
+		}
+		if err != nil {
+			info = &media.Info{}
+		}
+		return saveMediaInfo(a.db, file, info)
+	})
+}
+
+func saveMediaInfo(db *gorm.DB, file *models.File, info *media.Info) error {
+	row := models.MediaInfo{
+		FileId:      file.ID,
+		UserId:      file.UserId,
+		Category:    file.Category,
+		TimelineAt:  file.UpdatedAt,
+		TakenAt:     info.TakenAt,
+		CameraMake:  nonZero(info.CameraMake),
+		CameraModel: nonZero(info.CameraModel),
+		Latitude:    info.Latitude,
+		Longitude:   info.Longitude,
+		Duration:    nonZero(info.Duration),
+		Width:       nonZero(info.Width),
+		Height:      nonZero(info.Height),
+		VideoCodec:  nonZero(info.VideoCodec),
+		AudioCodec:  nonZero(info.AudioCodec),
+		Title:       nonZero(info.Title),
+		Artist:      nonZero(info.Artist),
+		Album:       nonZero(info.Album),
+		Year:        nonZero(info.Year),
+		Track:       nonZero(info.Track),
+		Genre:       nonZero(info.Genre),
+	}
+	if info.TakenAt != nil {
+		row.TimelineAt = *info.TakenAt
+	}
+	return db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&row).Error
+}
+
+// copyMediaInfo gives a copied file the metadata of its source.
+func copyMediaInfo(db *gorm.DB, fromId string, to *models.File) error {
+	var rows []models.MediaInfo
+	if err := db.Where("file_id = ?", fromId).Find(&rows).Error; err != nil || len(rows) == 0 {
+		return err
+	}
+	row := rows[0]
+	row.FileId, row.UserId, row.CreatedAt = to.ID, to.UserId, time.Time{}
+	if row.TakenAt == nil {
+		row.TimelineAt = to.UpdatedAt
+	}
+	return db.Create(&row).Error
+}
+
+// loadMediaInfo returns the metadata of the given files by file id.
+func loadMediaInfo(db *gorm.DB, ids []string) (map[string]*models.MediaInfo, error) {
+	res := make(map[string]*models.MediaInfo, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	var rows []models.MediaInfo
+	if err := db.Where("file_id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		res[rows[i].FileId] = &rows[i]
+	}
+	return res, nil
+}
+
+// canReadMedia reports whether a file is a photo, video or audio file the server can
+// read without the owner's personal key.
+func canReadMedia(file *models.File) bool {
+	switch category.Category(file.Category) {
+	case category.Image, category.Video, category.Audio:
+	default:
+		return false
+	}
+	return file.Type == "file" && file.Size != nil && *file.Size > 0 && serverReadable(file)
+}
+
+func nonZero[T comparable](value T) *T {
+	var zero T
+	if value == zero {
+		return nil
+	}
+	return utils.Ptr(value)
+}
+
+// fileReaderAt serves the random reads of the header parsers from blocks downloaded
+// on demand, each read of missing blocks is one ranged download.
+type fileReaderAt struct {
+	open   func(start, end int64) (io.ReadCloser, error)
+	size   int64
+	blocks map[int64][]byte
+	// err keeps the first download failure, so it isn't taken for a malformed file.
+	err error
+}
+
+func (r *fileReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	end := min(off+int64(len(p)), r.size)
+	first, last := off/mediaBlockSize, (end-1)/mediaBlockSize
+	if err := r.fetch(first, last); err != nil {
+		return 0, err
+	}
+	n := 0
+	for block := first; block <= last; block++ {
+		data := r.blocks[block]
+		start := max(off+int64(n)-block*mediaBlockSize, 0)
+		n += copy(p[n:], data[start:])
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// fetch downloads the range from the first to the last missing block in one piece.
+func (r *fileReaderAt) fetch(first, last int64) error {
+	for first <= last && r.blocks[first] != nil {
+		first++
+	}
+	for last >= first && r.blocks[last] != nil {
+		last--
+	}
+	if first > last {
+		return nil
+	}
+	if r.err != nil {
+		return r.err
+	}
+	start, end := first*mediaBlockSize, min((last+1)*mediaBlockSize, r.size)
+	lr, err := r.open(start, end-1)
+	if err != nil {
+		r.err = err
+		return err
+	}
+	defer lr.Close()
+	data := make([]byte, end-start)
+	if _, err := io.ReadFull(lr, data); err != nil {
+		r.err = err
+		return err
+	}
+	for block := first; block <= last; block++ {
+		from := (block - first) * mediaBlockSize
+		r.blocks[block] = data[from:min(from+mediaBlockSize, int64(len(data)))]
+	}
+	return nil
+}
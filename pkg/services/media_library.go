@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/mapper"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/gorm"
+)
+
+// The category conditions are inlined, so the partial indexes on media_info also
+// match prepared statements.
+const (
+	timelineCategories = "m.category IN ('image', 'video')"
+	musicCategory      = "m.category = 'audio'"
+
+	artistKey = "coalesce(lower(m.artist), '')"
+	albumKey  = "coalesce(lower(m.album), '')"
+	trackKey  = "coalesce(m.track, 0)"
+)
+
+type timelineCursor struct {
+	At time.Time `json:"at"`
+	ID string    `json:"id"`
+}
+
+type trackCursor struct {
+	Artist string `json:"artist"`
+	Album  string `json:"album"`
+	Track  int    `json:"track"`
+	ID     string `json:"id"`
+}
+
+type mediaFile struct {
+	models.File
+	TimelineAt time.Time
+	ArtistKey  string
+	AlbumKey   string
+	TrackKey   int
+}
+
+func (a *apiService) MediaTimeline(ctx context.Context, params api.MediaTimelineParams) (*api.Timeline, error) {
+	userId := auth.GetUser(ctx)
+	query := a.mediaFiles(userId).Where(timelineCategories)
+	if params.Cursor.Value != "" {
+		var cursor timelineCursor
+		if err := decodeCursor(params.Cursor.Value, &cursor); err != nil {
+			return nil, &apiError{err: err, code: http.StatusBadRequest}
+		}
+		query = query.Where("(m.timeline_at, m.file_id) < (?, ?)", cursor.At, cursor.ID)
+	}
+	var rows []mediaFile
+	if err := query.Select("f.*", "m.timeline_at").Order("m.timeline_at DESC, m.file_id DESC").
+		Limit(params.Limit.Value + 1).Scan(&rows).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+
+	res := &api.Timeline{Groups: []api.TimelineGroup{}}
+	if len(rows) > params.Limit.Value {
+		rows = rows[:params.Limit.Value]
+		last := rows[len(rows)-1]
+		res.NextCursor = api.NewOptString(encodeCursor(timelineCursor{At: last.TimelineAt, ID: last.ID}))
+	}
+	files, err := a.mediaFilesOut(userId, rows)
+	if err != nil {
+		return nil, err
+	}
+	for i, row := range rows {
+		month := row.TimelineAt.Format("2006-01")
+		if len(res.Groups) == 0 || res.Groups[len(res.Groups)-1].Month != month {
+			res.Groups = append(res.Groups, api.TimelineGroup{Month: month})
+		}
+		group := &res.Groups[len(res.Groups)-1]
+		group.Items = append(group.Items, files[i])
+	}
+	return res, nil
+}
+
+func (a *apiService) MediaArtists(ctx context.Context) ([]api.MusicArtist, error) {
+	var artists []struct {
+		Name       string
+		AlbumCount int64
+		TrackCount int64
+	}
+	if err := a.mediaFiles(auth.GetUser(ctx)).Where(musicCategory).
+		Select("coalesce(min(m.artist), '') AS name", "count(DISTINCT "+albumKey+") AS album_count",
+			"count(*) AS track_count").
+		Group(artistKey).Order(artistKey).Scan(&artists).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	res := make([]api.MusicArtist, 0, len(artists))
+	for _, artist := range artists {
+		res = append(res, api.MusicArtist{Name: artist.Name, AlbumCount: artist.AlbumCount, TrackCount: artist.TrackCount})
+	}
+	return res, nil
+}
+
+func (a *apiService) MediaAlbums(ctx context.Context, params api.MediaAlbumsParams) ([]api.MusicAlbum, error) {
+	query := a.mediaFiles(auth.GetUser(ctx)).Where(musicCategory)
+	if params.Artist.IsSet() {
+		query = query.Where(artistKey+" = lower(?)", params.Artist.Value)
+	}
+	var albums []struct {
+		Name       string
+		Artist     string
+		Year       *int
+		TrackCount int64
+	}
+	if err := query.Select("coalesce(min(m.album), '') AS name", "coalesce(min(m.artist), '') AS artist",
+		"max(m.year) AS year", "count(*) AS track_count").
+		Group(artistKey + ", " + albumKey).Order(albumKey + ", " + artistKey).Scan(&albums).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	res := make([]api.MusicAlbum, 0, len(albums))
+	for _, album := range albums {
+		item := api.MusicAlbum{Name: album.Name, Artist: album.Artist, TrackCount: album.TrackCount}
+		if album.Year != nil {
+			item.Year = api.NewOptInt(*album.Year)
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+func (a *apiService) MediaTracks(ctx context.Context, params api.MediaTracksParams) (*api.TrackList, error) {
+	userId := auth.GetUser(ctx)
+	query := a.mediaFiles(userId).Where(musicCategory)
+	if params.Artist.IsSet() {
+		query = query.Where(artistKey+" = lower(?)", params.Artist.Value)
+	}
+	if params.Album.IsSet() {
+		query = query.Where(albumKey+" = lower(?)", params.Album.Value)
+	}
+	if params.Cursor.Value != "" {
+		var cursor trackCursor
+		if err := decodeCursor(params.Cursor.Value, &cursor); err != nil {
+			return nil, &apiError{err: err, code: http.StatusBadRequest}
+		}
+		query = query.Where("("+artistKey+", "+albumKey+", "+trackKey+", m.file_id) > (?, ?, ?, ?)",
+			cursor.Artist, cursor.Album, cursor.Track, cursor.ID)
+	}
+	var rows []mediaFile
+	if err := query.Select("f.*", artistKey+" AS artist_key", albumKey+" AS album_key", trackKey+" AS track_key").
+		Order(artistKey + ", " + albumKey + ", " + trackKey + ", m.file_id").
+		Limit(params.Limit.Value + 1).Scan(&rows).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+
+	res := &api.TrackList{}
+	if len(rows) > params.Limit.Value {
+		rows = rows[:params.Limit.Value]
+		last := rows[len(rows)-1]
+		res.NextCursor = api.NewOptString(encodeCursor(trackCursor{Artist: last.ArtistKey, Album: last.AlbumKey,
+			Track: last.TrackKey, ID: last.ID}))
+	}
+	files, err := a.mediaFilesOut(userId, rows)
+	if err != nil {
+		return nil, err
+	}
+	res.Items = files
+	return res, nil
+}
+
+// mediaFiles selects the active files of a user that have media info.
+func (a *apiService) mediaFiles(userId int64) *gorm.DB {
+	return a.db.Table("teldrive.media_info AS m").Joins("JOIN teldrive.files f ON f.id = m.file_id").
+		Where("m.user_id = ?", userId).Where("f.status = ?", "active")
+}
+
+func (a *apiService) mediaFilesOut(userId int64, rows []mediaFile) ([]api.File, error) {
+	media, err := loadMediaInfo(a.db, utils.Map(rows, func(row mediaFile) string { return row.ID }))
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	names := a.fileNames(userId)
+	return utils.Map(rows, func(row mediaFile) api.File {
+		row.Media = media[row.ID]
+		return *mapper.ToFileOut(row.File, names)
+	}), nil
+}
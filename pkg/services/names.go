@@ -0,0 +1,323 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tgdrive/teldrive/internal/crypt"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/gorm"
+)
+
+// nameCipher returns the cipher for a user's names sealed with the server key keyId. It
+// is derived from the key and the user id so that equal names always encrypt equally.
+func (a *apiService) nameCipher(userId int64, keyId string) (*crypt.Cipher, error) {
+	id := fmt.Sprintf("%d:%s", userId, keyId)
+	if c, ok := a.nameCiphers.Load(id); ok {
+		return c.(*crypt.Cipher), nil
+	}
+	key, err := a.cnf.TG.Uploads.EncryptionKeyFor(keyId)
+	if err != nil {
+		return nil, err
+	}
+	c, err := crypt.NewCipher(key, fmt.Sprintf("names:%d", userId))
+	if err != nil {
+		return nil, err
+	}
+	a.nameCiphers.Store(id, c)
+	return c, nil
+}
+
+// userNames seals and reveals the names of one user's items. Every folder that
+// encrypts names records the key its children are sealed with, and every encrypted
+// name the key it was sealed with, so names stay readable while keys are rotated.
+type userNames struct {
+	a      *apiService
+	userId int64
+}
+
+// fileNames returns the decrypter for names the user owns.
+func (a *apiService) fileNames(userId int64) *userNames {
+	return &userNames{a: a, userId: userId}
+}
+
+func (n *userNames) DecryptName(keyId, name string) (string, error) {
+	c, err := n.a.nameCipher(n.userId, keyId)
+	if err != nil {
+		return "", err
+	}
+	return c.DecryptName(name)
+}
+
+// sealed returns the encrypted forms of a name under every configured key, by key id.
+func (n *userNames) sealed(name string) map[string]string {
+	res := make(map[string]string)
+	for _, keyId := range n.a.cnf.TG.Uploads.EncryptionKeyIds() {
+		if c, err := n.a.nameCipher(n.userId, keyId); err == nil {
+			res[keyId] = c.EncryptName(name)
+		}
+	}
+	return res
+}
+
+// storedName is a name as it is stored for an item.
+type storedName struct {
+	Name      string
+	Encrypted bool
+	KeyId     string
+}
+
+// columns returns the updates storing the name.
+func (n storedName) columns() map[string]any {
+	return map[string]any{"name": n.Name, "name_encrypted": n.Encrypted, "name_key_id": n.KeyId}
+}
+
+// childrenKeyId returns the key the children of a new folder encrypting names are
+// sealed with.
+func (a *apiService) childrenKeyId() string {
+	return a.cnf.TG.Uploads.EncryptionKeyId
+}
+
+// nameForParent returns the name to store for an item placed in parentId, which is
+// encrypted below folders that opted into name encryption.
+func (a *apiService) nameForParent(tx *gorm.DB, userId int64, parentId *string, name string) (storedName, error) {
+	if parentId == nil || *parentId == "" {
+		return storedName{Name: name}, nil
+	}
+	var parents []models.File
+	if err := tx.Select("encrypt_names", "children_key_id").Where("id = ?", *parentId).
+		Where("user_id = ?", userId).Find(&parents).Error; err != nil {
+		return storedName{}, err
+	}
+	if len(parents) == 0 || !parents[0].EncryptNames {
+		return storedName{Name: name}, nil
+	}
+	keyId := parents[0].ChildrenKeyId
+	c, err := a.nameCipher(userId, keyId)
+	if err != nil {
+		return storedName{}, err
+	}
+	return storedName{Name: c.EncryptName(name), Encrypted: true, KeyId: keyId}, nil
+}
+
+// plainName returns the readable name of a stored item.
+func (a *apiService) plainName(file *models.File) (string, error) {
+	if !file.NameEncrypted {
+		return file.Name, nil
+	}
+	return a.fileNames(file.UserId).DecryptName(file.NameKeyId, file.Name)
+}
+
+// renameForParent re-encodes the names of moved items to match their new parent, so
+// that names follow the name encryption setting of the folder they live in.
+func (a *apiService) renameForParent(tx *gorm.DB, userId int64, ids []string, parentId string) error {
+	var files []models.File
+	if err := tx.Where("id IN ?", ids).Where("user_id = ?", userId).Find(&files).Error; err != nil {
+		return err
+	}
+	for i := range files {
+		plain, err := a.plainName(&files[i])
+		if err != nil {
+			return err
+		}
+		name, err := a.nameForParent(tx, userId, &parentId, plain)
+		if err != nil {
+			return err
+		}
+		if name.Name == files[i].Name && name.Encrypted == files[i].NameEncrypted {
+			continue
+		}
+		if err := tx.Model(&models.File{}).Where("id = ?", files[i].ID).
+			Updates(a.folderNameColumns(&files[i], name)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// folderNameColumns returns the updates storing the name of an item placed below a
+// new parent. Folders placed below a folder encrypting names encrypt names too.
+func (a *apiService) folderNameColumns(file *models.File, name storedName) map[string]any {
+	columns := name.columns()
+	if file.Type == "folder" && name.Encrypted && !file.EncryptNames {
+		columns["encrypt_names"] = true
+		columns["children_key_id"] = a.childrenKeyId()
+	}
+	return columns
+}
+
+// pathKeys follows the folders of a readable path from the folder start, or from the
+// user's root when start is empty. It returns the deepest folder it reached and, for
+// each segment up to the one below that folder, the cipher the segment is stored
+// encrypted with or nil when it is stored as is.
+func (a *apiService) pathKeys(userId int64, start, path string) (string, []*crypt.Cipher, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	// The segments are encrypted under every key, each folder picks the forms of the
+	// key it seals its children with.
+	sealed := make(map[string][]string)
+	for _, keyId := range a.cnf.TG.Uploads.EncryptionKeyIds() {
+		c, err := a.nameCipher(userId, keyId)
+		if err != nil {
+			continue
+		}
+		sealed[keyId] = utils.Map(segments, c.EncryptName)
+	}
+	sealedJSON, err := json.Marshal(sealed)
+	if err != nil {
+		return "", nil, err
+	}
+	startCondition, args := "parent_id IS NULL AND type = 'folder'", []any{}
+	if start != "" {
+		startCondition, args = "id = ?", []any{start}
+	}
+	args = append(args, userId, userId, len(segments), string(sealedJSON), strings.Join(segments, "/"))
+	var res []struct {
+		ID   string
+		Keys string
+	}
+	if err := a.db.Raw(`WITH RECURSIVE walk AS (
+			SELECT id, 0 AS depth,
+				jsonb_build_array(CASE WHEN encrypt_names THEN children_key_id END) AS keys
+			FROM teldrive.files WHERE `+startCondition+` AND user_id = ?
+			UNION ALL
+			SELECT f.id, w.depth + 1,
+				w.keys || jsonb_build_array(CASE WHEN f.encrypt_names THEN f.children_key_id END)
+			FROM teldrive.files f JOIN walk w ON f.parent_id = w.id
+			WHERE f.user_id = ? AND f.type = 'folder' AND w.depth < ? AND f.name = CASE
+				WHEN w.keys ->> -1 IS NOT NULL THEN (?::jsonb -> (w.keys ->> -1)) ->> w.depth
+				ELSE (string_to_array(?, '/'))[w.depth + 1] END
+		)
+		SELECT id, keys::text AS keys FROM walk ORDER BY depth DESC LIMIT 1`, args...).Scan(&res).Error; err != nil {
+		return "", nil, err
+	}
+	if len(res) == 0 {
+		return "", nil, nil
+	}
+	var keyIds []*string
+	if err := json.Unmarshal([]byte(res[0].Keys), &keyIds); err != nil {
+		return "", nil, err
+	}
+	ciphers := make([]*crypt.Cipher, len(keyIds))
+	for i, keyId := range keyIds {
+		if keyId == nil {
+			continue
+		}
+		if ciphers[i], err = a.nameCipher(userId, *keyId); err != nil {
+			return "", nil, err
+		}
+	}
+	return res[0].ID, ciphers, nil
+}
+
+// storedPath returns a readable path as it is stored, with the names below folders
+// that encrypt names encrypted, for the path lookups of the database.
+func (a *apiService) storedPath(userId int64, path string) (string, error) {
+	if strings.Trim(path, "/") == "" {
+		return path, nil
+	}
+	_, ciphers, err := a.pathKeys(userId, "", path)
+	if err != nil {
+		return "", err
+	}
+	return crypt.EncryptPath(path, ciphers), nil
+}
+
+// folderAt returns the folder at a readable path below the folder start, or an empty
+// id when there is none.
+func (a *apiService) folderAt(userId int64, start, path string) (string, error) {
+	if strings.Trim(path, "/") == "" {
+		return start, nil
+	}
+	id, ciphers, err := a.pathKeys(userId, start, path)
+	if err != nil {
+		return "", err
+	}
+	if len(ciphers) <= len(strings.Split(strings.Trim(path, "/"), "/")) {
+		return "", nil
+	}
+	return id, nil
+}
+
+// filePath returns the path of a file from the user's root. Encrypted names are
+// revealed when names is set and left as stored otherwise.
+func (a *apiService) filePath(fileId string, names *userNames) (string, error) {
+	var segments []struct {
+		Name          string
+		NameEncrypted bool
+		NameKeyId     string
+	}
+	if err := a.db.Raw(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, name, name_encrypted, name_key_id, 0 AS depth FROM teldrive.files WHERE id = ?
+			UNION ALL
+			SELECT f.id, f.parent_id, f.name, f.name_encrypted, f.name_key_id, a.depth + 1
+			FROM teldrive.files f JOIN ancestors a ON f.id = a.parent_id
+		)
+		SELECT name, name_encrypted, name_key_id FROM ancestors WHERE parent_id IS NOT NULL ORDER BY depth DESC`,
+		fileId).Scan(&segments).Error; err != nil {
+		return "", err
+	}
+	parts := make([]string, len(segments))
+	for i, segment := range segments {
+		parts[i] = segment.Name
+		if segment.NameEncrypted && names != nil {
+			plain, err := names.DecryptName(segment.NameKeyId, segment.Name)
+			if err != nil {
+				return "", err
+			}
+			parts[i] = plain
+		}
+	}
+	return "/" + strings.Join(parts, "/"), nil
+}
+
+// createDirectories returns the folder at a readable path and creates the folders
+// missing on the way, with their names stored the way their parents ask for.
+func (a *apiService) createDirectories(userId int64, path string) (*models.File, error) {
+	parentId, ciphers, err := a.pathKeys(userId, "", path)
+	if err != nil {
+		return nil, err
+	}
+	if parentId == "" {
+		return nil, errors.New("root folder not found")
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] == "" {
+		segments = nil
+	}
+	for _, segment := range segments[min(len(ciphers)-1, len(segments)):] {
+		name, err := a.nameForParent(a.db, userId, &parentId, segment)
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		if err := a.db.Raw(`INSERT INTO teldrive.files (name, type, mime_type, parent_id, user_id,
+			name_encrypted, name_key_id, encrypt_names, children_key_id)
+			VALUES (?, 'folder', 'drive/folder', ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (name, COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'::uuid), user_id)
+			WHERE status = 'active' DO NOTHING RETURNING id`,
+			name.Name, parentId, userId, name.Encrypted, name.KeyId, name.Encrypted, a.childrenKeyId(),
+		).Scan(&ids).Error; err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			// Created meanwhile, or a file takes the name.
+			if err := a.db.Model(&models.File{}).Where("parent_id = ?", parentId).Where("name = ?", name.Name).
+				Where("user_id = ?", userId).Where("type = ?", "folder").Where("status = ?", "active").
+				Pluck("id", &ids).Error; err != nil {
+				return nil, err
+			}
+			if len(ids) == 0 {
+				return nil, fmt.Errorf("%s already exists", segment)
+			}
+		}
+		parentId = ids[0]
+	}
+	var folder models.File
+	if err := a.db.Where("id = ?", parentId).First(&folder).Error; err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
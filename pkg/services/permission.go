@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	roleViewer = "viewer"
+	roleEditor = "editor"
+	roleOwner  = "owner"
+)
+
+var (
+	ErrFileNotFound = errors.New("file not found")
+	ErrAccessDenied = errors.New("access denied")
+)
+
+type filePermission struct {
+	models.FilePermission
+	UserName string
+	Name     string
+}
+
+func (a *apiService) FilesListPermissions(ctx context.Context, params api.FilesListPermissionsParams) ([]api.FilePermission, error) {
+	userId := auth.GetUser(ctx)
+	if err := a.ownFile(userId, params.ID); err != nil {
+		return nil, err
+	}
+	var permissions []filePermission
+	if err := a.db.Model(&models.FilePermission{}).Where("file_id = ?", params.ID).
+		Select("file_permissions.*", "u.user_name", "u.name").
+		Joins("left join teldrive.users as u on u.user_id = file_permissions.user_id").
+		Order("file_permissions.created_at").Scan(&permissions).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	return utils.Map(permissions, toPermissionOut), nil
+}
+
+func (a *apiService) FilesGrantPermission(ctx context.Context, req *api.FilePermissionCreate, params api.FilesGrantPermissionParams) (*api.FilePermission, error) {
+	userId := auth.GetUser(ctx)
+	if err := a.ownFile(userId, params.ID); err != nil {
+		return nil, err
+	}
+
+	var users []models.User
+	query := a.db.Model(&models.User{})
+	switch {
+	case req.UserId.Value != 0:
+		query = query.Where("user_id = ?", req.UserId.Value)
+	case req.UserName.Value != "":
+		query = query.Where("user_name = ?", req.UserName.Value)
+	default:
+		return nil, &apiError{err: errors.New("userId or userName is required"), code: http.StatusBadRequest}
+	}
+	if err := query.Find(&users).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if len(users) == 0 {
+		return nil, &apiError{err: errors.New("user not found"), code: http.StatusNotFound}
+	}
+	if users[0].UserId == userId {
+		return nil, &apiError{err: errors.New("cannot share with yourself"), code: http.StatusBadRequest}
+	}
+
+	permission := models.FilePermission{
+		FileId:  params.ID,
+		OwnerId: userId,
+		UserId:  users[0].UserId,
+		Role:    string(req.Role),
+	}
+	if err := a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "file_id"}, {Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"role": permission.Role, "updated_at": time.Now().UTC()}),
+	}, clause.Returning{}).Create(&permission).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	return utils.Ptr(toPermissionOut(filePermission{
+		FilePermission: permission,
+		UserName:       users[0].UserName,
+		Name:           users[0].Name,
+	})), nil
+}
+
+func (a *apiService) FilesRevokePermission(ctx context.Context, params api.FilesRevokePermissionParams) error {
+	userId := auth.GetUser(ctx)
+	if err := a.db.Where("file_id = ?", params.ID).Where("owner_id = ?", userId).Where("user_id = ?", params.UserId).
+		Delete(&models.FilePermission{}).Error; err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+func (a *apiService) ownFile(userId int64, fileId string) error {
+	var count int64
+	if err := a.db.Model(&models.File{}).Where("id = ?", fileId).Where("user_id = ?", userId).
+		Count(&count).Error; err != nil {
+		return &apiError{err: err}
+	}
+	if count == 0 {
+		return &apiError{err: ErrFileNotFound, code: http.StatusNotFound}
+	}
+	return nil
+}
+
+// fileAccess returns the owner of a file and the role the user has on it. Access granted
+// on a folder extends to everything below it, the strongest grant on the way up wins.
+func fileAccess(db *gorm.DB, userId int64, fileId string) (int64, string, error) {
+	var access []struct {
+		OwnerId int64
+		Role    *string
+	}
+	if err := db.Raw(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM teldrive.files WHERE id = @id
+			UNION ALL
+			SELECT f.id, f.parent_id FROM teldrive.files f JOIN ancestors a ON f.id = a.parent_id
+		)
+		SELECT f.user_id AS owner_id,
+			(SELECT p.role FROM teldrive.file_permissions p JOIN ancestors a ON p.file_id = a.id
+			 WHERE p.user_id = @userId ORDER BY p.role = 'editor' DESC LIMIT 1) AS role
+		FROM teldrive.files f WHERE f.id = @id`,
+		map[string]any{"id": fileId, "userId": userId}).Scan(&access).Error; err != nil {
+		return 0, "", err
+	}
+	if len(access) == 0 {
+		return 0, "", ErrFileNotFound
+	}
+	if access[0].OwnerId == userId {
+		return userId, roleOwner, nil
+	}
+	if access[0].Role == nil {
+		return 0, "", ErrFileNotFound
+	}
+	return access[0].OwnerId, *access[0].Role, nil
+}
+
+// checkAccess returns the owner of a file the user may act on with the given role.
+func (a *apiService) checkAccess(userId int64, fileId string, role string) (int64, error) {
+	ownerId, granted, err := fileAccess(a.db, userId, fileId)
+	if errors.Is(err, ErrFileNotFound) {
+		return 0, &apiError{err: err, code: http.StatusNotFound}
+	}
+	if err != nil {
+		return 0, &apiError{err: err}
+	}
+	if role == roleEditor && granted == roleViewer {
+		return 0, &apiError{err: ErrAccessDenied, code: http.StatusForbidden}
+	}
+	return ownerId, nil
+}
+
+func toPermissionOut(permission filePermission) api.FilePermission {
+	res := api.FilePermission{
+		UserId:    permission.UserId,
+		Role:      api.FilePermissionRole(permission.Role),
+		CreatedAt: permission.CreatedAt,
+	}
+	if permission.UserName != "" {
+		res.UserName = api.NewOptString(permission.UserName)
+	}
+	if permission.Name != "" {
+		res.Name = api.NewOptString(permission.Name)
+	}
+	return res
+}
@@ -0,0 +1,372 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/cache"
+	"github.com/tgdrive/teldrive/internal/crypt"
+	"github.com/tgdrive/teldrive/internal/reader"
+	"github.com/tgdrive/teldrive/internal/tgc"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/jobs"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"github.com/tgdrive/teldrive/pkg/types"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// reencryptionParams are the parameters of a re-encryption job.
+type reencryptionParams struct {
+	KeyId string `json:"keyId"`
+}
+
+func (a *apiService) UsersCreateReencryption(ctx context.Context) (*api.ReencryptionJob, error) {
+	userId := auth.GetUser(ctx)
+
+	keyId, _, err := a.cnf.TG.Uploads.ActiveEncryptionKey()
+	if err != nil {
+		return nil, &apiError{err: errors.New("encryption is not enabled"), code: 400}
+	}
+
+	var files, folders int64
+	if err := a.reencryptionFiles(userId, keyId).Count(&files).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if err := a.reencryptionFolders(userId, keyId).Count(&folders).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	job, err := a.jobs.Enqueue(userId, kindReencryption, reencryptionParams{KeyId: keyId}, files+folders)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	return toReencryptionJobOut(job), nil
+}
+
+func (a *apiService) UsersListReencryptions(ctx context.Context) ([]api.ReencryptionJob, error) {
+	reencryptions, err := a.jobs.List(auth.GetUser(ctx), kindReencryption, "")
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	return utils.Map(reencryptions, func(job models.Job) api.ReencryptionJob {
+		return *toReencryptionJobOut(&job)
+	}), nil
+}
+
+func (a *apiService) UsersGetReencryption(ctx context.Context, params api.UsersGetReencryptionParams) (*api.ReencryptionJob, error) {
+	job, err := a.jobs.Get(auth.GetUser(ctx), params.ID)
+	if errors.Is(err, jobs.ErrNotFound) || (err == nil && job.Kind != kindReencryption) {
+		return nil, &apiError{err: errors.New("re-encryption job not found"), code: 404}
+	}
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	return toReencryptionJobOut(job), nil
+}
+
+func toReencryptionJobOut(job *models.Job) *api.ReencryptionJob {
+	var params reencryptionParams
+	json.Unmarshal(job.Params, &params)
+	res := &api.ReencryptionJob{
+		ID:             job.ID,
+		KeyId:          params.KeyId,
+		Status:         api.ReencryptionJobStatus(job.Status),
+		TotalFiles:     job.Total,
+		ProcessedFiles: job.Done,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+	if job.Error != "" {
+		res.Error = api.NewOptString(job.Error)
+	}
+	return res
+}
+
+// reencryptionFiles selects encrypted files holding at least one part sealed with a
+// server key other than keyId. Parts under personal keys are left alone.
+func (a *apiService) reencryptionFiles(userId int64, keyId string) *gorm.DB {
+	return a.db.Model(&models.File{}).Where("user_id = ?", userId).
+		Where("type = ?", "file").Where("status = ?", "active").Where("encrypted = ?", true).
+		Where("EXISTS (SELECT 1 FROM jsonb_array_elements(parts) p WHERE coalesce(p->>'keyId', '') NOT IN (?, ?))",
+			keyId, userKeyId)
+}
+
+// reencryptionFolders selects folders whose children's names are sealed with a server
+// key other than keyId.
+func (a *apiService) reencryptionFolders(userId int64, keyId string) *gorm.DB {
+	return a.db.Model(&models.File{}).Where("user_id = ?", userId).
+		Where("type = ?", "folder").Where("encrypt_names = ?", true).Where("children_key_id <> ?", keyId)
+}
+
+// reencryptNames seals the names of a folder's children with keyId. The folder row is
+// locked so that names added meanwhile use either key consistently.
+func (a *apiService) reencryptNames(folder *models.File, keyId string) error {
+	names := a.fileNames(folder.UserId)
+	sealer, err := a.nameCipher(folder.UserId, keyId)
+	if err != nil {
+		return err
+	}
+	var children []models.File
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT 1 FROM teldrive.files WHERE id = ? FOR UPDATE", folder.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Select("id", "name", "name_key_id").Where("parent_id = ?", folder.ID).
+			Where("name_encrypted = ?", true).Where("name_key_id <> ?", keyId).Find(&children).Error; err != nil {
+			return err
+		}
+		for _, child := range children {
+			plain, err := names.DecryptName(child.NameKeyId, child.Name)
+			if err != nil {
+				return fmt.Errorf("name of %s: %w", child.ID, err)
+			}
+			if err := tx.Model(&models.File{}).Where("id = ?", child.ID).Updates(map[string]any{
+				"name":        sealer.EncryptName(plain),
+				"name_key_id": keyId,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&models.File{}).Where("id = ?", folder.ID).Update("children_key_id", keyId).Error
+	})
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		a.cache.Delete(cache.Key("files", child.ID))
+	}
+	a.cache.Delete(cache.Key("files", folder.ID))
+	return nil
+}
+
+// runReencryption seals the encrypted names with the job's key, then re-encrypts the
+// files of the job, resuming after the last file it processed.
+func (a *apiService) runReencryption(ctx context.Context, job *jobs.Job) error {
+	var (
+		params reencryptionParams
+		cursor jobCursor
+	)
+	if err := job.Params(&params); err != nil {
+		return err
+	}
+	if err := job.State(&cursor); err != nil {
+		return err
+	}
+	key, err := a.cnf.TG.Uploads.EncryptionKeyFor(params.KeyId)
+	if err != nil {
+		return err
+	}
+
+	// Rotated folders drop out of the selection, so a resumed job skips them.
+	for {
+		var folders []models.File
+		if err := a.reencryptionFolders(job.UserId, params.KeyId).Select("id", "user_id").Order("id").
+			Limit(migrationBatchSize).Find(&folders).Error; err != nil {
+			return err
+		}
+		if len(folders) == 0 {
+			break
+		}
+		for i := range folders {
+			if err := a.reencryptNames(&folders[i], params.KeyId); err != nil {
+				return fmt.Errorf("folder %s: %w", folders[i].ID, err)
+			}
+			if err := job.Progress(job.Done+1, cursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	var sessions []models.Session
+	if err := a.db.Where("user_id = ?", job.UserId).Order("created_at DESC").Limit(1).Find(&sessions).Error; err != nil {
+		return err
+	}
+	if len(sessions) == 0 {
+		return errors.New("no active session for user")
+	}
+
+	for {
+		var files []models.File
+		query := a.reencryptionFiles(job.UserId, params.KeyId).Order("id").Limit(migrationBatchSize)
+		if cursor.LastFileId != "" {
+			query = query.Where("id > ?", cursor.LastFileId)
+		}
+		if err := query.Find(&files).Error; err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return nil
+		}
+
+		client, err := tgc.AuthClient(ctx, &a.cnf.TG, sessions[0].Session, a.middlewares...)
+		if err != nil {
+			return err
+		}
+		err = tgc.RunWithAuth(ctx, client, "", func(ctx context.Context) error {
+			for i := range files {
+				if err := a.reencryptFile(ctx, client, job, params.KeyId, key, &files[i]); err != nil {
+					return fmt.Errorf("file %s: %w", files[i].ID, err)
+				}
+				cursor.LastFileId = files[i].ID
+				if err := job.Progress(job.Done+1, cursor); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// reencryptFile streams every part sealed with another key through the decrypter and
+// a fresh encrypter, uploads the result next to the original and swaps the parts in a
+// single update guarded by the previous parts. The originals are deleted afterwards.
+func (a *apiService) reencryptFile(ctx context.Context, client *telegram.Client, job *jobs.Job,
+	keyId, key string, file *models.File) error {
+	if len(file.Parts) == 0 || file.ChannelId == nil {
+		return nil
+	}
+	messages, err := getPartMessages(ctx, client.API(), file)
+	if err != nil {
+		return err
+	}
+
+	parts := slices.Clone(file.Parts)
+	replaced := make(map[int64][]int)
+	created := make(map[int64][]int)
+	rollback := func() {
+		for channelId, ids := range created {
+			deleteChannelMessages(ctx, client.API(), channelId, ids)
+		}
+	}
+
+	for i, part := range file.Parts {
+		if part.KeyId.Value == keyId || part.KeyId.Value == userKeyId {
+			continue
+		}
+		document, err := partDocument(messages[i])
+		if err != nil {
+			rollback()
+			return err
+		}
+		channelId := part.ChannelId.Or(*file.ChannelId)
+		salt, msgId, err := a.reencryptPart(ctx, client, file, document, types.Part{
+			ID:        int64(part.ID),
+			Size:      document.Size,
+			Salt:      part.Salt.Value,
+			KeyId:     part.KeyId.Value,
+			Format:    string(part.Format.Value),
+			ChannelId: channelId,
+		}, key)
+		if err != nil {
+			rollback()
+			return err
+		}
+		created[channelId] = append(created[channelId], msgId)
+		replaced[channelId] = append(replaced[channelId], part.ID)
+
+		parts[i].ID = msgId
+		parts[i].Salt = api.NewOptString(salt)
+		parts[i].KeyId = api.OptString{}
+		if keyId != "" {
+			parts[i].KeyId = api.NewOptString(keyId)
+		}
+		if err := job.Heartbeat(); err != nil {
+			rollback()
+			return err
+		}
+	}
+	if len(replaced) == 0 {
+		return nil
+	}
+
+	res := a.db.Model(&models.File{}).Where("id = ?", file.ID).Where("parts = ?::jsonb", file.Parts).
+		Update("parts", datatypes.NewJSONSlice(parts))
+	if res.Error != nil || res.RowsAffected == 0 {
+		// The file changed or was removed meanwhile, keep it as it is.
+		rollback()
+		return res.Error
+	}
+
+	for channelId, ids := range replaced {
+		deleteChannelMessages(ctx, client.API(), channelId, ids)
+	}
+	a.cache.Delete(fileCacheKeys(file)...)
+	return nil
+}
+
+func (a *apiService) reencryptPart(ctx context.Context, client *telegram.Client, file *models.File,
+	document *tg.Document, part types.Part, key string) (string, int, error) {
+	format := crypt.Format(part.Format)
+	size, err := format.DecryptedSize(part.Size)
+	if err != nil {
+		return "", 0, err
+	}
+	part.DecryptedSize = size
+
+	var plain io.Reader = strings.NewReader("")
+	if size > 0 {
+		lr, err := reader.NewLinearReader(ctx, client.API(), a.cache, file, []types.Part{part}, 0, size-1, &a.cnf.TG,
+			a.partKeys(file.UserId, ""), 1)
+		if err != nil {
+			return "", 0, err
+		}
+		defer lr.Close()
+		plain = lr
+	}
+
+	salt, err := generateRandomSalt()
+	if err != nil {
+		return "", 0, err
+	}
+	cipher, err := crypt.NewCipher(key, salt)
+	if err != nil {
+		return "", 0, err
+	}
+	encrypted, err := cipher.EncryptDataFormat(plain, format)
+	if err != nil {
+		return "", 0, err
+	}
+	defer encrypted.Close()
+
+	name := fmt.Sprintf("%d", part.ID)
+	for _, attr := range document.Attributes {
+		if filename, ok := attr.(*tg.DocumentAttributeFilename); ok {
+			name = filename.FileName
+		}
+	}
+
+	channel, err := tgc.GetChannelById(ctx, client.API(), part.ChannelId)
+	if err != nil {
+		return "", 0, err
+	}
+	u := uploader.NewUploader(client.API()).WithThreads(a.cnf.TG.Uploads.Threads).WithPartSize(512 * 1024)
+	upload, err := u.Upload(ctx, uploader.NewUpload(name, encrypted, format.EncryptedSize(size)))
+	if err != nil {
+		return "", 0, err
+	}
+	res, err := message.NewSender(client.API()).
+		To(&tg.InputPeerChannel{ChannelID: channel.ChannelID, AccessHash: channel.AccessHash}).
+		Media(ctx, message.UploadedDocument(upload).Filename(name).ForceFile(true))
+	if err != nil {
+		return "", 0, err
+	}
+	msg, err := sentMessage(res)
+	if err != nil {
+		return "", 0, err
+	}
+	return salt, msg.ID, nil
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/database"
+	"github.com/tgdrive/teldrive/internal/searchquery"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/datatypes"
+)
+
+var ErrSavedSearchNotFound = errors.New("saved search not found")
+
+func (a *apiService) SearchesList(ctx context.Context) ([]api.SavedSearch, error) {
+	var searches []models.SavedSearch
+	if err := a.db.Where("user_id = ?", auth.GetUser(ctx)).Order("lower(name)").Find(&searches).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	return utils.Map(searches, toSavedSearchOut), nil
+}
+
+func (a *apiService) SearchesCreate(ctx context.Context, req *api.SavedSearch) (*api.SavedSearch, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, &apiError{err: errors.New("saved search name is required"), code: http.StatusBadRequest}
+	}
+	if err := validateSearchQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	search := models.SavedSearch{UserId: auth.GetUser(ctx), Name: name, Query: datatypes.NewJSONType(&req.Query)}
+	if err := a.db.Create(&search).Error; err != nil {
+		if database.IsKeyConflictErr(err) {
+			return nil, &apiError{err: errors.New("saved search already exists"), code: http.StatusConflict}
+		}
+		return nil, &apiError{err: err}
+	}
+	return utils.Ptr(toSavedSearchOut(search)), nil
+}
+
+func (a *apiService) SearchesUpdate(ctx context.Context, req *api.SavedSearchUpdate, params api.SearchesUpdateParams) (*api.SavedSearch, error) {
+	userId := auth.GetUser(ctx)
+	updates := map[string]any{}
+	if name := strings.TrimSpace(req.Name.Value); name != "" {
+		updates["name"] = name
+	}
+	if req.Query.IsSet() {
+		if err := validateSearchQuery(&req.Query.Value); err != nil {
+			return nil, err
+		}
+		updates["query"] = datatypes.NewJSONType(&req.Query.Value)
+	}
+	if len(updates) > 0 {
+		updates["updated_at"] = time.Now().UTC()
+		res := a.db.Model(&models.SavedSearch{}).Where("id = ?", params.ID).Where("user_id = ?", userId).Updates(updates)
+		if res.Error != nil {
+			if database.IsKeyConflictErr(res.Error) {
+				return nil, &apiError{err: errors.New("saved search already exists"), code: http.StatusConflict}
+			}
+			return nil, &apiError{err: res.Error}
+		}
+	}
+	search, err := a.savedSearch(userId, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return utils.Ptr(toSavedSearchOut(*search)), nil
+}
+
+func (a *apiService) SearchesDelete(ctx context.Context, params api.SearchesDeleteParams) error {
+	if err := a.db.Where("id = ?", params.ID).Where("user_id = ?", auth.GetUser(ctx)).
+		Delete(&models.SavedSearch{}).Error; err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+func (a *apiService) savedSearch(userId int64, id string) (*models.SavedSearch, error) {
+	if !isUUID(id) {
+		return nil, &apiError{err: ErrSavedSearchNotFound, code: http.StatusNotFound}
+	}
+	var searches []models.SavedSearch
+	if err := a.db.Where("id = ?", id).Where("user_id = ?", userId).Find(&searches).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if len(searches) == 0 {
+		return nil, &apiError{err: ErrSavedSearchNotFound, code: http.StatusNotFound}
+	}
+	return &searches[0], nil
+}
+
+// validateSearchQuery rejects a filter query that couldn't be listed, so mistakes show
+// up when the search is saved rather than when its smart folder is opened.
+func validateSearchQuery(query *api.SearchQuery) error {
+	if query.Filter.Value == "" {
+		return nil
+	}
+	if _, err := searchquery.Parse(query.Filter.Value); err != nil {
+		return &apiError{err: err, code: http.StatusBadRequest}
+	}
+	return nil
+}
+
+// applySavedSearch turns a listing of a smart folder into a search with the saved
+// filters. Paging, status and the defaults of the request are kept where the saved
+// search leaves them unset.
+func applySavedSearch(params *api.FilesListParams, query *api.SearchQuery) {
+	*params = api.FilesListParams{
+		Operation:   api.NewOptFileQueryOperation(api.FileQueryOperationFind),
+		Status:      params.Status,
+		SearchType:  params.SearchType,
+		TagMatch:    params.TagMatch,
+		Sort:        params.Sort,
+		Order:       params.Order,
+		Limit:       params.Limit,
+		Page:        params.Page,
+		Cursor:      params.Cursor,
+		SkipCount:   params.SkipCount,
+		Query:       query.Query,
+		Filter:      query.Filter,
+		Path:        query.Path,
+		DeepSearch:  query.DeepSearch,
+		Starred:     query.Starred,
+		Recent:      query.Recent,
+		Category:    query.Category,
+		Tags:        query.Tags,
+		Properties:  query.Properties,
+		UpdatedAt:   query.UpdatedAt,
+		TakenAt:     query.TakenAt,
+		Camera:      query.Camera,
+		Duration:    query.Duration,
+		HasLocation: query.HasLocation,
+	}
+	if query.SearchType.IsSet() {
+		params.SearchType = api.NewOptFileQuerySearchType(api.FileQuerySearchType(query.SearchType.Value))
+	}
+	if query.Type.IsSet() {
+		params.Type = api.NewOptFileQueryType(api.FileQueryType(query.Type.Value))
+	}
+	if query.TagMatch.IsSet() {
+		params.TagMatch = api.NewOptFileQueryTagMatch(api.FileQueryTagMatch(query.TagMatch.Value))
+	}
+	if query.Sort.IsSet() {
+		params.Sort = api.NewOptFileQuerySort(api.FileQuerySort(query.Sort.Value))
+	}
+	if query.Order.IsSet() {
+		params.Order = api.NewOptFileQueryOrder(api.FileQueryOrder(query.Order.Value))
+	}
+}
+
+func toSavedSearchOut(search models.SavedSearch) api.SavedSearch {
+	res := api.SavedSearch{
+		ID:        api.NewOptString(search.ID),
+		Name:      search.Name,
+		CreatedAt: api.NewOptDateTime(search.CreatedAt),
+		UpdatedAt: api.NewOptDateTime(search.UpdatedAt),
+	}
+	if query := search.Query.Data(); query != nil {
+		res.Query = *query
+	}
+	return res
+}
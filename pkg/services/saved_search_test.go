@@ -0,0 +1,75 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tgdrive/teldrive/internal/api"
+)
+
+func TestApplySavedSearch(t *testing.T) {
+	tests := []struct {
+		name   string
+		params api.FilesListParams
+		query  api.SearchQuery
+		want   api.FilesListParams
+	}{
+		{
+			name: "Keeps paging by page",
+			params: api.FilesListParams{
+				SmartFolderId: api.NewOptString("search"),
+				Status:        api.NewOptFileQueryStatus(api.FileQueryStatusActive),
+				Limit:         api.NewOptInt(50),
+				Page:          api.NewOptInt(3),
+				SkipCount:     api.NewOptBool(true),
+			},
+			query: api.SearchQuery{Query: api.NewOptString("report")},
+			want: api.FilesListParams{
+				Operation: api.NewOptFileQueryOperation(api.FileQueryOperationFind),
+				Status:    api.NewOptFileQueryStatus(api.FileQueryStatusActive),
+				Limit:     api.NewOptInt(50),
+				Page:      api.NewOptInt(3),
+				SkipCount: api.NewOptBool(true),
+				Query:     api.NewOptString("report"),
+			},
+		},
+		{
+			name: "Keeps paging by cursor",
+			params: api.FilesListParams{
+				Limit:  api.NewOptInt(50),
+				Cursor: api.NewOptString("cursor"),
+			},
+			query: api.SearchQuery{Starred: api.NewOptBool(true)},
+			want: api.FilesListParams{
+				Operation: api.NewOptFileQueryOperation(api.FileQueryOperationFind),
+				Limit:     api.NewOptInt(50),
+				Cursor:    api.NewOptString("cursor"),
+				Starred:   api.NewOptBool(true),
+			},
+		},
+		{
+			name: "Saved sort overrides the request",
+			params: api.FilesListParams{
+				Sort:  api.NewOptFileQuerySort(api.FileQuerySortName),
+				Order: api.NewOptFileQueryOrder(api.FileQueryOrderAsc),
+			},
+			query: api.SearchQuery{
+				Sort:  api.NewOptSearchQuerySort(api.SearchQuerySortSize),
+				Order: api.NewOptSearchQueryOrder(api.SearchQueryOrderDesc),
+			},
+			want: api.FilesListParams{
+				Operation: api.NewOptFileQueryOperation(api.FileQueryOperationFind),
+				Sort:      api.NewOptFileQuerySort(api.FileQuerySortSize),
+				Order:     api.NewOptFileQueryOrder(api.FileQueryOrderDesc),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			applySavedSearch(&params, &tt.query)
+			assert.Equal(t, tt.want, params)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tgdrive/teldrive/internal/appcontext"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/ratelimit"
+	"go.uber.org/zap"
+)
+
+var ErrTooManyAttempts = errors.New("too many failed attempts")
+
+const (
+	scopeShareUnlock   = "share_unlock"
+	scopeShareAuth     = "share_auth"
+	scopeLogin         = "login"
+	scopeKeyPassphrase = "key_passphrase"
+)
+
+// checkAttempts refuses an attempt while any of the keys is locked out.
+func checkAttempts(ctx context.Context, limiter *ratelimit.Limiter, scope string, keys ...string) error {
+	wait, ok := limiter.Allow(keys...)
+	if ok {
+		return nil
+	}
+	securityEvent(ctx, "attempt_blocked", zap.String("scope", scope), zap.Strings("keys", keys),
+		zap.Duration("retryAfter", wait))
+	return &apiError{err: fmt.Errorf("%w, retry in %s", ErrTooManyAttempts, wait.Round(time.Second)),
+		code: http.StatusTooManyRequests}
+}
+
+// failedAttempt counts a failed attempt against the keys and logs the lockout it may
+// have started.
+func failedAttempt(ctx context.Context, limiter *ratelimit.Limiter, scope string, keys ...string) {
+	securityEvent(ctx, "attempt_failed", zap.String("scope", scope), zap.Strings("keys", keys))
+	if lockout := limiter.Fail(keys...); lockout > 0 {
+		securityEvent(ctx, "lockout", zap.String("scope", scope), zap.Strings("keys", keys),
+			zap.Duration("lockout", lockout))
+	}
+}
+
+func securityEvent(ctx context.Context, event string, fields ...zap.Field) {
+	logging.FromContext(ctx).Named("security").Warn("security event",
+		append([]zap.Field{zap.String("event", event)}, fields...)...)
+}
+
+// requestIP returns the client address of the request behind an API call.
+func requestIP(ctx context.Context) string {
+	if c, ok := ctx.(*appcontext.Context); ok {
+		return clientIP(c.Request)
+	}
+	return ""
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
@@ -4,17 +4,26 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"math"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/tgdrive/teldrive/internal/api"
 	"github.com/tgdrive/teldrive/internal/appcontext"
+	"github.com/tgdrive/teldrive/internal/auth"
 	"github.com/tgdrive/teldrive/internal/cache"
 	"github.com/tgdrive/teldrive/internal/database"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/middleware"
+	"github.com/tgdrive/teldrive/internal/utils"
 	"github.com/tgdrive/teldrive/pkg/mapper"
 	"github.com/tgdrive/teldrive/pkg/models"
+	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
@@ -22,21 +31,44 @@ var (
 	ErrInvalidPassword = errors.New("invalid password")
 	ErrEmptyAuth       = errors.New("empty auth")
 	ErrShareExpired    = errors.New("share expired")
+	ErrShareDisabled   = errors.New("share disabled")
+)
+
+const (
+	shareAccessList     = "list"
+	shareAccessDownload = "download"
+	shareAccessUpload   = "upload"
+
+	shareLimitDownloads = "downloads"
+	shareLimitIps       = "ips"
+
+	shareRecentAccesses = 100
 )
 
 type fileShare struct {
 	models.FileShare
-	Type api.FileShareInfoType
-	Name string
-	Path string
+	Type          api.FileShareInfoType
+	Name          string
+	NameEncrypted bool
+	NameKeyId     string
+	// Items lists the files and folders of a multi-file share, which are presented
+	// as a virtual root folder.
+	Items []string `gorm:"-"`
+}
+
+// roots returns the files a share exposes at its top level.
+func (s *fileShare) roots() []string {
+	if len(s.Items) > 0 {
+		return s.Items
+	}
+	return []string{*s.FileId}
 }
 
 func (a *apiService) shareGetById(id string) (*fileShare, error) {
 	var result []fileShare
 
 	if err := a.db.Model(&models.FileShare{}).Where("file_shares.id = ?", id).
-		Select("file_shares.*", "f.type", "f.name",
-			"(select get_path_from_file_id as path from teldrive.get_path_from_file_id(f.id))").
+		Select("file_shares.*", "f.type", "f.name", "f.name_encrypted", "f.name_key_id").
 		Joins("left join teldrive.files as f on f.id = file_shares.file_id").
 		Scan(&result).Error; err != nil {
 		return nil, &apiError{err: err}
@@ -50,9 +82,43 @@ func (a *apiService) shareGetById(id string) (*fileShare, error) {
 		return nil, &apiError{err: ErrShareExpired, code: http.StatusNotFound}
 	}
 
+	if result[0].DisabledAt != nil {
+		return nil, &apiError{err: ErrShareDisabled, code: http.StatusGone}
+	}
+
+	if err := a.db.Model(&models.FileShareItem{}).Where("share_id = ?", id).
+		Pluck("file_id", &result[0].Items).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if result[0].FileId == nil && len(result[0].Items) == 0 {
+		// Every file of a multi-file share was deleted.
+		return nil, &apiError{err: ErrShareNotFound, code: http.StatusNotFound}
+	}
+	if len(result[0].Items) > 0 {
+		result[0].Type = api.FileShareInfoTypeFolder
+		result[0].Name = "Shared files"
+		if result[0].Title != nil {
+			result[0].Name = *result[0].Title
+		}
+	}
+
 	return &result[0], nil
 }
 
+// shareName returns the readable name of a share, the names of shared items are
+// revealed to the visitors.
+func (a *apiService) shareName(share *fileShare) string {
+	if !share.NameEncrypted {
+		return share.Name
+	}
+	name, err := a.plainName(&models.File{Name: share.Name, NameEncrypted: true, NameKeyId: share.NameKeyId,
+		UserId: share.UserId})
+	if err != nil {
+		return share.Name
+	}
+	return name
+}
+
 func (a *apiService) SharesGetById(ctx context.Context, params api.SharesGetByIdParams) (*api.FileShareInfo, error) {
 	share, err := a.shareGetById(params.ID)
 
@@ -63,11 +129,21 @@ func (a *apiService) SharesGetById(ctx context.Context, params api.SharesGetById
 		Protected: share.Password != nil,
 		UserId:    share.UserId,
 		Type:      share.Type,
-		Name:      share.Name,
+		Name:      a.shareName(share),
 	}
 	if share.ExpiresAt != nil {
 		res.ExpiresAt = api.NewOptDateTime(*share.ExpiresAt)
 	}
+	if len(share.Items) > 0 {
+		res.ItemCount = api.NewOptInt(len(share.Items))
+	}
+	res.Mode = api.NewOptFileShareInfoMode(api.FileShareInfoMode(share.Mode))
+	if share.Mode == shareModeUpload {
+		res.AllowedExtensions = share.AllowedExtensions
+		if share.MaxFileSize != nil {
+			res.MaxFileSize = api.NewOptInt64(*share.MaxFileSize)
+		}
+	}
 	return res, nil
 }
 
@@ -82,9 +158,19 @@ func (a *apiService) SharesUnlock(ctx context.Context, req *api.ShareUnlock, par
 		return &apiError{err: ErrShareNotFound, code: http.StatusNotFound}
 	}
 
+	if result[0].Password == nil {
+		return nil
+	}
+
+	keys := []string{ipKey(requestIP(ctx)), "share:" + params.ID}
+	if err := checkAttempts(ctx, a.shareAuth, scopeShareUnlock, keys...); err != nil {
+		return err
+	}
 	if err := bcrypt.CompareHashAndPassword([]byte(*result[0].Password), []byte(req.Password)); err != nil {
+		failedAttempt(ctx, a.shareAuth, scopeShareUnlock, keys...)
 		return &apiError{err: ErrInvalidPassword, code: http.StatusForbidden}
 	}
+	a.shareAuth.Reset(keys[0])
 	return nil
 }
 
@@ -94,37 +180,191 @@ func (a *apiService) SharesListFiles(ctx context.Context, params api.SharesListF
 	if err != nil {
 		return nil, err
 	}
+	if share.Mode == shareModeUpload {
+		return nil, &apiError{err: ErrShareUploadOnly, code: http.StatusForbidden}
+	}
+	a.recordShareAccess(c.Request, share.ID, shareAccessList, 0)
 	fileType := share.Type
 
+	if len(share.Items) > 0 {
+		return a.listShareItems(share, &params)
+	}
+
 	if fileType == api.FileShareInfoTypeFolder {
-		queryBuilder := &fileQueryBuilder{db: a.db}
-		return queryBuilder.execute(&api.FilesListParams{
-			Path:      api.NewOptString(share.Path + params.Path.Or("")),
-			Limit:     params.Limit,
-			Page:      params.Page,
-			Status:    api.NewOptFileQueryStatus(api.FileQueryStatusActive),
-			Order:     api.NewOptFileQueryOrder(api.FileQueryOrder(string(params.Order.Value))),
-			Sort:      api.NewOptFileQuerySort(api.FileQuerySort(string(params.Sort.Value))),
-			Operation: api.NewOptFileQueryOperation(api.FileQueryOperationList)}, share.UserId)
+		return a.listSharedFolder(share, *share.FileId, params.Path.Value, &params)
 	} else {
 		var file models.File
-		if err := a.db.Where("id = ?", share.FileId).First(&file).Error; err != nil {
+		if err := a.db.Where("id = ?", *share.FileId).First(&file).Error; err != nil {
 			if database.IsRecordNotFoundErr(err) {
 				return nil, &apiError{err: database.ErrNotFound, code: http.StatusNotFound}
 			}
 			return nil, &apiError{err: err}
 		}
-		return &api.FileList{Items: []api.File{*mapper.ToFileOut(file)},
-			Meta: api.Meta{Count: 1, TotalPages: 1, CurrentPage: 1}}, nil
+		return &api.FileList{Items: []api.File{*mapper.ToFileOut(file, a.fileNames(share.UserId))},
+			Meta: api.Meta{Count: api.NewOptInt(1), TotalPages: api.NewOptInt(1), CurrentPage: 1}}, nil
+	}
+
+}
+
+// listSharedFolder lists the folder at the readable path below the shared folder root.
+func (a *apiService) listSharedFolder(share *fileShare, root, path string, params *api.SharesListFilesParams) (*api.FileList, error) {
+	folderId, err := a.folderAt(share.UserId, root, path)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	if folderId == "" {
+		return nil, &apiError{err: errors.New("invalid path"), code: http.StatusNotFound}
+	}
+	queryBuilder := &fileQueryBuilder{db: a.db, names: a.fileNames(share.UserId)}
+	return queryBuilder.execute(&api.FilesListParams{
+		ParentId:  api.NewOptString(folderId),
+		Limit:     params.Limit,
+		Page:      params.Page,
+		Status:    api.NewOptFileQueryStatus(api.FileQueryStatusActive),
+		Order:     api.NewOptFileQueryOrder(api.FileQueryOrder(string(params.Order.Value))),
+		Sort:      api.NewOptFileQuerySort(api.FileQuerySort(string(params.Sort.Value))),
+		Operation: api.NewOptFileQueryOperation(api.FileQueryOperationList)}, share.UserId)
+}
+
+// listShareItems lists the virtual root of a multi-file share, or a folder below one
+// of its items when the path starts with the item's id.
+func (a *apiService) listShareItems(share *fileShare, params *api.SharesListFilesParams) (*api.FileList, error) {
+	path := strings.Trim(params.Path.Value, "/")
+	if path == "" {
+		query := a.db.Model(&models.File{}).Where("id IN ?", share.Items).Where("status = ?", "active")
+		var count int64
+		if err := query.Count(&count).Error; err != nil {
+			return nil, &apiError{err: err}
+		}
+		var files []models.File
+		if err := query.Order(getOrder(&api.FilesListParams{
+			Sort:  api.NewOptFileQuerySort(api.FileQuerySort(string(params.Sort.Value))),
+			Order: api.NewOptFileQueryOrder(api.FileQueryOrder(string(params.Order.Value))),
+		})).Limit(params.Limit.Value).Offset((params.Page.Value - 1) * params.Limit.Value).
+			Find(&files).Error; err != nil {
+			return nil, &apiError{err: err}
+		}
+		names := a.fileNames(share.UserId)
+		return &api.FileList{Items: utils.Map(files, func(file models.File) api.File {
+			return *mapper.ToFileOut(file, names)
+		}), Meta: api.Meta{Count: api.NewOptInt(int(count)),
+			TotalPages:  api.NewOptInt(int(math.Ceil(float64(count) / float64(params.Limit.Value)))),
+			CurrentPage: params.Page.Value}}, nil
 	}
 
+	itemId, rest, _ := strings.Cut(path, "/")
+	if !slices.Contains(share.Items, itemId) {
+		return nil, &apiError{err: errors.New("invalid path"), code: http.StatusNotFound}
+	}
+	var count int64
+	if err := a.db.Model(&models.File{}).Where("id = ?", itemId).Where("type = ?", "folder").
+		Where("status = ?", "active").Count(&count).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if count == 0 {
+		return nil, &apiError{err: errors.New("invalid path"), code: http.StatusNotFound}
+	}
+	return a.listSharedFolder(share, itemId, rest, params)
 }
+
+// shareContains reports whether a file is one of the share roots or lies below one.
+func (a *apiService) shareContains(share *fileShare, fileId string) (bool, error) {
+	var found []bool
+	if err := a.db.Raw(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM teldrive.files WHERE id = ?
+			UNION ALL
+			SELECT f.id, f.parent_id FROM teldrive.files f JOIN ancestors a ON f.id = a.parent_id
+		)
+		SELECT true FROM ancestors WHERE id IN ? LIMIT 1`, fileId, share.roots()).Scan(&found).Error; err != nil {
+		return false, err
+	}
+	return len(found) > 0, nil
+}
+
+func (a *apiService) SharesCreate(ctx context.Context, req *api.ShareCreate) (*api.FileShare, error) {
+	userId := auth.GetUser(ctx)
+
+	ids := slices.Compact(slices.Sorted(slices.Values(req.FileIds)))
+	var owned int64
+	if err := a.db.Model(&models.File{}).Where("id IN ?", ids).Where("user_id = ?", userId).
+		Where("status = ?", "active").Count(&owned).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if owned != int64(len(ids)) {
+		return nil, &apiError{err: ErrFileNotFound, code: http.StatusNotFound}
+	}
+
+	// The files are listed in the share items, per-file lookups don't see the share.
+	share := models.FileShare{
+		UserId: userId,
+		Mode:   shareModeDownload,
+	}
+	if req.Name.Value != "" {
+		share.Title = utils.Ptr(req.Name.Value)
+	}
+	if req.Password.Value != "" {
+		bytes, err := bcrypt.GenerateFromPassword([]byte(req.Password.Value), bcrypt.MinCost)
+		if err != nil {
+			return nil, &apiError{err: err}
+		}
+		share.Password = utils.Ptr(string(bytes))
+	}
+	if req.ExpiresAt.IsSet() {
+		share.ExpiresAt = utils.Ptr(req.ExpiresAt.Value)
+	}
+	if req.MaxDownloads.IsSet() {
+		share.MaxDownloads = utils.Ptr(req.MaxDownloads.Value)
+	}
+	if req.MaxIps.IsSet() {
+		share.MaxIps = utils.Ptr(req.MaxIps.Value)
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&share).Error; err != nil {
+			return err
+		}
+		return tx.Create(utils.Map(ids, func(id string) models.FileShareItem {
+			return models.FileShareItem{ShareId: share.ID, FileId: id}
+		})).Error
+	})
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+
+	res := &api.FileShare{
+		ID:        share.ID,
+		Protected: share.Password != nil,
+		UserId:    api.NewOptInt64(userId),
+		Type:      api.FileShareTypeFolder,
+		Name:      "Shared files",
+		FileIds:   ids,
+		Mode:      api.NewOptFileShareMode(api.FileShareModeDownload),
+	}
+	if share.Title != nil {
+		res.Name = *share.Title
+	}
+	if share.ExpiresAt != nil {
+		res.ExpiresAt = api.NewOptDateTime(*share.ExpiresAt)
+	}
+	if share.MaxDownloads != nil {
+		res.MaxDownloads = api.NewOptInt64(*share.MaxDownloads)
+	}
+	if share.MaxIps != nil {
+		res.MaxIps = api.NewOptInt64(*share.MaxIps)
+	}
+	return res, nil
+}
+
+func (a *apiService) SharesZip(ctx context.Context, params api.SharesZipParams) (*api.SharesZipOKHeaders, error) {
+	return nil, nil
+}
+
 func (a *apiService) validFileShare(r *http.Request, id string) (*fileShare, error) {
 
 	share, err := cache.FetchArg(a.cache, cache.Key("shares", id), 0, a.shareGetById, id)
 
 	if err != nil {
-		return nil, &apiError{err: err}
+		return nil, err
 	}
 
 	if share.Password != nil {
@@ -132,20 +372,193 @@ func (a *apiService) validFileShare(r *http.Request, id string) (*fileShare, err
 		if authHeader == "" {
 			return nil, &apiError{err: ErrEmptyAuth, code: http.StatusUnauthorized}
 		}
+		ctx := r.Context()
+		keys := []string{ipKey(clientIP(r)), "share:" + share.ID}
+		if err := checkAttempts(ctx, a.shareAuth, scopeShareAuth, keys...); err != nil {
+			return nil, err
+		}
 		bytes, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(authHeader, "Basic "))
 		if err != nil {
-			return nil, &apiError{err: err}
+			failedAttempt(ctx, a.shareAuth, scopeShareAuth, keys...)
+			return nil, &apiError{err: ErrInvalidPassword, code: http.StatusUnauthorized}
+		}
+		_, password, ok := strings.Cut(string(bytes), ":")
+		if !ok {
+			failedAttempt(ctx, a.shareAuth, scopeShareAuth, keys...)
+			return nil, &apiError{err: ErrInvalidPassword, code: http.StatusUnauthorized}
 		}
-		password := strings.Split(string(bytes), ":")[1]
 
 		if err := bcrypt.CompareHashAndPassword([]byte(*share.Password), []byte(password)); err != nil {
+			failedAttempt(ctx, a.shareAuth, scopeShareAuth, keys...)
 			return nil, &apiError{err: ErrInvalidPassword, code: http.StatusUnauthorized}
 		}
+		a.shareAuth.Reset(keys[0])
 
 	}
+	if err := a.admitShareClient(share, clientIP(r)); err != nil {
+		return nil, err
+	}
 	return share, nil
 }
 
+// admitShareClient enforces the IP limit of a share. Clients seen before are always
+// admitted, the first client past the limit disables the share for everyone.
+func (a *apiService) admitShareClient(share *fileShare, ip string) error {
+	if share.MaxIps == nil {
+		return nil
+	}
+	var seen int64
+	if err := a.db.Model(&models.ShareAccess{}).Where("share_id = ?", share.ID).Where("ip = ?", ip).
+		Count(&seen).Error; err != nil {
+		return &apiError{err: err}
+	}
+	if seen > 0 {
+		return nil
+	}
+	var ips int64
+	if err := a.db.Model(&models.ShareAccess{}).Where("share_id = ?", share.ID).
+		Distinct("ip").Count(&ips).Error; err != nil {
+		return &apiError{err: err}
+	}
+	if ips >= *share.MaxIps {
+		a.disableShare(share.ID, shareLimitIps)
+		return &apiError{err: ErrShareDisabled, code: http.StatusGone}
+	}
+	return nil
+}
+
+// countShareDownload takes one download off the share allowance and disables the
+// share once the last one is handed out.
+func (a *apiService) countShareDownload(share *fileShare) error {
+	var downloads []int64
+	if err := a.db.Raw(`UPDATE teldrive.file_shares SET downloads = downloads + 1
+		WHERE id = ? AND disabled_at IS NULL AND (max_downloads IS NULL OR downloads < max_downloads)
+		RETURNING downloads`, share.ID).Scan(&downloads).Error; err != nil {
+		return &apiError{err: err}
+	}
+	if len(downloads) == 0 {
+		a.disableShare(share.ID, shareLimitDownloads)
+		return &apiError{err: ErrShareDisabled, code: http.StatusGone}
+	}
+	if share.MaxDownloads != nil && downloads[0] >= *share.MaxDownloads {
+		a.disableShare(share.ID, shareLimitDownloads)
+	}
+	return nil
+}
+
+func (a *apiService) disableShare(id, reason string) {
+	a.db.Model(&models.FileShare{}).Where("id = ?", id).Where("disabled_at IS NULL").
+		Updates(map[string]any{"disabled_at": time.Now().UTC(), "disabled_reason": reason})
+	a.cache.Delete(cache.Key("shares", id))
+}
+
+func (a *apiService) recordShareAccess(r *http.Request, shareId, accessType string, bytes int64) {
+	access := models.ShareAccess{
+		ShareId:   shareId,
+		Type:      accessType,
+		IP:        clientIP(r),
+		UserAgent: r.UserAgent(),
+		Bytes:     bytes,
+	}
+	if err := a.db.Create(&access).Error; err != nil {
+		logging.FromContext(r.Context()).Error("failed to record share access", zap.Error(err))
+	}
+}
+
+// SharesDelete removes a share by its id, which is how multi-file shares are removed.
+func (a *apiService) SharesDelete(ctx context.Context, params api.SharesDeleteParams) error {
+	var deleted []models.FileShare
+	if err := a.db.Clauses(clause.Returning{}).Where("id = ?", params.ID).Where("user_id = ?", auth.GetUser(ctx)).
+		Delete(&deleted).Error; err != nil {
+		return &apiError{err: err}
+	}
+	if len(deleted) == 0 {
+		return &apiError{err: errors.New("invalid share"), code: http.StatusNotFound}
+	}
+	a.cache.Delete(cache.Key("shares", params.ID))
+	return nil
+}
+
+// FilesShareAnalytics reports on the latest share of a file, SharesAnalytics on any
+// share by its id.
+func (a *apiService) FilesShareAnalytics(ctx context.Context, params api.FilesShareAnalyticsParams) (*api.ShareAnalytics, error) {
+	var shares []models.FileShare
+	if err := a.db.Where("file_id = ?", params.ID).Where("user_id = ?", auth.GetUser(ctx)).
+		Order("created_at DESC, id DESC").Limit(1).Find(&shares).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if len(shares) == 0 {
+		return nil, &apiError{err: errors.New("invalid share"), code: http.StatusNotFound}
+	}
+	return a.shareAnalytics(&shares[0])
+}
+
+func (a *apiService) SharesAnalytics(ctx context.Context, params api.SharesAnalyticsParams) (*api.ShareAnalytics, error) {
+	var shares []models.FileShare
+	if err := a.db.Where("id = ?", params.ID).Where("user_id = ?", auth.GetUser(ctx)).
+		Find(&shares).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if len(shares) == 0 {
+		return nil, &apiError{err: errors.New("invalid share"), code: http.StatusNotFound}
+	}
+	return a.shareAnalytics(&shares[0])
+}
+
+func (a *apiService) shareAnalytics(share *models.FileShare) (*api.ShareAnalytics, error) {
+	var stats struct {
+		Views          int64
+		UniqueIps      int64
+		BytesServed    int64
+		LastAccessedAt *time.Time
+	}
+	if err := a.db.Model(&models.ShareAccess{}).Where("share_id = ?", share.ID).
+		Select("count(*) FILTER (WHERE type = ?) AS views, count(DISTINCT ip) AS unique_ips, "+
+			"coalesce(sum(bytes), 0) AS bytes_served, max(created_at) AS last_accessed_at", shareAccessList).
+		Scan(&stats).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+
+	var accesses []models.ShareAccess
+	if err := a.db.Where("share_id = ?", share.ID).Order("created_at DESC").Limit(shareRecentAccesses).
+		Find(&accesses).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+
+	res := &api.ShareAnalytics{
+		Downloads:   share.Downloads,
+		Views:       stats.Views,
+		UniqueIps:   stats.UniqueIps,
+		BytesServed: stats.BytesServed,
+		Disabled:    share.DisabledAt != nil,
+		Accesses: utils.Map(accesses, func(access models.ShareAccess) api.ShareAccess {
+			return api.ShareAccess{
+				Type:      api.ShareAccessType(access.Type),
+				IP:        access.IP,
+				UserAgent: api.NewOptString(access.UserAgent),
+				Bytes:     access.Bytes,
+				CreatedAt: access.CreatedAt,
+			}
+		}),
+	}
+	if stats.LastAccessedAt != nil {
+		res.LastAccessedAt = api.NewOptDateTime(*stats.LastAccessedAt)
+	}
+	if share.DisabledAt != nil {
+		res.DisabledAt = api.NewOptDateTime(*share.DisabledAt)
+	}
+	if share.DisabledReason != nil {
+		res.DisabledReason = api.NewOptString(*share.DisabledReason)
+	}
+	return res, nil
+}
+
+// clientIP returns the address of the client, forwarding headers only count when
+// they come from a configured trusted proxy.
+func clientIP(r *http.Request) string {
+	return middleware.GetClientIP(r)
+}
+
 func (a *apiService) SharesStream(ctx context.Context, params api.SharesStreamParams) (api.SharesStreamRes, error) {
 	return nil, nil
 }
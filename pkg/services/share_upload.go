@@ -0,0 +1,274 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/appcontext"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"github.com/tgdrive/teldrive/pkg/types"
+)
+
+var (
+	ErrShareUploadOnly     = errors.New("share only accepts uploads")
+	ErrShareNotUpload      = errors.New("share does not accept uploads")
+	ErrExtensionNotAllowed = errors.New("file extension is not allowed")
+	ErrShareFileTooLarge   = errors.New("file exceeds the share size limit")
+	ErrShareFileLimit      = errors.New("share accepts no more files")
+)
+
+const (
+	shareModeDownload = "download"
+	shareModeUpload   = "upload"
+
+	shareLimitFiles = "files"
+)
+
+func (a *apiService) SharesUpload(ctx context.Context, req *api.SharesUploadReqWithContentType, params api.SharesUploadParams) (*api.UploadPart, error) {
+	c := ctx.(*appcontext.Context)
+	share, err := a.uploadShare(c.Request, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkShareExtension(share, params.FileName); err != nil {
+		return nil, err
+	}
+
+	uploadId := shareUploadId(share.ID, params.UploadId)
+	if err := a.checkShareUploadLimits(share, uploadId, params.ContentLength); err != nil {
+		return nil, err
+	}
+
+	ownerCtx, err := a.ownerContext(ctx, share.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return a.UploadsUpload(ownerCtx, &api.UploadsUploadReqWithContentType{
+		ContentType: req.ContentType,
+		Content:     api.UploadsUploadReq{Data: req.Content.Data},
+	}, api.UploadsUploadParams{
+		ID:            uploadId,
+		ContentLength: params.ContentLength,
+		PartName:      params.PartName,
+		FileName:      params.FileName,
+		PartNo:        params.PartNo,
+		ParentId:      api.NewOptString(*share.FileId),
+	})
+}
+
+func (a *apiService) SharesCreateFile(ctx context.Context, req *api.ShareFileCreate, params api.SharesCreateFileParams) (*api.File, error) {
+	c := ctx.(*appcontext.Context)
+	share, err := a.uploadShare(c.Request, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkShareExtension(share, req.Name); err != nil {
+		return nil, err
+	}
+
+	uploadId := shareUploadId(share.ID, req.UploadId)
+	var uploads []models.Upload
+	if err := a.db.Where("upload_id = ?", uploadId).Order("part_no").Find(&uploads).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if len(uploads) == 0 {
+		return nil, &apiError{err: errors.New("no uploaded parts"), code: http.StatusBadRequest}
+	}
+
+	// The parts are taken from the upload records rather than from the request, so the
+	// uploader can only reference messages it uploaded itself.
+	var size int64
+	parts := make([]api.Part, 0, len(uploads))
+	for _, upload := range uploads {
+		size += upload.Size
+		parts = append(parts, api.Part{ID: upload.PartId})
+	}
+	if share.MaxFileSize != nil && size > *share.MaxFileSize {
+		return nil, &apiError{err: ErrShareFileTooLarge, code: http.StatusRequestEntityTooLarge}
+	}
+	channelId := uploads[0].ChannelId
+	if err := fillPartsFromUploads(a.db, share.UserId, uploadId, parts, channelId); err != nil {
+		return nil, &apiError{err: err}
+	}
+
+	name, err := a.freeShareName(share, req.Name)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	if err := a.countShareUpload(share); err != nil {
+		return nil, err
+	}
+
+	ownerCtx, err := a.ownerContext(ctx, share.UserId)
+	if err != nil {
+		return nil, err
+	}
+	file, err := a.FilesCreate(ownerCtx, &api.File{
+		Name:      name,
+		Type:      api.FileTypeFile,
+		ParentId:  api.NewOptString(*share.FileId),
+		MimeType:  req.MimeType,
+		Size:      api.NewOptInt64(size),
+		ChannelId: api.NewOptInt64(channelId),
+		Parts:     parts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.db.Where("upload_id = ?", uploadId).Delete(&models.Upload{})
+	a.recordShareAccess(c.Request, share.ID, shareAccessUpload, size)
+	return file, nil
+}
+
+// uploadShare validates the share like validFileShare and makes sure it accepts uploads.
+func (a *apiService) uploadShare(r *http.Request, id string) (*fileShare, error) {
+	share, err := a.validFileShare(r, id)
+	if err != nil {
+		return nil, err
+	}
+	if share.Mode != shareModeUpload {
+		return nil, &apiError{err: ErrShareNotUpload, code: http.StatusForbidden}
+	}
+	return share, nil
+}
+
+// checkShareUploadLimits keeps a part within the limits of the share. Uploads whose file
+// is not created yet count as files, so starting many uploads doesn't get around them.
+func (a *apiService) checkShareUploadLimits(share *fileShare, uploadId string, size int64) error {
+	if share.MaxFileSize == nil && share.MaxFiles == nil {
+		return nil
+	}
+	type pendingUpload struct {
+		UploadId string
+		Size     int64
+	}
+	var pending []pendingUpload
+	if err := a.db.Model(&models.Upload{}).Select("upload_id", "sum(size) AS size").
+		Where("upload_id LIKE ?", shareUploadId(share.ID, "")+"%").Group("upload_id").
+		Scan(&pending).Error; err != nil {
+		return &apiError{err: err}
+	}
+	current := slices.IndexFunc(pending, func(p pendingUpload) bool { return p.UploadId == uploadId })
+	if share.MaxFileSize != nil {
+		uploaded := int64(0)
+		if current >= 0 {
+			uploaded = pending[current].Size
+		}
+		if uploaded+size > *share.MaxFileSize {
+			return &apiError{err: ErrShareFileTooLarge, code: http.StatusRequestEntityTooLarge}
+		}
+	}
+	if share.MaxFiles != nil && current < 0 {
+		var files []int64
+		if err := a.db.Model(&models.FileShare{}).Where("id = ?", share.ID).
+			Pluck("uploaded_files", &files).Error; err != nil {
+			return &apiError{err: err}
+		}
+		if len(files) == 0 || files[0]+int64(len(pending)) >= *share.MaxFiles {
+			return &apiError{err: ErrShareFileLimit, code: http.StatusForbidden}
+		}
+	}
+	return nil
+}
+
+// countShareUpload takes one file off the share allowance and disables the share once
+// the last one is received.
+func (a *apiService) countShareUpload(share *fileShare) error {
+	var uploaded []int64
+	if err := a.db.Raw(`UPDATE teldrive.file_shares SET uploaded_files = uploaded_files + 1
+		WHERE id = ? AND disabled_at IS NULL AND (max_files IS NULL OR uploaded_files < max_files)
+		RETURNING uploaded_files`, share.ID).Scan(&uploaded).Error; err != nil {
+		return &apiError{err: err}
+	}
+	if len(uploaded) == 0 {
+		a.disableShare(share.ID, shareLimitFiles)
+		return &apiError{err: ErrShareDisabled, code: http.StatusGone}
+	}
+	if share.MaxFiles != nil && uploaded[0] >= *share.MaxFiles {
+		a.disableShare(share.ID, shareLimitFiles)
+	}
+	return nil
+}
+
+// freeShareName returns a name that is not taken in the shared folder. Uploaders can't
+// see the folder, so a clash gets a numbered name instead of replacing the owner's file.
+func (a *apiService) freeShareName(share *fileShare, name string) (string, error) {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	candidate := name
+	for i := 1; ; i++ {
+		stored, err := a.nameForParent(a.db, share.UserId, share.FileId, candidate)
+		if err != nil {
+			return "", err
+		}
+		var count int64
+		if err := a.db.Model(&models.File{}).Where("parent_id = ?", *share.FileId).Where("user_id = ?", share.UserId).
+			Where("name = ?", stored.Name).Where("status = ?", "active").Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s (%d)%s", base, i, ext)
+	}
+}
+
+// ownerContext returns a context acting as the share owner. The owner's latest session
+// is used when no bots are configured for the upload channel.
+func (a *apiService) ownerContext(ctx context.Context, userId int64) (context.Context, error) {
+	session, err := a.ownerSession(userId)
+	if err != nil {
+		return nil, err
+	}
+	return auth.WithUser(ctx, &types.JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: strconv.FormatInt(userId, 10)},
+		TgSession:        session.Session,
+	}), nil
+}
+
+// ownerSession returns the latest session of a share owner.
+func (a *apiService) ownerSession(userId int64) (*models.Session, error) {
+	var sessions []models.Session
+	if err := a.db.Where("user_id = ?", userId).Order("created_at DESC").Limit(1).Find(&sessions).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if len(sessions) == 0 {
+		return nil, &apiError{err: errors.New("share owner has no active session"), code: http.StatusServiceUnavailable}
+	}
+	return &sessions[0], nil
+}
+
+func checkShareExtension(share *fileShare, name string) error {
+	if len(share.AllowedExtensions) == 0 {
+		return nil
+	}
+	ext := strings.ToLower(filepath.Ext(name))
+	if slices.ContainsFunc(share.AllowedExtensions, func(allowed string) bool {
+		return strings.ToLower("."+strings.TrimPrefix(allowed, ".")) == ext
+	}) {
+		return nil
+	}
+	return &apiError{err: ErrExtensionNotAllowed, code: http.StatusUnsupportedMediaType}
+}
+
+// shareUploadId scopes anonymous upload ids to their share.
+func shareUploadId(shareId, uploadId string) string {
+	return "share:" + shareId + ":" + uploadId
+}
+
+// normalizeExtensions stores extensions lowercase with a leading dot.
+func normalizeExtensions(exts []string) []string {
+	return utils.Map(exts, func(ext string) string {
+		return "." + strings.TrimPrefix(strings.ToLower(strings.TrimSpace(ext)), ".")
+	})
+}
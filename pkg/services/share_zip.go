@@ -0,0 +1,175 @@
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gotd/td/telegram"
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/reader"
+	"github.com/tgdrive/teldrive/internal/tgc"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"go.uber.org/zap"
+)
+
+// skippedFilesName is the archive entry listing the files a zip download left out.
+const skippedFilesName = "skipped files.txt"
+
+type zipEntry struct {
+	models.File
+	ZipPath string
+}
+
+// SharesZip streams every file of a share as a single zip archive. Entries are stored
+// without compression, so the archive is written while the parts are downloaded.
+func (e *extendedService) SharesZip(w http.ResponseWriter, r *http.Request, shareId string) {
+	ctx := r.Context()
+	share, err := e.api.validFileShare(r, shareId)
+	if err != nil && errors.Is(err, ErrEmptyAuth) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Restricted"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), shareErrorStatus(err))
+		return
+	}
+	if share.Mode == shareModeUpload {
+		http.Error(w, ErrShareUploadOnly.Error(), http.StatusForbidden)
+		return
+	}
+
+	entries, err := e.api.shareZipEntries(share)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	session, err := e.api.ownerSession(share.UserId)
+	if err != nil {
+		http.Error(w, err.Error(), shareErrorStatus(err))
+		return
+	}
+	if err := e.api.countShareDownload(share); err != nil {
+		http.Error(w, err.Error(), shareErrorStatus(err))
+		return
+	}
+
+	client, err := tgc.AuthClient(ctx, &e.api.cnf.TG, session.Session, e.api.middlewares...)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Parts under a personal key can't be read without the owner's passphrase, the
+	// archive lists those files instead.
+	var skipped []string
+	entries = slices.DeleteFunc(entries, func(entry zipEntry) bool {
+		if personalKeyFile(&entry.File) {
+			skipped = append(skipped, entry.ZipPath)
+			return true
+		}
+		return false
+	})
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+		map[string]string{"filename": e.api.shareName(share) + ".zip"}))
+	w.WriteHeader(http.StatusOK)
+
+	cw := &countingWriter{ResponseWriter: w}
+	err = tgc.RunWithAuth(ctx, client, "", func(ctx context.Context) error {
+		zw := zip.NewWriter(cw)
+		for i := range entries {
+			if err := e.api.writeZipEntry(ctx, zw, client, &entries[i]); err != nil {
+				return err
+			}
+		}
+		if len(skipped) > 0 {
+			hw, err := zw.Create(skippedFilesName)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(hw, "These files are encrypted with a personal key and were left out:\n\n"+
+				strings.Join(skipped, "\n")+"\n"); err != nil {
+				return err
+			}
+		}
+		return zw.Close()
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("share zip failed", zap.String("shareId", share.ID), zap.Error(err))
+	}
+	e.api.recordShareAccess(r, share.ID, shareAccessDownload, cw.n)
+}
+
+func (a *apiService) writeZipEntry(ctx context.Context, zw *zip.Writer, client *telegram.Client, entry *zipEntry) error {
+	if entry.Type == "folder" {
+		_, err := zw.CreateHeader(&zip.FileHeader{Name: entry.ZipPath + "/", Modified: entry.UpdatedAt})
+		return err
+	}
+	hw, err := zw.CreateHeader(&zip.FileHeader{Name: entry.ZipPath, Method: zip.Store, Modified: entry.UpdatedAt})
+	if err != nil {
+		return err
+	}
+	if entry.Size == nil || *entry.Size == 0 || len(entry.Parts) == 0 {
+		return nil
+	}
+	parts, err := getParts(ctx, client, a.cache, &entry.File)
+	if err != nil {
+		return err
+	}
+	lr, err := reader.NewLinearReader(ctx, client.API(), a.cache, &entry.File, parts, 0, *entry.Size-1, &a.cnf.TG,
+		a.partKeys(entry.UserId, ""), 0)
+	if err != nil {
+		return err
+	}
+	defer lr.Close()
+	_, err = io.Copy(hw, lr)
+	return err
+}
+
+// personalKeyFile reports whether parts of a file are encrypted with its owner's
+// personal key.
+func personalKeyFile(file *models.File) bool {
+	return file.Encrypted != nil && *file.Encrypted && slices.ContainsFunc(file.Parts, func(part api.Part) bool {
+		return part.KeyId.Value == userKeyId
+	})
+}
+
+// shareZipEntries returns the files and folders below the share roots with their
+// readable path inside the archive, sorted by path so parents come first.
+func (a *apiService) shareZipEntries(share *fileShare) ([]zipEntry, error) {
+	var entries []zipEntry
+	if err := a.db.Raw(`WITH RECURSIVE tree AS (
+			SELECT f.*, 0 AS depth FROM teldrive.files f
+			WHERE f.id IN ? AND f.status = 'active'
+			UNION ALL
+			SELECT f.*, tree.depth + 1 FROM teldrive.files f
+			JOIN tree ON f.parent_id = tree.id WHERE f.status = 'active'
+		)
+		SELECT * FROM tree ORDER BY depth`, share.roots()).Scan(&entries).Error; err != nil {
+		return nil, err
+	}
+	paths := make(map[string]string, len(entries))
+	roots := share.roots()
+	for i := range entries {
+		name, err := a.plainName(&entries[i].File)
+		if err != nil {
+			return nil, err
+		}
+		entries[i].ZipPath = name
+		if entries[i].ParentId != nil && !slices.Contains(roots, entries[i].ID) {
+			entries[i].ZipPath = paths[*entries[i].ParentId] + "/" + name
+		}
+		paths[entries[i].ID] = entries[i].ZipPath
+	}
+	slices.SortFunc(entries, func(a, b zipEntry) int { return strings.Compare(a.ZipPath, b.ZipPath) })
+	return entries, nil
+}
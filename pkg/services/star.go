@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/cache"
+	"github.com/tgdrive/teldrive/internal/events"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// recentWindow is how far back opened files count as recent. Changes come from the
+	// events table, which is cleaned up sooner.
+	recentWindow = 30 * 24 * time.Hour
+
+	// fileOpenInterval throttles open tracking, players issue many range requests
+	// for a single view.
+	fileOpenInterval = 5 * time.Minute
+)
+
+var recentEvents = []string{string(events.OpCreate), string(events.OpUpdate), string(events.OpMove), string(events.OpCopy)}
+
+func (a *apiService) FilesStar(ctx context.Context, params api.FilesStarParams) error {
+	userId := auth.GetUser(ctx)
+	if err := a.ownFile(userId, params.ID); err != nil {
+		return err
+	}
+	if err := a.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.FileStar{UserId: userId, FileId: params.ID}).Error; err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+func (a *apiService) FilesUnstar(ctx context.Context, params api.FilesUnstarParams) error {
+	userId := auth.GetUser(ctx)
+	if err := a.db.Where("user_id = ?", userId).Where("file_id = ?", params.ID).
+		Delete(&models.FileStar{}).Error; err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+// recordFileOpen remembers that the user streamed a file for the recent view.
+func (a *apiService) recordFileOpen(userId int64, fileId string) {
+	key := cache.Key("opens", userId, fileId)
+	var seen bool
+	if a.cache.Get(key, &seen) == nil {
+		return
+	}
+	a.cache.Set(key, true, fileOpenInterval)
+	a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "file_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"opened_at": time.Now().UTC()}),
+	}).Create(&models.FileOpen{UserId: userId, FileId: fileId, OpenedAt: time.Now().UTC()})
+}
+
+func (a *apiService) isStarred(userId int64, fileId string) (bool, error) {
+	var count int64
+	err := a.db.Model(&models.FileStar{}).Where("user_id = ?", userId).Where("file_id = ?", fileId).Count(&count).Error
+	return count > 0, err
+}
+
+func starredFiles(db *gorm.DB, userId int64) *gorm.DB {
+	return db.Model(&models.FileStar{}).Select("file_id").Where("user_id = ?", userId)
+}
+
+// recentFiles selects files the user opened or changed lately.
+func recentFiles(db *gorm.DB, userId int64) *gorm.DB {
+	since := time.Now().UTC().Add(-recentWindow)
+	return db.Raw(`SELECT file_id FROM teldrive.file_opens WHERE user_id = ? AND opened_at > ?
+		UNION
+		SELECT (source->>'id')::uuid FROM teldrive.events
+		WHERE user_id = ? AND type IN ? AND created_at > ? AND coalesce(source->>'id', '') <> ''`,
+		userId, since, userId, recentEvents, since)
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+
+	"github.com/gotd/contrib/storage"
+	"github.com/gotd/td/tg"
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/cache"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/tgc"
+	"github.com/tgdrive/teldrive/internal/tgstorage"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func getStoragePolicy(db *gorm.DB, c cache.Cacher, userId int64) (*models.StoragePolicy, error) {
+	return cache.Fetch(c, cache.Key("users", "policy", userId), 0, func() (*models.StoragePolicy, error) {
+		var policies []models.StoragePolicy
+		if err := db.Where("user_id = ?", userId).Find(&policies).Error; err != nil {
+			return nil, err
+		}
+		if len(policies) == 0 {
+			return &models.StoragePolicy{UserId: userId, Mode: string(api.StoragePolicyModeSingle)}, nil
+		}
+		return &policies[0], nil
+	})
+}
+
+// selectChannel picks the channel for a part. Round robin keeps all parts of an
+// upload together while stripe spreads consecutive parts over the channel set.
+func selectChannel(policy *models.StoragePolicy, defaultChannelId int64, uploadId string, partNo int) int64 {
+	channels := policy.ChannelIds
+	if len(channels) == 0 {
+		return defaultChannelId
+	}
+	h := fnv.New32a()
+	h.Write([]byte(uploadId))
+	offset := int(h.Sum32() % uint32(len(channels)))
+	switch api.StoragePolicyMode(policy.Mode) {
+	case api.StoragePolicyModeRoundRobin:
+		return channels[offset]
+	case api.StoragePolicyModeStripe:
+		return channels[(offset+partNo)%len(channels)]
+	}
+	return defaultChannelId
+}
+
+// storageChannels returns the default channel followed by the policy channel set.
+func storageChannels(policy *models.StoragePolicy, defaultChannelId int64) []int64 {
+	channels := []int64{defaultChannelId}
+	for _, id := range policy.ChannelIds {
+		if !slices.Contains(channels, id) {
+			channels = append(channels, id)
+		}
+	}
+	return channels
+}
+
+func (a *apiService) uploadChannel(userId int64, uploadId string, partNo int) (int64, error) {
+	defaultChannelId, err := getDefaultChannel(a.db, a.cache, userId)
+	if err != nil {
+		return 0, err
+	}
+	policy, err := getStoragePolicy(a.db, a.cache, userId)
+	if err != nil {
+		return 0, err
+	}
+	return selectChannel(policy, defaultChannelId, uploadId, partNo), nil
+}
+
+func (a *apiService) createChannel(ctx context.Context, session string, userId int64, name string) (*tg.Channel, error) {
+	peerStorage := tgstorage.NewPeerStorage(a.tgdb, cache.Key("peers", userId))
+	client, err := tgc.AuthClient(ctx, &a.cnf.TG, session, a.middlewares...)
+	if err != nil {
+		return nil, err
+	}
+	var channel *tg.Channel
+	err = client.Run(ctx, func(ctx context.Context) error {
+		res, err := client.API().ChannelsCreateChannel(ctx, &tg.ChannelsCreateChannelRequest{
+			Title:     name,
+			Broadcast: true,
+		})
+		if err != nil {
+			return err
+		}
+		var ok, found bool
+		updates := res.(*tg.Updates)
+		for _, update := range updates.Chats {
+			channel, ok = update.(*tg.Channel)
+			if ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.New("faield to create channel")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	peer := storage.Peer{}
+	peer.FromChat(channel)
+	peerStorage.Add(ctx, peer)
+	return channel, nil
+}
+
+// trackChannelUsage counts an uploaded message against its channel and rolls the
+// channel over once the user's threshold is reached. Uploads may land past the
+// threshold, the first one to claim the channel rolls it over.
+func (a *apiService) trackChannelUsage(ctx context.Context, session string, userId, channelId int64) {
+	var count int64
+	if err := a.db.Raw("UPDATE teldrive.channels SET message_count = message_count + 1 WHERE channel_id = ? RETURNING message_count",
+		channelId).Scan(&count).Error; err != nil {
+		return
+	}
+	policy, err := getStoragePolicy(a.db, a.cache, userId)
+	if err != nil || policy.RolloverThreshold == 0 || count < policy.RolloverThreshold {
+		return
+	}
+	res := a.db.Model(&models.Channel{}).Where("channel_id = ?", channelId).Where("rolled_over = ?", false).
+		Update("rolled_over", true)
+	if res.Error != nil || res.RowsAffected == 0 {
+		return
+	}
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		if err := a.rolloverChannel(ctx, session, userId, channelId); err != nil {
+			logging.FromContext(ctx).Error("channel rollover failed", zap.Int64("channelId", channelId), zap.Error(err))
+			// Let the next upload try again.
+			a.db.Model(&models.Channel{}).Where("channel_id = ?", channelId).Update("rolled_over", false)
+		}
+	}()
+}
+
+func (a *apiService) rolloverChannel(ctx context.Context, session string, userId, fullChannelId int64) error {
+	var channels []models.Channel
+	if err := a.db.Where("user_id = ?", userId).Find(&channels).Error; err != nil {
+		return err
+	}
+	name := "TelDrive Storage"
+	selected := false
+	for _, channel := range channels {
+		if channel.ChannelId == fullChannelId {
+			if channel.ChannelName != "" {
+				name = channel.ChannelName
+			}
+			selected = channel.Selected
+		}
+	}
+	created, err := a.createChannel(ctx, session, userId, fmt.Sprintf("%s %d", name, len(channels)+1))
+	if err != nil {
+		return err
+	}
+	newChannel := models.Channel{ChannelId: created.ID, ChannelName: created.Title, UserId: userId, Selected: selected}
+
+	tokens, err := getBotsToken(a.db, a.cache, userId, fullChannelId)
+	if err != nil {
+		return err
+	}
+	if len(tokens) > 0 {
+		client, err := tgc.AuthClient(ctx, &a.cnf.TG, session, a.middlewares...)
+		if err != nil {
+			return err
+		}
+		if err := a.addBots(ctx, client, userId, newChannel.ChannelId, tokens); err != nil {
+			return err
+		}
+	}
+
+	policy, err := getStoragePolicy(a.db, a.cache, userId)
+	if err != nil {
+		return err
+	}
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&newChannel).Error; err != nil {
+			return err
+		}
+		if selected {
+			if err := tx.Model(&models.Channel{}).Where("channel_id != ?", newChannel.ChannelId).
+				Where("user_id = ?", userId).Update("selected", false).Error; err != nil {
+				return err
+			}
+		}
+		if index := slices.Index(policy.ChannelIds, fullChannelId); index >= 0 {
+			channelIds := slices.Clone(policy.ChannelIds)
+			channelIds[index] = newChannel.ChannelId
+			if err := tx.Model(&models.StoragePolicy{}).Where("user_id = ?", userId).
+				Update("channel_ids", datatypes.NewJSONSlice(channelIds)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if selected {
+		a.cache.Set(cache.Key("users", "channel", userId), newChannel.ChannelId, 0)
+	}
+	a.cache.Delete(cache.Key("users", "policy", userId))
+	logging.FromContext(ctx).Info("rolled over channel", zap.Int64("from", fullChannelId),
+		zap.Int64("to", newChannel.ChannelId))
+	return nil
+}
+
+func (a *apiService) saveStoragePolicy(userId int64, req *api.StoragePolicy) error {
+	policy := models.StoragePolicy{
+		UserId:            userId,
+		Mode:              string(req.Mode),
+		ChannelIds:        datatypes.NewJSONSlice(req.ChannelIds),
+		RolloverThreshold: req.RolloverThreshold.Value,
+	}
+	if policy.Mode != string(api.StoragePolicyModeSingle) && len(policy.ChannelIds) == 0 {
+		return &apiError{err: errors.New("channel ids are required for this mode"), code: 400}
+	}
+	if len(policy.ChannelIds) > 0 {
+		channelIds := slices.Compact(slices.Sorted(slices.Values(req.ChannelIds)))
+		var owned int64
+		if err := a.db.Model(&models.Channel{}).Where("channel_id IN ?", channelIds).
+			Where("user_id = ?", userId).Count(&owned).Error; err != nil {
+			return &apiError{err: err}
+		}
+		if owned != int64(len(channelIds)) {
+			return &apiError{err: errors.New("channel not found"), code: 400}
+		}
+	}
+	err := a.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"mode", "channel_ids", "rollover_threshold", "updated_at"}),
+	}).Create(&policy).Error
+	if err != nil {
+		return &apiError{err: err}
+	}
+	a.cache.Delete(cache.Key("users", "policy", userId))
+	return nil
+}
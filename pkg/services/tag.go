@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/database"
+	"github.com/tgdrive/teldrive/internal/utils"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrTagNotFound = errors.New("tag not found")
+
+type tagCount struct {
+	models.Tag
+	FileCount int64
+}
+
+func (a *apiService) TagsList(ctx context.Context) ([]api.Tag, error) {
+	userId := auth.GetUser(ctx)
+	var tags []tagCount
+	if err := a.db.Model(&models.Tag{}).Where("user_id = ?", userId).
+		Select("tags.*", "(SELECT count(*) FROM teldrive.file_tags ft WHERE ft.tag_id = tags.id) AS file_count").
+		Order("lower(name)").Scan(&tags).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	return utils.Map(tags, toTagOut), nil
+}
+
+func (a *apiService) TagsCreate(ctx context.Context, req *api.TagCreate) (*api.Tag, error) {
+	userId := auth.GetUser(ctx)
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, &apiError{err: errors.New("tag name is required"), code: http.StatusBadRequest}
+	}
+	tag := models.Tag{UserId: userId, Name: name}
+	if req.Color.Value != "" {
+		tag.Color = utils.Ptr(req.Color.Value)
+	}
+	if err := a.db.Create(&tag).Error; err != nil {
+		if database.IsKeyConflictErr(err) {
+			return nil, &apiError{err: errors.New("tag already exists"), code: http.StatusConflict}
+		}
+		return nil, &apiError{err: err}
+	}
+	return utils.Ptr(toTagOut(tagCount{Tag: tag})), nil
+}
+
+func (a *apiService) TagsUpdate(ctx context.Context, req *api.TagUpdate, params api.TagsUpdateParams) (*api.Tag, error) {
+	userId := auth.GetUser(ctx)
+	updates := map[string]any{}
+	if name := strings.TrimSpace(req.Name.Value); name != "" {
+		updates["name"] = name
+	}
+	if req.Color.IsSet() {
+		updates["color"] = req.Color.Value
+	}
+	if len(updates) > 0 {
+		res := a.db.Model(&models.Tag{}).Where("id = ?", params.ID).Where("user_id = ?", userId).Updates(updates)
+		if res.Error != nil {
+			if database.IsKeyConflictErr(res.Error) {
+				return nil, &apiError{err: errors.New("tag already exists"), code: http.StatusConflict}
+			}
+			return nil, &apiError{err: res.Error}
+		}
+	}
+	var tags []tagCount
+	if err := a.db.Model(&models.Tag{}).Where("id = ?", params.ID).Where("user_id = ?", userId).
+		Select("tags.*", "(SELECT count(*) FROM teldrive.file_tags ft WHERE ft.tag_id = tags.id) AS file_count").
+		Scan(&tags).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	if len(tags) == 0 {
+		return nil, &apiError{err: ErrTagNotFound, code: http.StatusNotFound}
+	}
+	return utils.Ptr(toTagOut(tags[0])), nil
+}
+
+func (a *apiService) TagsDelete(ctx context.Context, params api.TagsDeleteParams) error {
+	userId := auth.GetUser(ctx)
+	if err := a.db.Where("id = ?", params.ID).Where("user_id = ?", userId).Delete(&models.Tag{}).Error; err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+func (a *apiService) TagsStats(ctx context.Context) ([]api.TagStats, error) {
+	userId := auth.GetUser(ctx)
+	var stats []struct {
+		Name        string
+		FileCount   int64
+		FolderCount int64
+		TotalSize   int64
+	}
+	if err := a.db.Raw(`SELECT t.name,
+			count(f.id) FILTER (WHERE f.type = 'file') AS file_count,
+			count(f.id) FILTER (WHERE f.type = 'folder') AS folder_count,
+			coalesce(sum(f.size) FILTER (WHERE f.type = 'file'), 0)::bigint AS total_size
+		FROM teldrive.tags t
+		LEFT JOIN teldrive.file_tags ft ON ft.tag_id = t.id
+		LEFT JOIN teldrive.files f ON f.id = ft.file_id AND f.status = 'active'
+		WHERE t.user_id = ?
+		GROUP BY t.id, t.name
+		ORDER BY file_count DESC, lower(t.name)`, userId).Scan(&stats).Error; err != nil {
+		return nil, &apiError{err: err}
+	}
+	res := make([]api.TagStats, 0, len(stats))
+	for _, stat := range stats {
+		res = append(res, api.TagStats{
+			Name:        stat.Name,
+			FileCount:   stat.FileCount,
+			FolderCount: api.NewOptInt64(stat.FolderCount),
+			TotalSize:   stat.TotalSize,
+		})
+	}
+	return res, nil
+}
+
+func (a *apiService) FilesUpdateTags(ctx context.Context, req *api.FileTagsUpdate) error {
+	userId := auth.GetUser(ctx)
+
+	var owned int64
+	if err := a.db.Model(&models.File{}).Where("id IN ?", req.Ids).Where("user_id = ?", userId).
+		Count(&owned).Error; err != nil {
+		return &apiError{err: err}
+	}
+	ids := slices.Compact(slices.Sorted(slices.Values(req.Ids)))
+	if owned != int64(len(ids)) {
+		return &apiError{err: ErrFileNotFound, code: http.StatusNotFound}
+	}
+
+	if err := a.db.Transaction(func(tx *gorm.DB) error {
+		return updateFileTags(tx, userId, ids, req.Add, req.Remove)
+	}); err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+// updateFileTags adds and removes tags by name on files of the user.
+func updateFileTags(tx *gorm.DB, userId int64, ids, add, remove []string) error {
+	if len(remove) > 0 {
+		if err := tx.Where("file_id IN ?", ids).
+			Where("tag_id IN (SELECT id FROM teldrive.tags WHERE user_id = ? AND lower(name) IN ?)",
+				userId, utils.Map(remove, strings.ToLower)).
+			Delete(&models.FileTag{}).Error; err != nil {
+			return err
+		}
+	}
+	if len(add) == 0 {
+		return nil
+	}
+	tagIds, err := ensureTags(tx, userId, add)
+	if err != nil {
+		return err
+	}
+	var fileTags []models.FileTag
+	for _, fileId := range ids {
+		for _, tagId := range tagIds {
+			fileTags = append(fileTags, models.FileTag{FileId: fileId, TagId: tagId})
+		}
+	}
+	if len(fileTags) == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&fileTags).Error
+}
+
+// ensureTags returns the ids of the named tags, creating the ones the user doesn't
+// have yet. Names are matched case-insensitively.
+func ensureTags(tx *gorm.DB, userId int64, names []string) ([]string, error) {
+	names = utils.Filter(utils.Map(names, strings.TrimSpace), func(name string) bool {
+		return name != ""
+	})
+	if len(names) == 0 {
+		return nil, nil
+	}
+	for _, name := range names {
+		if err := tx.Exec(`INSERT INTO teldrive.tags (user_id, name) VALUES (?, ?)
+			ON CONFLICT (user_id, lower(name)) DO NOTHING`, userId, name).Error; err != nil {
+			return nil, err
+		}
+	}
+	var ids []string
+	if err := tx.Model(&models.Tag{}).Where("user_id = ?", userId).
+		Where("lower(name) IN ?", utils.Map(names, strings.ToLower)).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// fileTagNames returns the names of the tags on a file.
+func (a *apiService) fileTagNames(fileId string) ([]string, error) {
+	var names []string
+	err := a.db.Model(&models.Tag{}).Joins("JOIN teldrive.file_tags ft ON ft.tag_id = tags.id").
+		Where("ft.file_id = ?", fileId).Order("lower(tags.name)").Pluck("tags.name", &names).Error
+	return names, err
+}
+
+func toTagOut(tag tagCount) api.Tag {
+	res := api.Tag{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		FileCount: tag.FileCount,
+	}
+	if tag.Color != nil {
+		res.Color = api.NewOptString(*tag.Color)
+	}
+	return res
+}
@@ -0,0 +1,433 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gotd/td/telegram"
+	"github.com/gotd/td/telegram/message"
+	"github.com/gotd/td/telegram/uploader"
+	"github.com/gotd/td/tg"
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/cache"
+	"github.com/tgdrive/teldrive/internal/category"
+	"github.com/tgdrive/teldrive/internal/crypt"
+	"github.com/tgdrive/teldrive/internal/logging"
+	"github.com/tgdrive/teldrive/internal/reader"
+	"github.com/tgdrive/teldrive/internal/tgc"
+	"github.com/tgdrive/teldrive/pkg/models"
+	"github.com/tgdrive/teldrive/pkg/types"
+	"go.uber.org/zap"
+	_ "golang.org/x/image/bmp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/tiff"
+	_ "golang.org/x/image/webp"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/datatypes"
+)
+
+var ErrNoThumbnail = errors.New("file has no thumbnail")
+
+const (
+	thumbnailQuality = 80
+
+	// Images are decoded in memory, larger ones don't get thumbnails.
+	maxThumbnailSource = 64 << 20
+	maxThumbnailPixels = 40_000_000
+
+	thumbnailBatchSize = 50
+
+	// pendingThumbnails matches files whose thumbnails were not generated yet.
+	pendingThumbnails = "coalesce(thumbnails, 'null'::jsonb) = 'null'::jsonb"
+)
+
+var thumbnailSizes = map[api.FilesThumbnailSize]int{
+	api.FilesThumbnailSizeSmall: 256,
+	api.FilesThumbnailSizeLarge: 1024,
+}
+
+// thumbnailSlots bounds the generations started after uploads or by requests, the
+// backfill picks up whatever doesn't get a slot.
+var thumbnailSlots = make(chan struct{}, 2)
+
+// thumbnailRequests lets concurrent requests for a file without thumbnails share one
+// generation.
+var thumbnailRequests singleflight.Group
+
+func (a *apiService) FilesThumbnail(ctx context.Context, params api.FilesThumbnailParams) (api.FilesThumbnailOK, error) {
+	return api.FilesThumbnailOK{}, nil
+}
+
+func (e *extendedService) FilesThumbnail(w http.ResponseWriter, r *http.Request, fileId string) {
+	session, ok := e.requestSession(w, r)
+	if !ok {
+		return
+	}
+	var file models.File
+	if err := e.api.db.Where("id = ?", fileId).Where("status = ?", "active").First(&file).Error; err != nil {
+		http.Error(w, ErrFileNotFound.Error(), http.StatusNotFound)
+		return
+	}
+	if file.UserId != session.UserId {
+		if _, _, err := fileAccess(e.api.db, session.UserId, fileId); err != nil {
+			http.Error(w, ErrFileNotFound.Error(), http.StatusNotFound)
+			return
+		}
+	}
+
+	size, ok := thumbnailSizes[api.FilesThumbnailSize(r.URL.Query().Get("size"))]
+	if !ok {
+		size = thumbnailSizes[api.FilesThumbnailSizeSmall]
+	}
+	data, err := e.api.thumbnail(r.Context(), &file, size)
+	if errors.Is(err, ErrNoThumbnail) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	w.Write(data)
+}
+
+// thumbnail returns the stored thumbnail of the given size, generating the thumbnails
+// of images that have none yet.
+func (a *apiService) thumbnail(ctx context.Context, file *models.File, size int) ([]byte, error) {
+	if file.Thumbnails == nil {
+		if !canThumbnail(file) {
+			return nil, ErrNoThumbnail
+		}
+		images, err, _ := thumbnailRequests.Do(file.ID, func() (any, error) {
+			select {
+			case thumbnailSlots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			defer func() { <-thumbnailSlots }()
+			return a.generateThumbnails(context.WithoutCancel(ctx), file)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return images.(map[int][]byte)[size], nil
+	}
+	idx := slices.IndexFunc(file.Thumbnails, func(thumb models.Thumbnail) bool {
+		return thumb.Size == size
+	})
+	if idx < 0 {
+		return nil, ErrNoThumbnail
+	}
+	thumb := file.Thumbnails[idx]
+	read := func() ([]byte, error) {
+		var data []byte
+		err := a.runAsOwner(ctx, file.UserId, func(ctx context.Context, client *telegram.Client) error {
+			var err error
+			data, err = a.readThumbnail(ctx, client, file, thumb)
+			return err
+		})
+		return data, err
+	}
+	// Previews of encrypted files stay out of the shared cache.
+	if thumb.Salt != "" {
+		return read()
+	}
+	return cache.Fetch(a.cache, cache.Key("files", "thumbnails", file.ID, thumb.ID), 24*time.Hour, read)
+}
+
+// queueThumbnails starts generating the thumbnails of a new image when a slot is free.
+func (a *apiService) queueThumbnails(ctx context.Context, file models.File) {
+	if !canThumbnail(&file) {
+		return
+	}
+	select {
+	case thumbnailSlots <- struct{}{}:
+	default:
+		return
+	}
+	go func() {
+		defer func() { <-thumbnailSlots }()
+		if _, err := a.generateThumbnails(context.WithoutCancel(ctx), &file); err != nil && !errors.Is(err, ErrNoThumbnail) {
+			logging.FromContext(ctx).Warn("failed to generate thumbnails", zap.String("fileId", file.ID), zap.Error(err))
+		}
+	}()
+}
+
+// BackfillThumbnails generates thumbnails for images stored before they were
+// supported, a batch per run. The batches move on by id, so files that keep failing
+// don't hold back the rest.
+func (a *apiService) BackfillThumbnails(ctx context.Context) {
+	var files []models.File
+	if err := a.db.Where("type = ?", "file").Where("status = ?", "active").
+		Where("category = ?", category.Image).Where("size BETWEEN 1 AND ?", maxThumbnailSource).
+		Where(pendingThumbnails).Where("id > ?", a.thumbnailCursor).
+		Order("id").Limit(thumbnailBatchSize).Find(&files).Error; err != nil {
+		return
+	}
+	if len(files) < thumbnailBatchSize {
+		a.thumbnailCursor = uuid.Nil.String()
+	} else {
+		a.thumbnailCursor = files[len(files)-1].ID
+	}
+	logger := logging.FromContext(ctx)
+	for i := range files {
+		if ctx.Err() != nil {
+			return
+		}
+		if _, err := a.generateThumbnails(ctx, &files[i]); err != nil && !errors.Is(err, ErrNoThumbnail) {
+			logger.Warn("failed to generate thumbnails", zap.String("fileId", files[i].ID), zap.Error(err))
+		}
+	}
+}
+
+// generateThumbnails renders the thumbnails of an image and stores them in the file's
+// channel. Files that can't be decoded get an empty list, so they are not tried again.
+func (a *apiService) generateThumbnails(ctx context.Context, file *models.File) (map[int][]byte, error) {
+	if !canThumbnail(file) {
+		a.saveThumbnails(file, []models.Thumbnail{})
+		return nil, ErrNoThumbnail
+	}
+	var images map[int][]byte
+	err := a.runAsOwner(ctx, file.UserId, func(ctx context.Context, client *telegram.Client) error {
+		parts, err := getParts(ctx, client, a.cache, file)
+		if err != nil {
+			return err
+		}
+		lr, err := reader.NewLinearReader(ctx, client.API(), a.cache, file, parts, 0, *file.Size-1, &a.cnf.TG,
+			a.partKeys(file.UserId, ""), 0)
+		if err != nil {
+			return err
+		}
+		defer lr.Close()
+		data, err := io.ReadAll(io.LimitReader(lr, maxThumbnailSource))
+		if err != nil {
+			return err
+		}
+		images, err = renderThumbnails(data)
+		if err != nil {
+			a.saveThumbnails(file, []models.Thumbnail{})
+			return ErrNoThumbnail
+		}
+
+		var thumbs []models.Thumbnail
+		for size, image := range images {
+			thumb, err := a.uploadThumbnail(ctx, client, file, size, image)
+			if err != nil {
+				removeThumbnails(ctx, client.API(), thumbs)
+				return err
+			}
+			thumbs = append(thumbs, *thumb)
+		}
+		if !a.saveThumbnails(file, thumbs) {
+			// Generated concurrently or the file changed meanwhile.
+			removeThumbnails(ctx, client.API(), thumbs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+// saveThumbnails stores thumbnails unless the file already got some.
+func (a *apiService) saveThumbnails(file *models.File, thumbs []models.Thumbnail) bool {
+	res := a.db.Model(&models.File{}).Where("id = ?", file.ID).Where(pendingThumbnails).
+		Update("thumbnails", datatypes.NewJSONSlice(thumbs))
+	if res.Error != nil || res.RowsAffected == 0 {
+		return false
+	}
+	a.cache.Delete(cache.Key("files", file.ID))
+	return true
+}
+
+func (a *apiService) uploadThumbnail(ctx context.Context, client *telegram.Client, file *models.File,
+	size int, data []byte) (*models.Thumbnail, error) {
+	thumb := &models.Thumbnail{Size: size, ChannelId: *file.ChannelId, Length: int64(len(data))}
+	var content io.Reader = bytes.NewReader(data)
+	// Previews of encrypted files are encrypted with the active server key.
+	if *file.Encrypted {
+		keyId, key, err := a.cnf.TG.Uploads.ActiveEncryptionKey()
+		if err != nil {
+			return nil, err
+		}
+		salt, err := generateRandomSalt()
+		if err != nil {
+			return nil, err
+		}
+		cipher, err := crypt.NewCipher(key, salt)
+		if err != nil {
+			return nil, err
+		}
+		encrypted, err := cipher.EncryptDataFormat(content, crypt.FormatTelDrive)
+		if err != nil {
+			return nil, err
+		}
+		defer encrypted.Close()
+		content = encrypted
+		thumb.Salt, thumb.KeyId = salt, keyId
+		thumb.Length = crypt.FormatTelDrive.EncryptedSize(thumb.Length)
+	}
+
+	channel, err := tgc.GetChannelById(ctx, client.API(), thumb.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("%s.%d.jpg", file.ID, size)
+	upload, err := uploader.NewUploader(client.API()).Upload(ctx, uploader.NewUpload(name, content, thumb.Length))
+	if err != nil {
+		return nil, err
+	}
+	res, err := message.NewSender(client.API()).
+		To(&tg.InputPeerChannel{ChannelID: channel.ChannelID, AccessHash: channel.AccessHash}).
+		Media(ctx, message.UploadedDocument(upload).Filename(name).ForceFile(true))
+	if err != nil {
+		return nil, err
+	}
+	msg, err := sentMessage(res)
+	if err != nil {
+		return nil, err
+	}
+	thumb.ID = msg.ID
+	return thumb, nil
+}
+
+func (a *apiService) readThumbnail(ctx context.Context, client *telegram.Client, file *models.File,
+	thumb models.Thumbnail) ([]byte, error) {
+	part := types.Part{
+		ID:        int64(thumb.ID),
+		Size:      thumb.Length,
+		Salt:      thumb.Salt,
+		KeyId:     thumb.KeyId,
+		ChannelId: thumb.ChannelId,
+	}
+	size := thumb.Length
+	if thumb.Salt != "" {
+		var err error
+		if size, err = crypt.FormatTelDrive.DecryptedSize(thumb.Length); err != nil {
+			return nil, err
+		}
+		part.DecryptedSize = size
+	}
+	// The reader decrypts by the file flag, so it gets a view of the file matching the
+	// thumbnail.
+	view := &models.File{ID: file.ID, ChannelId: &thumb.ChannelId, Encrypted: new(bool)}
+	*view.Encrypted = thumb.Salt != ""
+	lr, err := reader.NewLinearReader(ctx, client.API(), a.cache, view, []types.Part{part}, 0, size-1, &a.cnf.TG,
+		a.partKeys(file.UserId, ""), 0)
+	if err != nil {
+		return nil, err
+	}
+	defer lr.Close()
+	return io.ReadAll(lr)
+}
+
+// runAsOwner runs fn with a client logged in as the user.
+func (a *apiService) runAsOwner(ctx context.Context, userId int64, fn func(context.Context, *telegram.Client) error) error {
+	session, err := a.ownerSession(userId)
+	if err != nil {
+		return err
+	}
+	client, err := tgc.AuthClient(ctx, &a.cnf.TG, session.Session, a.middlewares...)
+	if err != nil {
+		return err
+	}
+	return tgc.RunWithAuth(ctx, client, "", func(ctx context.Context) error {
+		return fn(ctx, client)
+	})
+}
+
+// canThumbnail reports whether a file is an image the server can read without the
+// owner's personal key.
+func canThumbnail(file *models.File) bool {
+	if file.Type != "file" || file.Category != string(category.Image) ||
+		file.Size == nil || *file.Size == 0 || *file.Size > maxThumbnailSource {
+		return false
+	}
+	return serverReadable(file)
+}
+
+// serverReadable reports whether the content of a file can be read without the owner's
+// personal key.
+func serverReadable(file *models.File) bool {
+	if file.ChannelId == nil || len(file.Parts) == 0 {
+		return false
+	}
+	return !slices.ContainsFunc(file.Parts, func(part api.Part) bool {
+		return part.KeyId.Value == userKeyId
+	})
+}
+
+// renderThumbnails scales an image to fit every thumbnail size, smaller images are
+// kept at their size.
+func renderThumbnails(data []byte) (map[int][]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > maxThumbnailPixels {
+		return nil, errors.New("image too large")
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	images := make(map[int][]byte, len(thumbnailSizes))
+	for _, size := range thumbnailSizes {
+		bounds := src.Bounds()
+		width, height := bounds.Dx(), bounds.Dy()
+		if width > size || height > size {
+			if width >= height {
+				width, height = size, max(1, height*size/width)
+			} else {
+				width, height = max(1, width*size/height), size
+			}
+		}
+		dst := image.NewRGBA(image.Rect(0, 0, width, height))
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Src, nil)
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+			return nil, err
+		}
+		images[size] = buf.Bytes()
+	}
+	return images, nil
+}
+
+// dropThumbnails deletes the messages of thumbnails a file no longer references, in
+// the background as the owner.
+func (a *apiService) dropThumbnails(ctx context.Context, userId int64, thumbs []models.Thumbnail) {
+	if len(thumbs) == 0 {
+		return
+	}
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		if err := a.runAsOwner(ctx, userId, func(ctx context.Context, client *telegram.Client) error {
+			removeThumbnails(ctx, client.API(), thumbs)
+			return nil
+		}); err != nil {
+			logging.FromContext(ctx).Warn("failed to remove thumbnails", zap.Error(err))
+		}
+	}()
+}
+
+func removeThumbnails(ctx context.Context, client *tg.Client, thumbs []models.Thumbnail) {
+	for _, thumb := range thumbs {
+		deleteChannelMessages(ctx, client, thumb.ChannelId, []int{thumb.ID})
+	}
+}
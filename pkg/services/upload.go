@@ -93,18 +93,35 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 		out         api.UploadPart
 	)
 
-	if params.Encrypted.Value && a.cnf.TG.Uploads.EncryptionKey == "" {
-		return nil, &apiError{err: errors.New("encryption is not enabled"), code: 400}
-	}
+	var keyId, encryptionKey string
+
+	format := crypt.Format(params.Format.Or(api.EncryptionFormatTeldrive))
 
 	userId := auth.GetUser(ctx)
 
+	if params.Encrypted.Value {
+		keyId, encryptionKey, err = a.uploadKey(userId, auth.GetJWTUser(ctx).Hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	partName := params.PartName
+
+	if params.ParentId.Value != "" {
+		stored, err := a.nameForParent(a.db, userId, &params.ParentId.Value, params.PartName)
+		if err != nil {
+			return nil, &apiError{err: err}
+		}
+		partName = stored.Name
+	}
+
 	fileStream := req.Content.Data
 
 	fileSize := params.ContentLength
 
 	if params.ChannelId.Value == 0 {
-		channelId, err = getDefaultChannel(a.db, a.cache, userId)
+		channelId, err = a.uploadChannel(userId, params.ID, params.PartNo)
 		if err != nil {
 			return nil, err
 		}
@@ -118,22 +135,24 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 		return nil, err
 	}
 
-	if len(tokens) == 0 {
-		client, err = tgc.AuthClient(ctx, &a.cnf.TG, auth.GetJWTUser(ctx).TgSession)
-		if err != nil {
-			return nil, err
-		}
-		channelUser = strconv.FormatInt(userId, 10)
-	} else {
+	if len(tokens) > 0 {
 		a.worker.Set(tokens, channelId)
-		token, index = a.worker.Next(channelId)
-		client, err = tgc.BotClient(ctx, a.tgdb, &a.cnf.TG, token)
+		if token, index, err = a.worker.Next(channelId); err == nil {
+			client, err = tgc.BotClient(ctx, a.tgdb, &a.cnf.TG, token)
+
+			if err != nil {
+				return nil, err
+			}
 
+			channelUser = strings.Split(token, ":")[0]
+		}
+	}
+	if client == nil {
+		client, err = tgc.AuthClient(ctx, &a.cnf.TG, auth.GetJWTUser(ctx).TgSession)
 		if err != nil {
 			return nil, err
 		}
-
-		channelUser = strings.Split(token, ":")[0]
+		channelUser = strconv.FormatInt(userId, 10)
 	}
 
 	middlewares := tgc.NewMiddleware(&a.cnf.TG, tgc.WithFloodWait(),
@@ -179,13 +198,13 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 		if params.Encrypted.Value {
 			logger.Debug("encryption enabled, generating salt")
 			salt, _ = generateRandomSalt()
-			cipher, err := crypt.NewCipher(a.cnf.TG.Uploads.EncryptionKey, salt)
+			cipher, err := crypt.NewCipher(encryptionKey, salt)
 			if err != nil {
 				logger.Error("failed to create cipher", zap.Error(err))
 				return fmt.Errorf("failed to create cipher: %w", err)
 			}
-			fileSize = crypt.EncryptedSize(fileSize)
-			fileStream, err = cipher.EncryptData(fileStream)
+			fileSize = format.EncryptedSize(fileSize)
+			fileStream, err = cipher.EncryptDataFormat(fileStream, format)
 			if err != nil {
 				logger.Error("failed to encrypt data", zap.Error(err))
 				return fmt.Errorf("failed to encrypt data: %w", err)
@@ -201,7 +220,7 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 
 		u := uploader.NewUploader(client).WithThreads(a.cnf.TG.Uploads.Threads).WithPartSize(512 * 1024)
 
-		upload, err := u.Upload(ctx, uploader.NewUpload(params.PartName, fileStream, fileSize))
+		upload, err := u.Upload(ctx, uploader.NewUpload(partName, fileStream, fileSize))
 
 		if err != nil {
 			logger.Error("telegram uploader failed",
@@ -212,7 +231,7 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 
 		logger.Debug("telegram upload completed successfully")
 
-		document := message.UploadedDocument(upload).Filename(params.PartName).ForceFile(true)
+		document := message.UploadedDocument(upload).Filename(partName).ForceFile(true)
 
 		sender := message.NewSender(client)
 
@@ -272,7 +291,7 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 			zap.Int("messageId", message.ID))
 
 		partUpload := &models.Upload{
-			Name:      params.PartName,
+			Name:      partName,
 			UploadId:  params.ID,
 			PartId:    message.ID,
 			ChannelId: channelId,
@@ -281,6 +300,10 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 			UserId:    userId,
 			Encrypted: params.Encrypted.Value,
 			Salt:      salt,
+			KeyId:     keyId,
+		}
+		if params.Encrypted.Value && format != crypt.FormatTelDrive {
+			partUpload.Format = string(format)
 		}
 
 		logger.Debug("saving upload record to database",
@@ -358,6 +381,12 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 			Encrypted: partUpload.Encrypted,
 		}
 		out.SetSalt(api.NewOptString(partUpload.Salt))
+		if partUpload.KeyId != "" {
+			out.SetKeyId(api.NewOptString(partUpload.KeyId))
+		}
+		if partUpload.Format != "" {
+			out.SetFormat(api.NewOptEncryptionFormat(api.EncryptionFormat(partUpload.Format)))
+		}
 		
 		logger.Debug("upload process completed successfully",
 			zap.Int("partId", partUpload.PartId),
@@ -397,6 +426,8 @@ func (a *apiService) UploadsUpload(ctx context.Context, req *api.UploadsUploadRe
 		
 		return nil, &apiError{err: fmt.Errorf("upload failed: %w", err), code: 500}
 	}
+	a.trackChannelUsage(ctx, auth.GetJWTUser(ctx).TgSession, userId, channelId)
+
 	logger.Debug("upload finished", zap.String("fileName", params.FileName),
 		zap.String("partName", params.PartName),
 		zap.Int("chunkNo", params.PartNo))
@@ -30,17 +30,18 @@ import (
 
 func (a *apiService) UsersAddBots(ctx context.Context, req *api.AddBots) error {
 	userId := auth.GetUser(ctx)
-	client, _ := tgc.AuthClient(ctx, &a.cnf.TG, auth.GetJWTUser(ctx).TgSession, a.middlewares...)
 
 	if len(req.Bots) > 0 {
-		channelId, err := getDefaultChannel(a.db, a.cache, userId)
-
+		channelIds, err := a.userStorageChannels(userId)
 		if err != nil {
 			return &apiError{err: err}
 		}
-		err = a.addBots(ctx, client, userId, channelId, req.Bots)
-		if err != nil {
-			return &apiError{err: err}
+		for _, channelId := range channelIds {
+			client, _ := tgc.AuthClient(ctx, &a.cnf.TG, auth.GetJWTUser(ctx).TgSession, a.middlewares...)
+			err = a.addBots(ctx, client, userId, channelId, req.Bots)
+			if err != nil {
+				return &apiError{err: err}
+			}
 		}
 	}
 	return nil
@@ -83,41 +84,9 @@ func (a *apiService) UsersListChannels(ctx context.Context) ([]api.Channel, erro
 
 func (a *apiService) UsersCreateChannel(ctx context.Context, req *api.Channel) error {
 	userId := auth.GetUser(ctx)
-	peerStorage := tgstorage.NewPeerStorage(a.tgdb, cache.Key("peers", userId))
-	client, _ := tgc.AuthClient(ctx, &a.cnf.TG, auth.GetJWTUser(ctx).TgSession, a.middlewares...)
-	var (
-		res     tg.UpdatesClass
-		channel *tg.Channel
-		err     error
-	)
-	err = client.Run(ctx, func(ctx context.Context) error {
-		res, err = client.API().ChannelsCreateChannel(ctx, &tg.ChannelsCreateChannelRequest{
-			Title:     req.ChannelName,
-			Broadcast: true,
-		})
-		if err != nil {
-			return err
-		}
-		var ok, found bool
-		updates := res.(*tg.Updates)
-		for _, update := range updates.Chats {
-			channel, ok = update.(*tg.Channel)
-			if ok {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return errors.New("faield to create channel")
-		}
-		return nil
-	})
-	if err != nil {
+	if _, err := a.createChannel(ctx, auth.GetJWTUser(ctx).TgSession, userId, req.ChannelName); err != nil {
 		return &apiError{err: err}
 	}
-	peer := storage.Peer{}
-	peer.FromChat(channel)
-	peerStorage.Add(ctx, peer)
 	return nil
 }
 
@@ -274,21 +243,60 @@ func (a *apiService) UsersProfileImage(ctx context.Context, params api.UsersProf
 func (a *apiService) UsersRemoveBots(ctx context.Context) error {
 	userId := auth.GetUser(ctx)
 
-	channelId, err := getDefaultChannel(a.db, a.cache, userId)
+	channelIds, err := a.userStorageChannels(userId)
 	if err != nil {
 		return &apiError{err: err}
 	}
 
-	if err := a.db.Where("user_id = ?", userId).Where("channel_id = ?", channelId).
-		Delete(&models.Bot{}).Error; err != nil {
-		return &apiError{err: err}
-	}
+	for _, channelId := range channelIds {
+		if err := a.db.Where("user_id = ?", userId).Where("channel_id = ?", channelId).
+			Delete(&models.Bot{}).Error; err != nil {
+			return &apiError{err: err}
+		}
 
-	a.cache.Delete(cache.Key("users", "bots", userId, channelId))
+		a.cache.Delete(cache.Key("users", "bots", userId, channelId))
+
+		// Other instances keep rotating the removed bots unless they hear of it.
+		if err := a.worker.Invalidate(channelId); err != nil {
+			return &apiError{err: err}
+		}
+	}
 
 	return nil
 }
 
+func (a *apiService) UsersGetStoragePolicy(ctx context.Context) (*api.StoragePolicy, error) {
+	userId := auth.GetUser(ctx)
+	policy, err := getStoragePolicy(a.db, a.cache, userId)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	res := &api.StoragePolicy{
+		Mode:       api.StoragePolicyMode(policy.Mode),
+		ChannelIds: policy.ChannelIds,
+	}
+	if policy.RolloverThreshold > 0 {
+		res.RolloverThreshold = api.NewOptInt64(policy.RolloverThreshold)
+	}
+	return res, nil
+}
+
+func (a *apiService) UsersUpdateStoragePolicy(ctx context.Context, req *api.StoragePolicy) error {
+	return a.saveStoragePolicy(auth.GetUser(ctx), req)
+}
+
+func (a *apiService) userStorageChannels(userId int64) ([]int64, error) {
+	channelId, err := getDefaultChannel(a.db, a.cache, userId)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := getStoragePolicy(a.db, a.cache, userId)
+	if err != nil {
+		return nil, err
+	}
+	return storageChannels(policy, channelId), nil
+}
+
 func (a *apiService) UsersRemoveSession(ctx context.Context, params api.UsersRemoveSessionParams) error {
 	userId := auth.GetUser(ctx)
 
@@ -445,11 +453,12 @@ func (a *apiService) addBots(c context.Context, client *telegram.Client, userId
 		})
 	}
 
-	a.cache.Delete(cache.Key("users", "bots", userId, channelId))
-
 	if err := a.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&payload).Error; err != nil {
 		return err
 	}
-	return nil
+
+	a.cache.Delete(cache.Key("users", "bots", userId, channelId))
+
+	return a.worker.Invalidate(channelId)
 
 }
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/tgdrive/teldrive/internal/api"
+	"github.com/tgdrive/teldrive/internal/auth"
+	"github.com/tgdrive/teldrive/internal/cache"
+	"github.com/tgdrive/teldrive/internal/crypt"
+	"github.com/tgdrive/teldrive/internal/reader"
+	"github.com/tgdrive/teldrive/pkg/models"
+)
+
+// userKeyId marks parts sealed with the owner's personal key rather than a server key.
+const userKeyId = "user"
+
+// userKeysCacheSize is the memory set aside for unlocked personal keys.
+const userKeysCacheSize = 1 << 20
+
+var (
+	errKeyLocked        = errors.New("encryption key is locked")
+	errOwnerKeyRequired = errors.New("file is encrypted with its owner's personal key")
+)
+
+func (a *apiService) UsersGetEncryptionKey(ctx context.Context) (*api.UserEncryptionKey, error) {
+	userId := auth.GetUser(ctx)
+	configured, err := a.hasUserKey(userId)
+	if err != nil {
+		return nil, &apiError{err: err}
+	}
+	_, err = a.unlockedUserKey(userId, auth.GetJWTUser(ctx).Hash)
+	return &api.UserEncryptionKey{Configured: configured, Unlocked: err == nil}, nil
+}
+
+func (a *apiService) UsersUpdateEncryptionKey(ctx context.Context, req *api.UserEncryptionKeyUpdate) error {
+	userId := auth.GetUser(ctx)
+	if req.Passphrase == "" || (req.NewPassphrase.IsSet() && req.NewPassphrase.Value == "") {
+		return &apiError{err: errors.New("passphrase is required"), code: 400}
+	}
+
+	var keys []models.UserKey
+	if err := a.db.Where("user_id = ?", userId).Find(&keys).Error; err != nil {
+		return &apiError{err: err}
+	}
+
+	if len(keys) == 0 {
+		key, err := crypt.NewDataKey()
+		if err != nil {
+			return &apiError{err: err}
+		}
+		wrapped, salt, err := crypt.WrapKey(req.Passphrase, key)
+		if err != nil {
+			return &apiError{err: err}
+		}
+		if err := a.db.Create(&models.UserKey{UserId: userId, WrappedKey: wrapped, Salt: salt}).Error; err != nil {
+			return &apiError{err: err}
+		}
+		return nil
+	}
+
+	if !req.NewPassphrase.IsSet() {
+		return &apiError{err: errors.New("encryption key already exists"), code: 409}
+	}
+	attempts := passphraseAttemptKeys(ctx, userId)
+	if err := checkAttempts(ctx, a.keyAuth, scopeKeyPassphrase, attempts...); err != nil {
+		return err
+	}
+	key, err := crypt.UnwrapKey(req.Passphrase, keys[0].WrappedKey, keys[0].Salt)
+	if errors.Is(err, crypt.ErrorWrongPassphrase) {
+		failedAttempt(ctx, a.keyAuth, scopeKeyPassphrase, attempts...)
+		return &apiError{err: err, code: 403}
+	}
+	if err != nil {
+		return &apiError{err: err}
+	}
+	a.keyAuth.Reset(attempts...)
+	wrapped, salt, err := crypt.WrapKey(req.NewPassphrase.Value, key)
+	if err != nil {
+		return &apiError{err: err}
+	}
+	if err := a.db.Model(&models.UserKey{}).Where("user_id = ?", userId).Updates(map[string]any{
+		"wrapped_key": wrapped,
+		"salt":        salt,
+		"updated_at":  a.db.NowFunc(),
+	}).Error; err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+func (a *apiService) UsersUnlockEncryptionKey(ctx context.Context, req *api.UserEncryptionKeyUnlock) error {
+	userId := auth.GetUser(ctx)
+	var keys []models.UserKey
+	if err := a.db.Where("user_id = ?", userId).Find(&keys).Error; err != nil {
+		return &apiError{err: err}
+	}
+	if len(keys) == 0 {
+		return &apiError{err: errors.New("encryption key not found"), code: 404}
+	}
+	attempts := passphraseAttemptKeys(ctx, userId)
+	if err := checkAttempts(ctx, a.keyAuth, scopeKeyPassphrase, attempts...); err != nil {
+		return err
+	}
+	key, err := crypt.UnwrapKey(req.Passphrase, keys[0].WrappedKey, keys[0].Salt)
+	if errors.Is(err, crypt.ErrorWrongPassphrase) {
+		failedAttempt(ctx, a.keyAuth, scopeKeyPassphrase, attempts...)
+		return &apiError{err: err, code: 403}
+	}
+	if err != nil {
+		return &apiError{err: err}
+	}
+	a.keyAuth.Reset(attempts...)
+	if err := a.userKeys.Set(userKeyCacheKey(userId, auth.GetJWTUser(ctx).Hash), key, a.cnf.TG.Uploads.UserKeyTTL); err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+func (a *apiService) UsersLockEncryptionKey(ctx context.Context) error {
+	userId := auth.GetUser(ctx)
+	if err := a.userKeys.Delete(userKeyCacheKey(userId, auth.GetJWTUser(ctx).Hash)); err != nil {
+		return &apiError{err: err}
+	}
+	return nil
+}
+
+// passphraseAttemptKeys limits passphrase guesses per client and per user, so a stolen
+// session can't be used to try passphrases from many addresses.
+func passphraseAttemptKeys(ctx context.Context, userId int64) []string {
+	return []string{ipKey(requestIP(ctx)), "user:" + strconv.FormatInt(userId, 10)}
+}
+
+func userKeyCacheKey(userId int64, sessionHash string) string {
+	return cache.Key("users", "key", userId, sessionHash)
+}
+
+func (a *apiService) hasUserKey(userId int64) (bool, error) {
+	var count int64
+	if err := a.db.Model(&models.UserKey{}).Where("user_id = ?", userId).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// unlockedUserKey returns the personal key while the session holds it unlocked.
+func (a *apiService) unlockedUserKey(userId int64, sessionHash string) (string, error) {
+	var key string
+	if sessionHash == "" {
+		return "", errKeyLocked
+	}
+	if err := a.userKeys.Get(userKeyCacheKey(userId, sessionHash), &key); err != nil || key == "" {
+		return "", errKeyLocked
+	}
+	return key, nil
+}
+
+// partKeys resolves part key ids for a session of the file owner.
+func (a *apiService) partKeys(userId int64, sessionHash string) reader.KeyFunc {
+	return func(keyId string) (string, error) {
+		if keyId == userKeyId {
+			return a.unlockedUserKey(userId, sessionHash)
+		}
+		return a.cnf.TG.Uploads.EncryptionKeyFor(keyId)
+	}
+}
+
+// uploadKey picks the key for a new encrypted part: the personal key when the user
+// has one, the active server key otherwise.
+func (a *apiService) uploadKey(userId int64, sessionHash string) (string, string, error) {
+	if key, err := a.unlockedUserKey(userId, sessionHash); err == nil {
+		return userKeyId, key, nil
+	}
+	configured, err := a.hasUserKey(userId)
+	if err != nil {
+		return "", "", &apiError{err: err}
+	}
+	if configured {
+		return "", "", &apiError{err: errKeyLocked, code: 423}
+	}
+	keyId, key, err := a.cnf.TG.Uploads.ActiveEncryptionKey()
+	if err != nil {
+		return "", "", &apiError{err: errors.New("encryption is not enabled"), code: 400}
+	}
+	return keyId, key, nil
+}
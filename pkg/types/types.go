@@ -9,7 +9,10 @@ type Part struct {
 	DecryptedSize int64
 	Size          int64
 	Salt          string
+	KeyId         string
+	Format        string
 	ID            int64
+	ChannelId     int64
 }
 
 type JWTClaims struct {